@@ -16,7 +16,6 @@ package agent
 
 import (
 	"fmt"
-	"os/exec"
 	"strings"
 
 	"github.com/coreos/dbtester/dbtesterpb"
@@ -47,12 +46,15 @@ func startCetcd(fs *flags, t *transporterServer) error {
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
 
-	flagString := strings.Join(flags, " ")
-
-	cmd := exec.Command(fs.cetcdExec, flags...)
+	if t.req.ConfigProfile != nil {
+		flags = append(flags, "-pprof-addr", fmt.Sprintf("%s:%d", peerIPs[t.req.IPIndex], proxyProfilePort))
+	}
+	flags = append(flags, t.req.ExtraFlags...)
+	cmd := databaseCommand(&t.req, true, nil, "", fs.cetcdExec, flags...)
+	attachCgroup(cmd, t.cgroupDir)
 	cmd.Stdout = t.proxyDatabaseLogfile
 	cmd.Stderr = t.proxyDatabaseLogfile
-	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
+	cs := fmt.Sprintf("%s %s", cmd.Path, strings.Join(cmd.Args[1:], " "))
 
 	plog.Infof("starting database %q", cs)
 	if err := cmd.Start(); err != nil {
@@ -60,7 +62,11 @@ func startCetcd(fs *flags, t *transporterServer) error {
 	}
 	t.proxyCmd = cmd
 	t.proxyCmdWait = make(chan struct{})
-	t.proxyPid = int64(cmd.Process.Pid)
+	pid, err := databasePID(&t.req, true, cmd)
+	if err != nil {
+		return err
+	}
+	t.proxyPid = pid
 
 	plog.Infof("started database %q (PID: %d)", cs, t.pid)
 	return nil
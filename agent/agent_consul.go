@@ -17,7 +17,7 @@ package agent
 import (
 	"fmt"
 	"os"
-	"os/exec"
+	"path/filepath"
 	"strings"
 
 	"github.com/coreos/dbtester/dbtesterpb"
@@ -107,12 +107,23 @@ func startConsul(fs *flags, t *transporterServer) error {
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
 
-	flagString := strings.Join(flags, " ")
-
-	cmd := exec.Command(fs.consulExec, flags...)
+	if t.req.ConfigProfile != nil {
+		// Consul serves '/debug/pprof' with 'enable_debug'
+		debugConfig := filepath.Join(fs.consulDataDir, "dbtester-debug.json")
+		if err := os.MkdirAll(fs.consulDataDir, 0777); err != nil {
+			return err
+		}
+		if err := toFile(`{"enable_debug": true}`, debugConfig); err != nil {
+			return err
+		}
+		flags = append(flags, "-config-file", debugConfig)
+	}
+	flags = append(flags, t.req.ExtraFlags...)
+	cmd := databaseCommand(&t.req, false, []string{fs.consulDataDir}, "", fs.consulExec, flags...)
+	attachCgroup(cmd, t.cgroupDir)
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
-	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
+	cs := fmt.Sprintf("%s %s", cmd.Path, strings.Join(cmd.Args[1:], " "))
 
 	plog.Infof("starting database %q", cs)
 	if err := cmd.Start(); err != nil {
@@ -120,7 +131,11 @@ func startConsul(fs *flags, t *transporterServer) error {
 	}
 	t.cmd = cmd
 	t.cmdWait = make(chan struct{})
-	t.pid = int64(cmd.Process.Pid)
+	pid, err := databasePID(&t.req, false, cmd)
+	if err != nil {
+		return err
+	}
+	t.pid = pid
 
 	plog.Infof("started database %q (PID: %d)", cs, t.pid)
 	return nil
@@ -17,7 +17,6 @@ package agent
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/coreos/dbtester/dbtesterpb"
@@ -127,12 +126,20 @@ func startEtcd(fs *flags, t *transporterServer) error {
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
 
-	flagString := strings.Join(flags, " ")
-
-	cmd := exec.Command(fs.etcdExec, flags...)
+	if t.req.ConfigProfile != nil {
+		flags = append(flags, "--enable-pprof")
+	}
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_zetcd__beta, dbtesterpb.DatabaseID_cetcd__beta:
+		// extra flags are for the proxy, not for the etcd behind it
+	default:
+		flags = append(flags, t.req.ExtraFlags...)
+	}
+	cmd := databaseCommand(&t.req, false, []string{fs.etcdDataDir}, "", fs.etcdExec, flags...)
+	attachCgroup(cmd, t.cgroupDir)
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
-	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
+	cs := fmt.Sprintf("%s %s", cmd.Path, strings.Join(cmd.Args[1:], " "))
 
 	plog.Infof("starting database %q", cs)
 	if err := cmd.Start(); err != nil {
@@ -140,7 +147,11 @@ func startEtcd(fs *flags, t *transporterServer) error {
 	}
 	t.cmd = cmd
 	t.cmdWait = make(chan struct{})
-	t.pid = int64(cmd.Process.Pid)
+	pid, err := databasePID(&t.req, false, cmd)
+	if err != nil {
+		return err
+	}
+	t.pid = pid
 
 	plog.Infof("started database %q (PID: %d)", cs, t.pid)
 	return nil
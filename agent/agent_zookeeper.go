@@ -21,6 +21,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/coreos/dbtester/dbtesterpb"
@@ -34,6 +35,7 @@ initLimit={{.InitLimit}}
 syncLimit={{.SyncLimit}}
 maxClientCnxns={{.MaxClientConnections}}
 snapCount={{.SnapCount}}
+4lw.commands.whitelist=mntr,srvr,cons
 {{range .Peers}}server.{{.MyID}}={{.IP}}:2888:3888
 {{end}}
 `
@@ -66,11 +68,10 @@ func init() {
 	}
 }
 
-// Java class paths for Zookeeper.
-// '-cp' is for 'class search path of directories and zip/jar files'.
-// See https://zookeeper.apache.org/doc/trunk/zookeeperAdmin.html for more.
-// UPDATE FOR EACH ZOOKEEPER RELEASES!
-// Search correct paths with 'find ./zookeeper/lib | sort'.
+// Java class paths for Zookeeper releases, with the jars of the release tarballs.
+//
+// Deprecated: agents build the class path from the jars in the Zookeeper
+// working directory with javaClassPathZookeeper, which works for any release.
 const (
 	// JavaClassPathZookeeperr349 is the Java class paths of Zookeeper r3.4.9.
 	JavaClassPathZookeeperr349 = `-cp zookeeper-3.4.9.jar:lib/slf4j-api-1.6.1.jar:lib/slf4j-log4j12-1.6.1.jar:lib/log4j-1.2.16.jar:conf org.apache.zookeeper.server.quorum.QuorumPeerMain`
@@ -83,6 +84,62 @@ const (
 	JavaClassPathZookeeperr353beta = `-cp zookeeper-3.5.3-beta.jar:lib/slf4j-api-1.7.5.jar:lib/slf4j-log4j12-1.7.5.jar:lib/log4j-1.2.17.jar:conf org.apache.zookeeper.server.quorum.QuorumPeerMain`
 )
 
+// javaClassPathZookeeper returns the Java class paths of Zookeeper
+// unpacked in 'dir', relative to 'dir': the Zookeeper jar and all jars
+// in 'lib', so that any release (or pinned binary) runs as is.
+// '-cp' is for 'class search path of directories and zip/jar files'.
+// See https://zookeeper.apache.org/doc/trunk/zookeeperAdmin.html for more.
+func javaClassPathZookeeper(dir string) (string, error) {
+	var jars []string
+	found := false
+	for _, pattern := range []string{"zookeeper-*.jar", filepath.Join("lib", "*.jar")} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return "", err
+		}
+		sort.Strings(matches)
+		for _, fpath := range matches {
+			name := filepath.Base(fpath)
+			if strings.HasSuffix(name, "-sources.jar") || strings.HasSuffix(name, "-javadoc.jar") || strings.HasSuffix(name, "-tests.jar") {
+				continue
+			}
+			// releases since 3.5.5 have the Zookeeper jar in 'lib'
+			if isZookeeperJar(name) {
+				found = true
+			}
+			rel, err := filepath.Rel(dir, fpath)
+			if err != nil {
+				return "", err
+			}
+			jars = append(jars, rel)
+		}
+	}
+	if !found {
+		return "", fmt.Errorf("no Zookeeper jar in %q", dir)
+	}
+	return fmt.Sprintf("-cp %s:conf org.apache.zookeeper.server.quorum.QuorumPeerMain", strings.Join(jars, ":")), nil
+}
+
+// isZookeeperJar returns true if the file is the Zookeeper server jar,
+// which is 'zookeeper-3.4.9.jar' or 'zookeeper-server-3.5.5.jar'.
+func isZookeeperJar(name string) bool {
+	if !strings.HasPrefix(name, "zookeeper-") || !strings.HasSuffix(name, ".jar") {
+		return false
+	}
+	for _, suffix := range []string{"-sources.jar", "-javadoc.jar", "-tests.jar"} {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	// other modules of releases since 3.5.5
+	for _, prefix := range []string{"zookeeper-jute-", "zookeeper-prometheus-", "zookeeper-recipes-", "zookeeper-contrib-", "zookeeper-it-"} {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
 // startZookeeper starts Zookeeper.
 func startZookeeper(fs *flags, t *transporterServer) error {
 	if !exist(fs.javaExec) {
@@ -180,12 +237,23 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 		return err
 	}
 	zctxt := buf.String()
+	for _, line := range t.req.ExtraZooCfg {
+		zctxt += line + "\n"
+	}
 	plog.Infof("writing Zookeeper config file %q (config %q)", fs.zkConfig, zctxt)
 	if err := toFile(zctxt, fs.zkConfig); err != nil {
 		return err
 	}
 
+	classPath, err := javaClassPathZookeeper(fs.zkWorkDir)
+	if err != nil {
+		return err
+	}
+
 	var flagString string
+	if t.req.GCLog {
+		flagString = strings.Join(gcLogFlags(fs, &t.req), " ")
+	}
 	switch t.req.DatabaseID {
 	case dbtesterpb.DatabaseID_zookeeper__r3_4_9:
 		if t.req.Flag_Zookeeper_R3_4_9.JavaDJuteMaxBuffer != 0 {
@@ -207,10 +275,16 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 			flagString += fmt.Sprintf("-Xmx%s", t.req.Flag_Zookeeper_R3_4_9.JavaXmx)
 		}
 		// -Djute.maxbuffer=33554432 -Xms50G -Xmx50G
+		for _, fl := range t.req.ExtraJVMFlags {
+			if len(flagString) > 0 {
+				flagString += " "
+			}
+			flagString += fl
+		}
 		if len(flagString) > 0 {
 			flagString += " "
 		}
-		flagString += JavaClassPathZookeeperr349
+		flagString += classPath
 
 	case dbtesterpb.DatabaseID_zookeeper__r3_5_2_alpha:
 		if t.req.Flag_Zookeeper_R3_5_2Alpha.JavaDJuteMaxBuffer != 0 {
@@ -232,10 +306,16 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 			flagString += fmt.Sprintf("-Xmx%s", t.req.Flag_Zookeeper_R3_5_2Alpha.JavaXmx)
 		}
 		// -Djute.maxbuffer=33554432 -Xms50G -Xmx50G
+		for _, fl := range t.req.ExtraJVMFlags {
+			if len(flagString) > 0 {
+				flagString += " "
+			}
+			flagString += fl
+		}
 		if len(flagString) > 0 {
 			flagString += " "
 		}
-		flagString += JavaClassPathZookeeperr352alpha
+		flagString += classPath
 
 	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
 		if t.req.Flag_Zookeeper_R3_5_3Beta.JavaDJuteMaxBuffer != 0 {
@@ -257,17 +337,35 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 			flagString += fmt.Sprintf("-Xmx%s", t.req.Flag_Zookeeper_R3_5_3Beta.JavaXmx)
 		}
 		// -Djute.maxbuffer=33554432 -Xms50G -Xmx50G
+		for _, fl := range t.req.ExtraJVMFlags {
+			if len(flagString) > 0 {
+				flagString += " "
+			}
+			flagString += fl
+		}
 		if len(flagString) > 0 {
 			flagString += " "
 		}
-		flagString += JavaClassPathZookeeperr353beta
+		flagString += classPath
 
 	default:
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
 
 	args := []string{shell, "-c", fs.javaExec + " " + flagString + " " + fs.zkConfig}
-	cmd := exec.Command(args[0], args[1:]...)
+	var cmd *exec.Cmd
+	if useContainer(&t.req) {
+		// Java runtime is from the container image
+		args = []string{"sh", "-c", "java " + flagString + " " + fs.zkConfig}
+		dirs := []string{fs.zkWorkDir, fs.zkDataDir, filepath.Dir(fs.zkConfig)}
+		if t.req.GCLog {
+			dirs = append(dirs, filepath.Dir(fs.zkGCLog))
+		}
+		cmd = databaseCommand(&t.req, false, dirs, fs.zkWorkDir, args[0], args[1:]...)
+	} else {
+		cmd = exec.Command(args[0], args[1:]...)
+		attachCgroup(cmd, t.cgroupDir)
+	}
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
 	cs := fmt.Sprintf("%s %s", cmd.Path, strings.Join(args[1:], " "))
@@ -278,7 +376,11 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 	}
 	t.cmd = cmd
 	t.cmdWait = make(chan struct{})
-	t.pid = int64(cmd.Process.Pid)
+	pid, err := databasePID(&t.req, false, cmd)
+	if err != nil {
+		return err
+	}
+	t.pid = pid
 
 	plog.Infof("started database %q (PID: %d)", cs, t.pid)
 	return nil
@@ -0,0 +1,116 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func Test_javaClassPathZookeeper(t *testing.T) {
+	tests := []struct {
+		files []string
+		exp   string
+	}{
+		{
+			[]string{"zookeeper-3.4.9.jar", "zookeeper-3.4.9.jar.md5", "lib/slf4j-api-1.6.1.jar", "lib/log4j-1.2.16.jar", "lib/jline-0.9.94.LICENSE.txt"},
+			"-cp zookeeper-3.4.9.jar:lib/log4j-1.2.16.jar:lib/slf4j-api-1.6.1.jar:conf org.apache.zookeeper.server.quorum.QuorumPeerMain",
+		},
+		{
+			[]string{"lib/zookeeper-3.5.5.jar", "lib/zookeeper-jute-3.5.5.jar", "lib/slf4j-api-1.7.25.jar"},
+			"-cp lib/slf4j-api-1.7.25.jar:lib/zookeeper-3.5.5.jar:lib/zookeeper-jute-3.5.5.jar:conf org.apache.zookeeper.server.quorum.QuorumPeerMain",
+		},
+		{
+			[]string{"zookeeper-3.5.3-beta-sources.jar", "lib/slf4j-api-1.7.5.jar"},
+			"",
+		},
+	}
+	for i, tt := range tests {
+		dir, err := ioutil.TempDir(os.TempDir(), "zookeeper")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		for _, name := range tt.files {
+			fpath := filepath.Join(dir, name)
+			if err = os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+				t.Fatal(err)
+			}
+			if err = ioutil.WriteFile(fpath, nil, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		cp, err := javaClassPathZookeeper(dir)
+		if tt.exp == "" {
+			if err == nil {
+				t.Fatalf("#%d: expected error, got %q", i, cp)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cp != tt.exp {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.exp, cp)
+		}
+	}
+}
+
+func Test_isZookeeperJar(t *testing.T) {
+	tests := map[string]bool{
+		"zookeeper-3.4.9.jar":              true,
+		"zookeeper-3.5.5.jar":              true,
+		"zookeeper-server-3.5.5.jar":       true,
+		"zookeeper-jute-3.5.5.jar":         false,
+		"zookeeper-3.5.3-beta-sources.jar": false,
+		"zookeeper-3.4.9.jar.md5":          false,
+		"slf4j-api-1.7.25.jar":             false,
+	}
+	for name, exp := range tests {
+		if ok := isZookeeperJar(name); ok != exp {
+			t.Fatalf("%q: expected %v, got %v", name, exp, ok)
+		}
+	}
+}
+
+func Test_provisionBinaryZookeeperVersion(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "zookeeper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"lib/zookeeper-jute-3.5.5.jar", "lib/zookeeper-3.5.5.jar", "lib/slf4j-api-1.7.25.jar"} {
+		fpath := filepath.Join(dir, name)
+		if err = os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(fpath, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs := &flags{zkWorkDir: dir}
+	version, sum, err := provisionBinary(fs, &dbtesterpb.Request{DatabaseID: dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != "zookeeper-3.5.5" || sum == "" {
+		t.Fatalf("unexpected version %q, SHA-256 %q", version, sum)
+	}
+}
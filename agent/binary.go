@@ -0,0 +1,346 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// provisionBinary unpacks the database binary of the request into the
+// binary cache directory after verifying its checksum, and points the
+// executable flag of the database to it. It returns the version output
+// and SHA-256 checksum of the binary to run.
+func provisionBinary(fs *flags, req *dbtesterpb.Request) (version, sum string, err error) {
+	target, zookeeper := execFlag(fs, req.DatabaseID)
+	if target == nil {
+		return "", "", fmt.Errorf("unknown database %q", req.DatabaseID)
+	}
+
+	bcfg := req.ConfigDatabaseBinary
+	if bcfg != nil && bcfg.Source != "" {
+		if bcfg.SHA256 == "" {
+			return "", "", fmt.Errorf("binary %q has no SHA-256 checksum", bcfg.Source)
+		}
+		var src string
+		src, err = fetchSource(fs.binaryCacheDir, bcfg.Source, bcfg.SHA256)
+		if err != nil {
+			return "", "", err
+		}
+		dir := filepath.Join(fs.binaryCacheDir, strings.ToLower(bcfg.SHA256))
+		if !exist(dir) {
+			plog.Infof("unpacking %q to %q", src, dir)
+			if err = unpack(src, dir+".tmp"); err != nil {
+				os.RemoveAll(dir + ".tmp")
+				return "", "", err
+			}
+			if err = os.Rename(dir+".tmp", dir); err != nil {
+				return "", "", err
+			}
+		}
+		var resolved string
+		switch {
+		case bcfg.Path != "":
+			resolved = filepath.Join(dir, bcfg.Path)
+		case zookeeper:
+			resolved, err = findFile(dir, func(p string, fi os.FileInfo) bool {
+				return !fi.IsDir() && isZookeeperJar(fi.Name()) && filepath.Dir(p) != dir
+			})
+			resolved = filepath.Dir(resolved)
+			if filepath.Base(resolved) == "lib" {
+				// releases since 3.5.5 have the Zookeeper jar in 'lib'
+				resolved = filepath.Dir(resolved)
+			}
+		default:
+			name := filepath.Base(*target)
+			resolved, err = findFile(dir, func(p string, fi os.FileInfo) bool {
+				return !fi.IsDir() && fi.Name() == name
+			})
+		}
+		if err != nil {
+			return "", "", err
+		}
+		plog.Infof("provisioned %q for %q", resolved, req.DatabaseID)
+		*target = resolved
+	}
+
+	binPath := *target
+	if zookeeper {
+		// Zookeeper has no version flag, so use the jar name
+		binPath, err = findFile(binPath, func(p string, fi os.FileInfo) bool {
+			return !fi.IsDir() && isZookeeperJar(fi.Name())
+		})
+		if err != nil {
+			return "", "", fmt.Errorf("no Zookeeper jar in %q", *target)
+		}
+		version = strings.TrimSuffix(filepath.Base(binPath), ".jar")
+	} else {
+		version = binaryVersion(binPath, req.DatabaseID)
+	}
+	if bcfg != nil && bcfg.Version != "" && !strings.Contains(version, bcfg.Version) {
+		return "", "", fmt.Errorf("expected version %q, got %q from %q", bcfg.Version, version, binPath)
+	}
+
+	if sum, err = sha256File(binPath); err != nil {
+		return "", "", err
+	}
+	plog.Infof("database binary %q [version: %q | SHA-256: %s]", binPath, version, sum)
+
+	switch req.DatabaseID {
+	case dbtesterpb.DatabaseID_zetcd__beta, dbtesterpb.DatabaseID_cetcd__beta:
+		// the proxy runs on the etcd at the flag path, which is not pinned
+		etcdVersion := binaryVersion(fs.etcdExec, req.DatabaseID)
+		etcdSum, err := sha256File(fs.etcdExec)
+		if err != nil {
+			return "", "", err
+		}
+		plog.Infof("etcd binary %q [version: %q | SHA-256: %s]", fs.etcdExec, etcdVersion, etcdSum)
+		version = fmt.Sprintf("%s (etcd: %s)", version, etcdVersion)
+		sum = fmt.Sprintf("%s (etcd: %s)", sum, etcdSum)
+	}
+	return version, sum, nil
+}
+
+// binaryVersion returns the version output of the database binary.
+func binaryVersion(binPath string, id dbtesterpb.DatabaseID) string {
+	args := []string{"--version"}
+	if strings.HasPrefix(id.String(), "consul") {
+		args = []string{"version"}
+	}
+	out, err := exec.Command(binPath, args...).CombinedOutput()
+	if err != nil {
+		plog.Warningf("%q %v failed (%v)", binPath, args, err)
+	}
+	return strings.Join(strings.Fields(string(out)), " ")
+}
+
+// execFlag returns the executable flag to run the database.
+// For Zookeeper, it returns the working directory.
+func execFlag(fs *flags, id dbtesterpb.DatabaseID) (target *string, zookeeper bool) {
+	switch id {
+	case dbtesterpb.DatabaseID_etcd__v2_3,
+		dbtesterpb.DatabaseID_etcd__v3_1,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__tip:
+		return &fs.etcdExec, false
+	case dbtesterpb.DatabaseID_zetcd__beta:
+		return &fs.zetcdExec, false
+	case dbtesterpb.DatabaseID_cetcd__beta:
+		return &fs.cetcdExec, false
+	case dbtesterpb.DatabaseID_zookeeper__r3_4_9,
+		dbtesterpb.DatabaseID_zookeeper__r3_5_2_alpha,
+		dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		return &fs.zkWorkDir, true
+	case dbtesterpb.DatabaseID_consul__v0_7_5,
+		dbtesterpb.DatabaseID_consul__v0_8_0,
+		dbtesterpb.DatabaseID_consul__v0_8_4:
+		return &fs.consulExec, false
+	}
+	return nil, false
+}
+
+// fetchSource returns the local path of the source, downloading it
+// into the cache directory if it is an HTTP URL. It returns an error
+// if the checksum does not match.
+func fetchSource(cacheDir, source, expected string) (string, error) {
+	fpath := source
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		if err := os.MkdirAll(cacheDir, 0777); err != nil {
+			return "", err
+		}
+		fpath = filepath.Join(cacheDir, path.Base(source))
+		if sum, err := sha256File(fpath); err != nil || !strings.EqualFold(sum, expected) {
+			plog.Infof("downloading %q to %q", source, fpath)
+			if err = download(source, fpath); err != nil {
+				return "", err
+			}
+		}
+	}
+	sum, err := sha256File(fpath)
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(sum, expected) {
+		return "", fmt.Errorf("%q has SHA-256 %s, expected %s", fpath, sum, expected)
+	}
+	return fpath, nil
+}
+
+func download(url, fpath string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%q returned %q", url, resp.Status)
+	}
+	f, err := openToOverwrite(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, resp.Body)
+	return err
+}
+
+func sha256File(fpath string) (string, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// unpack extracts the tarball or zip file into the directory.
+func unpack(src, dir string) error {
+	if strings.HasSuffix(src, ".zip") {
+		return unzip(src, dir)
+	}
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(src, ".gz") || strings.HasSuffix(src, ".tgz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		r = gr
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		fpath, err := unpackPath(dir, hdr.Name)
+		if err != nil {
+			return err
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(fpath, 0777)
+		case tar.TypeReg, tar.TypeRegA:
+			err = writeFile(fpath, tr, os.FileMode(hdr.Mode))
+		case tar.TypeSymlink:
+			if err = os.MkdirAll(filepath.Dir(fpath), 0777); err == nil {
+				err = os.Symlink(hdr.Linkname, fpath)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func unzip(src, dir string) error {
+	zr, err := zip.OpenReader(src)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	for _, zf := range zr.File {
+		fpath, err := unpackPath(dir, zf.Name)
+		if err != nil {
+			return err
+		}
+		if zf.FileInfo().IsDir() {
+			if err = os.MkdirAll(fpath, 0777); err != nil {
+				return err
+			}
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return err
+		}
+		err = writeFile(fpath, rc, zf.Mode())
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unpackPath returns the path of archived file in the directory,
+// rejecting files outside of the directory.
+func unpackPath(dir, name string) (string, error) {
+	fpath := filepath.Join(dir, name)
+	if fpath != dir && !strings.HasPrefix(fpath, dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("archived file %q is outside of %q", name, dir)
+	}
+	return fpath, nil
+}
+
+func writeFile(fpath string, r io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(fpath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, mode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, r)
+	return err
+}
+
+// findFile returns the first file under the directory that matches.
+func findFile(dir string, match func(string, os.FileInfo) bool) (string, error) {
+	var found string
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if found == "" && match(p, fi) {
+			found = p
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if found == "" {
+		return "", fmt.Errorf("no matching file in %q", dir)
+	}
+	return found, nil
+}
@@ -0,0 +1,217 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cgroupCPUPeriod is the period of 'cpu.max' in microseconds.
+const cgroupCPUPeriod = 100000
+
+// startCgroup creates the cgroup v2 of the database with the limits of
+// the request. It must be called before the database processes start,
+// which move themselves into the cgroup with attachCgroup.
+func startCgroup(fs *flags, t *transporterServer) error {
+	ccfg := t.req.ConfigDatabaseCgroup
+	if ccfg == nil {
+		return nil
+	}
+
+	// controllers must be enabled in all ancestors
+	if err := os.MkdirAll(fs.cgroupRoot, 0755); err != nil {
+		return err
+	}
+	for _, dir := range []string{filepath.Dir(fs.cgroupRoot), fs.cgroupRoot} {
+		if err := writeCgroupFile(dir, "cgroup.subtree_control", "+cpu +memory +io"); err != nil {
+			return err
+		}
+	}
+
+	dir := filepath.Join(fs.cgroupRoot, t.req.DatabaseID.String())
+	if exist(dir) {
+		// empty cgroup of previous run
+		if err := os.Remove(dir); err != nil {
+			return err
+		}
+	}
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return err
+	}
+
+	if ccfg.CPUs > 0 {
+		quota := int64(ccfg.CPUs * cgroupCPUPeriod)
+		if err := writeCgroupFile(dir, "cpu.max", fmt.Sprintf("%d %d", quota, cgroupCPUPeriod)); err != nil {
+			return err
+		}
+	}
+	if ccfg.MemoryBytes > 0 {
+		if err := writeCgroupFile(dir, "memory.max", fmt.Sprintf("%d", ccfg.MemoryBytes)); err != nil {
+			return err
+		}
+	}
+	for _, line := range ccfg.IOMax {
+		if err := writeCgroupFile(dir, "io.max", line); err != nil {
+			return err
+		}
+	}
+
+	plog.Infof("created cgroup %q [CPUs: %.2f | memory: %d bytes | io: %q]", dir, ccfg.CPUs, ccfg.MemoryBytes, ccfg.IOMax)
+
+	t.psiMu.Lock()
+	t.cgroupDir = dir
+	t.psiRows = [][]string{psiColumns}
+	t.psiMu.Unlock()
+	return nil
+}
+
+// attachCgroup makes the command move itself into the cgroup before
+// it runs the database binary, so that the limits apply to all of its
+// allocations from the start. It does nothing if the cgroup is empty.
+func attachCgroup(cmd *exec.Cmd, dir string) {
+	if dir == "" {
+		return
+	}
+	procs := filepath.Join(dir, "cgroup.procs")
+	cmd.Args = append([]string{"sh", "-c", `echo $$ > "$0" && exec "$@"`, procs, cmd.Path}, cmd.Args[1:]...)
+	cmd.Path = "/bin/sh"
+}
+
+// stopCgroup removes the cgroup after the database processes exit.
+func stopCgroup(t *transporterServer) {
+	t.psiMu.Lock()
+	dir := t.cgroupDir
+	t.cgroupDir = ""
+	t.psiMu.Unlock()
+	if dir == "" {
+		return
+	}
+	if err := os.Remove(dir); err != nil {
+		plog.Warningf("failed to remove cgroup %q (%v)", dir, err)
+	}
+}
+
+func writeCgroupFile(dir, name, value string) error {
+	fpath := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(fpath, []byte(value), 0644); err != nil {
+		return fmt.Errorf("failed to write %q to %q (%v)", value, fpath, err)
+	}
+	return nil
+}
+
+// psiColumns defines the columns of pressure stall information (PSI) of the
+// database cgroup. 'AVG10' is the percentage of time in the last 10 seconds
+// that some (or all) tasks stalled, and 'TOTAL-US' is the total stall time.
+var psiColumns = []string{
+	"UNIX-SECOND",
+	"CPU-SOME-AVG10",
+	"CPU-SOME-TOTAL-US",
+	"MEMORY-SOME-AVG10",
+	"MEMORY-SOME-TOTAL-US",
+	"MEMORY-FULL-AVG10",
+	"MEMORY-FULL-TOTAL-US",
+	"IO-SOME-AVG10",
+	"IO-SOME-TOTAL-US",
+	"IO-FULL-AVG10",
+	"IO-FULL-TOTAL-US",
+}
+
+// addPSI reads the pressure stall information of the cgroup.
+func (t *transporterServer) addPSI() error {
+	t.psiMu.Lock()
+	dir := t.cgroupDir
+	t.psiMu.Unlock()
+	if dir == "" {
+		return nil
+	}
+	row := []string{fmt.Sprintf("%d", time.Now().Unix())}
+	for _, res := range []string{"cpu", "memory", "io"} {
+		psi, err := readPSI(filepath.Join(dir, res+".pressure"))
+		if err != nil {
+			return err
+		}
+		get := func(k string) string {
+			if v, ok := psi[k]; ok {
+				return v
+			}
+			return "0"
+		}
+		row = append(row, get("some avg10"), get("some total"))
+		if res != "cpu" {
+			row = append(row, get("full avg10"), get("full total"))
+		}
+	}
+	t.psiMu.Lock()
+	t.psiRows = append(t.psiRows, row)
+	t.psiMu.Unlock()
+	return nil
+}
+
+// readPSI parses the pressure file, whose lines are like
+// 'some avg10=0.00 avg60=0.00 avg300=0.00 total=0'.
+func readPSI(fpath string) (map[string]string, error) {
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	psi := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(string(bts)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		for _, kv := range fields[1:] {
+			ss := strings.SplitN(kv, "=", 2)
+			if len(ss) != 2 {
+				continue
+			}
+			if _, err := strconv.ParseFloat(ss[1], 64); err != nil {
+				return nil, fmt.Errorf("invalid %q in %q", kv, fpath)
+			}
+			psi[fields[0]+" "+ss[0]] = ss[1]
+		}
+	}
+	return psi, nil
+}
+
+// savePSI saves the pressure stall information to 'psiCSV'.
+func savePSI(fs *flags, t *transporterServer) error {
+	t.psiMu.Lock()
+	rows := make([][]string, len(t.psiRows))
+	copy(rows, t.psiRows)
+	t.psiMu.Unlock()
+	if len(rows) == 0 {
+		return nil
+	}
+	f, err := openToOverwrite(fs.psiCSV)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	wr := csv.NewWriter(f)
+	if err = wr.WriteAll(rows); err != nil {
+		return err
+	}
+	wr.Flush()
+	return wr.Error()
+}
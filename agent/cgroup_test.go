@@ -0,0 +1,52 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_attachCgroup(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "cgroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the database binary runs in the process that joined the cgroup
+	cmd := exec.Command("sh", "-c", "echo $$ $0 $1", "a b", "c")
+	attachCgroup(cmd, dir)
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bts, err := ioutil.ReadFile(filepath.Join(dir, "cgroup.procs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid := strings.TrimSpace(string(bts))
+	if exp := fmt.Sprintf("%s a b c", pid); strings.TrimSpace(string(out)) != exp {
+		t.Fatalf("expected %q, got %q", exp, out)
+	}
+	if pid != fmt.Sprintf("%d", cmd.Process.Pid) {
+		t.Fatalf("expected PID %d in cgroup, got %q", cmd.Process.Pid, pid)
+	}
+}
@@ -44,6 +44,7 @@ type flags struct {
 	zkWorkDir     string
 	zkDataDir     string
 	zkConfig      string
+	zkGCLog       string
 	etcdDataDir   string
 	consulDataDir string
 
@@ -51,6 +52,16 @@ type flags struct {
 	diskDevice       string
 	networkInterface string
 	clientNumPath    string
+
+	binaryCacheDir string
+
+	cgroupRoot string
+	psiCSV     string
+
+	databaseMetricsCSV string
+	gcPausesCSV        string
+
+	profileDir string
 }
 
 var globalFlags flags
@@ -74,6 +85,9 @@ func init() {
 	Command.PersistentFlags().StringVar(&globalFlags.databaseLog, "database-log", filepath.Join(homeDir(), "database.log"), "Database log path.")
 	Command.PersistentFlags().StringVar(&globalFlags.systemMetricsCSV, "system-metrics-csv", filepath.Join(homeDir(), "server-system-metrics.csv"), "Raw system metrics data path.")
 	Command.PersistentFlags().StringVar(&globalFlags.systemMetricsCSVInterpolated, "system-metrics-csv-interpolated", filepath.Join(homeDir(), "server-system-metrics-interpolated.csv"), "Interpolated system metrics data path.")
+	Command.PersistentFlags().StringVar(&globalFlags.databaseMetricsCSV, "database-metrics-csv", filepath.Join(homeDir(), "server-database-metrics.csv"), "Database metrics data path, scraped from the database metrics endpoint.")
+	Command.PersistentFlags().StringVar(&globalFlags.gcPausesCSV, "gc-pauses-csv", filepath.Join(homeDir(), "server-gc-pauses.csv"), "Garbage collection pause data path, parsed from Zookeeper GC log.")
+	Command.PersistentFlags().StringVar(&globalFlags.profileDir, "profile-dir", filepath.Join(homeDir(), "profiles"), "Directory to save Go runtime profiles of database.")
 
 	Command.PersistentFlags().StringVar(&globalFlags.javaExec, "java-exec", "/usr/bin/java", "Java executable binary path (needed for Zookeeper).")
 	Command.PersistentFlags().StringVar(&globalFlags.etcdExec, "etcd-exec", filepath.Join(os.Getenv("GOPATH"), "bin/etcd"), "etcd executable binary path.")
@@ -84,6 +98,7 @@ func init() {
 	Command.PersistentFlags().StringVar(&globalFlags.zkWorkDir, "zookeeper-work-dir", filepath.Join(homeDir(), "zookeeper"), "Zookeeper working directory.")
 	Command.PersistentFlags().StringVar(&globalFlags.zkDataDir, "zookeeper-data-dir", filepath.Join(homeDir(), "zookeeper/zookeeper.data"), "Zookeeper data directory.")
 	Command.PersistentFlags().StringVar(&globalFlags.zkConfig, "zookeeper-config", filepath.Join(homeDir(), "zookeeper/zookeeper.config"), "Zookeeper configuration file path.")
+	Command.PersistentFlags().StringVar(&globalFlags.zkGCLog, "zookeeper-gc-log", filepath.Join(homeDir(), "zookeeper-gc.log"), "Zookeeper JVM garbage collection log path.")
 	Command.PersistentFlags().StringVar(&globalFlags.etcdDataDir, "etcd-data-dir", filepath.Join(homeDir(), "etcd.data"), "etcd data directory.")
 	Command.PersistentFlags().StringVar(&globalFlags.consulDataDir, "consul-data-dir", filepath.Join(homeDir(), "consul.data"), "Consul data directory.")
 
@@ -91,6 +106,9 @@ func init() {
 	Command.PersistentFlags().StringVar(&globalFlags.diskDevice, "disk-device", dn, "Disk device to collect disk statistics metrics from.")
 	Command.PersistentFlags().StringVar(&globalFlags.networkInterface, "network-interface", nt, "Network interface to record in/outgoing packets.")
 	Command.PersistentFlags().StringVar(&globalFlags.clientNumPath, "client-num-path", filepath.Join(homeDir(), "client-num"), "File path to store client number.")
+	Command.PersistentFlags().StringVar(&globalFlags.cgroupRoot, "cgroup-root", "/sys/fs/cgroup/dbtester", "cgroup v2 directory to create database cgroups in.")
+	Command.PersistentFlags().StringVar(&globalFlags.psiCSV, "psi-csv", filepath.Join(homeDir(), "server-psi.csv"), "Pressure stall information data path of database cgroup.")
+	Command.PersistentFlags().StringVar(&globalFlags.binaryCacheDir, "binary-cache-dir", filepath.Join(homeDir(), "dbtester-binaries"), "Directory to download and unpack database binaries.")
 }
 
 // Command implements 'agent' command.
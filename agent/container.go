@@ -0,0 +1,108 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// containerName returns the name of the container to run the database,
+// or its proxy for zetcd and cetcd.
+func containerName(req *dbtesterpb.Request, proxy bool) string {
+	name := "dbtester-" + strings.Replace(req.DatabaseID.String(), "_", "-", -1)
+	if proxy {
+		name += "-proxy"
+	}
+	return name
+}
+
+// useContainer returns true if the request runs the database in a container.
+func useContainer(req *dbtesterpb.Request) bool {
+	return req.ConfigDatabaseContainer != nil && req.ConfigDatabaseContainer.Image != ""
+}
+
+// databaseCommand returns the command to run the database binary. If the
+// request has container configuration, the command runs the binary in the
+// container, with the resource limits and the host network. The binary and
+// 'dirs' are mounted at the same paths as in the host, so that the database
+// flags do not change.
+func databaseCommand(req *dbtesterpb.Request, proxy bool, dirs []string, workDir string, binPath string, args ...string) *exec.Cmd {
+	if !useContainer(req) {
+		return exec.Command(binPath, args...)
+	}
+	ccfg := req.ConfigDatabaseContainer
+	name := containerName(req, proxy)
+
+	// remove the container of previous run, if any
+	exec.Command(ccfg.Runtime, "rm", "--force", name).Run()
+
+	cargs := []string{
+		"run",
+		"--rm",
+		"--name", name,
+		"--network", "host",
+	}
+	if ccfg.CPUs > 0 {
+		cargs = append(cargs, "--cpus", strconv.FormatFloat(ccfg.CPUs, 'f', -1, 64))
+	}
+	if ccfg.MemoryBytes > 0 {
+		cargs = append(cargs, "--memory", fmt.Sprintf("%db", ccfg.MemoryBytes))
+	}
+	if filepath.IsAbs(binPath) {
+		cargs = append(cargs, "--volume", fmt.Sprintf("%s:%s:ro", binPath, binPath))
+	}
+	for _, dir := range dirs {
+		if err := os.MkdirAll(dir, 0777); err != nil {
+			plog.Warningf("failed to create %q (%v)", dir, err)
+		}
+		cargs = append(cargs, "--volume", fmt.Sprintf("%s:%s", dir, dir))
+	}
+	if workDir != "" {
+		cargs = append(cargs, "--workdir", workDir)
+	}
+	cargs = append(cargs, "--entrypoint", binPath, ccfg.Image)
+	cargs = append(cargs, args...)
+	return exec.Command(ccfg.Runtime, cargs...)
+}
+
+// databasePID returns the PID of the database process started by the command.
+// For containers, it is the host PID of the container's main process, so that
+// system metrics track the database rather than the container runtime CLI.
+func databasePID(req *dbtesterpb.Request, proxy bool, cmd *exec.Cmd) (int64, error) {
+	if !useContainer(req) {
+		return int64(cmd.Process.Pid), nil
+	}
+	name := containerName(req, proxy)
+	for i := 0; i < 60; i++ {
+		out, err := exec.Command(req.ConfigDatabaseContainer.Runtime, "inspect", "--format", "{{.State.Pid}}", name).Output()
+		if err == nil {
+			pid, perr := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+			if perr == nil && pid > 0 {
+				plog.Infof("container %q is running with PID %d", name, pid)
+				return pid, nil
+			}
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	return 0, fmt.Errorf("container %q did not start", name)
+}
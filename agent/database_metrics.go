@@ -0,0 +1,225 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// databaseMetricKind defines how to convert the scraped metric to the column value.
+type databaseMetricKind int
+
+const (
+	// metricGauge records the value as it is.
+	metricGauge databaseMetricKind = iota
+	// metricRate records the increase of counter per second.
+	metricRate
+	// metricAverageMs records the average of summary or histogram in the last
+	// second, which is the increase of '_sum' divided by the increase of '_count'.
+	metricAverageMs
+)
+
+// databaseMetric is a series to scrape from the database metrics endpoint.
+type databaseMetric struct {
+	column string
+	kind   databaseMetricKind
+	// names are the metric names, in case they are renamed across versions
+	names []string
+	// scale converts the '_sum' unit to milliseconds for metricAverageMs
+	scale float64
+}
+
+var etcdMetrics = []databaseMetric{
+	{column: "WAL-FSYNC-AVG-MS", kind: metricAverageMs, names: []string{"etcd_disk_wal_fsync_duration_seconds", "etcd_wal_fsync_durations_seconds"}, scale: 1000},
+	{column: "BACKEND-COMMIT-AVG-MS", kind: metricAverageMs, names: []string{"etcd_disk_backend_commit_duration_seconds"}, scale: 1000},
+	{column: "PROPOSALS-PENDING", kind: metricGauge, names: []string{"etcd_server_proposals_pending", "etcd_server_pending_proposal_total"}},
+	{column: "LEADER-CHANGES", kind: metricGauge, names: []string{"etcd_server_leader_changes_seen_total"}},
+	{column: "RAFT-APPLIED-PER-SECOND", kind: metricRate, names: []string{"etcd_server_proposals_applied_total"}},
+}
+
+var consulMetrics = []databaseMetric{
+	{column: "RAFT-COMMIT-AVG-MS", kind: metricAverageMs, names: []string{"consul_raft_commitTime"}, scale: 1},
+	{column: "RAFT-FSM-APPLY-AVG-MS", kind: metricAverageMs, names: []string{"consul_raft_fsm_apply"}, scale: 1},
+	{column: "LEADER-CHANGES", kind: metricGauge, names: []string{"consul_raft_state_leader"}},
+	{column: "RAFT-APPLIED-PER-SECOND", kind: metricRate, names: []string{"consul_raft_apply"}},
+}
+
+// databaseMetricsTarget returns the metrics endpoint of the database and the series to scrape.
+// It returns empty target if the database has no metrics endpoint.
+func databaseMetricsTarget(req *dbtesterpb.Request) (string, []databaseMetric) {
+	ip := strings.Split(req.PeerIPsString, "___")[req.IPIndex]
+	switch req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__v2_3,
+		dbtesterpb.DatabaseID_etcd__v3_1,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__tip,
+		dbtesterpb.DatabaseID_zetcd__beta,
+		dbtesterpb.DatabaseID_cetcd__beta:
+		return fmt.Sprintf("http://%s:2379/metrics", ip), etcdMetrics
+	case dbtesterpb.DatabaseID_consul__v0_7_5,
+		dbtesterpb.DatabaseID_consul__v0_8_0,
+		dbtesterpb.DatabaseID_consul__v0_8_4:
+		// requires 'telemetry' with 'prometheus_retention_time'
+		return fmt.Sprintf("http://%s:8500/v1/agent/metrics?format=prometheus", ip), consulMetrics
+	case dbtesterpb.DatabaseID_zookeeper__r3_4_9:
+		return fmt.Sprintf("%s:%d", ip, req.Flag_Zookeeper_R3_4_9.ClientPort), zookeeperMetrics
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_2_alpha:
+		return fmt.Sprintf("%s:%d", ip, req.Flag_Zookeeper_R3_5_2Alpha.ClientPort), zookeeperMetrics
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		return fmt.Sprintf("%s:%d", ip, req.Flag_Zookeeper_R3_5_3Beta.ClientPort), zookeeperMetrics
+	}
+	return "", nil
+}
+
+// databaseMetricsScraper polls the database metrics endpoint every second,
+// and records the selected series by unix second.
+type databaseMetricsScraper struct {
+	target  string
+	metrics []databaseMetric
+	// fetch returns the metric values by name
+	fetch func() (map[string]float64, error)
+
+	prev     map[string]float64
+	prevTime time.Time
+	failed   bool
+
+	rows [][]string
+}
+
+func newDatabaseMetricsScraper(req *dbtesterpb.Request) *databaseMetricsScraper {
+	target, metrics := databaseMetricsTarget(req)
+	if target == "" {
+		return nil
+	}
+	header := []string{"UNIX-SECOND"}
+	for _, m := range metrics {
+		header = append(header, m.column)
+	}
+	s := &databaseMetricsScraper{
+		target:  target,
+		metrics: metrics,
+		rows:    [][]string{header},
+	}
+	if strings.HasPrefix(target, "http://") {
+		cli := &http.Client{Timeout: 500 * time.Millisecond}
+		s.fetch = func() (map[string]float64, error) { return fetchPrometheus(cli, target) }
+	} else {
+		s.fetch = func() (map[string]float64, error) { return fetchZookeeper(target, 500*time.Millisecond) }
+	}
+	plog.Infof("scraping database metrics from %q", target)
+	return s
+}
+
+// scrape records the series at the current unix second.
+func (s *databaseMetricsScraper) scrape() {
+	now := time.Now()
+	cur, err := s.fetch()
+	if err != nil {
+		if !s.failed {
+			plog.Warningf("failed to scrape database metrics %q (%v)", s.target, err)
+			s.failed = true
+		}
+		return
+	}
+	s.failed = false
+
+	if s.prev != nil {
+		elapsed := now.Sub(s.prevTime).Seconds()
+		row := []string{fmt.Sprintf("%d", now.Unix())}
+		for _, m := range s.metrics {
+			var v float64
+			for _, name := range m.names {
+				switch m.kind {
+				case metricGauge:
+					v += cur[name]
+				case metricRate:
+					if elapsed > 0 {
+						v += (cur[name] - s.prev[name]) / elapsed
+					}
+				case metricAverageMs:
+					if cnt := cur[name+"_count"] - s.prev[name+"_count"]; cnt > 0 {
+						v += m.scale * (cur[name+"_sum"] - s.prev[name+"_sum"]) / cnt
+					}
+				}
+			}
+			row = append(row, strconv.FormatFloat(v, 'f', 4, 64))
+		}
+		s.rows = append(s.rows, row)
+	}
+	s.prev, s.prevTime = cur, now
+}
+
+// fetchPrometheus returns the metric values of Prometheus text format by name,
+// summed over all labels.
+func fetchPrometheus(cli *http.Client, url string) (map[string]float64, error) {
+	resp, err := cli.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%q returned %q", url, resp.Status)
+	}
+
+	vs := make(map[string]float64)
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		txt := scanner.Text()
+		if strings.HasPrefix(txt, "#") {
+			continue
+		}
+		// e.g. 'etcd_disk_wal_fsync_duration_seconds_sum 0.123'
+		// or 'consul_raft_apply{quantile="0.5"} 1'
+		fields := strings.Fields(txt)
+		if len(fields) < 2 {
+			continue
+		}
+		name := fields[0]
+		if idx := strings.Index(name, "{"); idx != -1 {
+			if strings.Contains(name[idx:], "quantile=") || strings.Contains(name[idx:], "le=") {
+				continue
+			}
+			name = name[:idx]
+		}
+		v, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			continue
+		}
+		vs[name] += v
+	}
+	return vs, scanner.Err()
+}
+
+func (s *databaseMetricsScraper) save(fpath string) error {
+	f, err := openToOverwrite(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	wr := csv.NewWriter(f)
+	if err = wr.WriteAll(s.rows); err != nil {
+		return err
+	}
+	wr.Flush()
+	return wr.Error()
+}
@@ -0,0 +1,156 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// zookeeperMetrics defines the series from Zookeeper four letter words.
+// Latencies are the averages and maximum since the server started, as
+// reported by Zookeeper. 'FOLLOWERS' and 'PENDING-SYNCS' are only
+// reported by the leader.
+var zookeeperMetrics = []databaseMetric{
+	{column: "OUTSTANDING-REQUESTS", kind: metricGauge, names: []string{"zk_outstanding_requests"}},
+	{column: "AVG-LATENCY-MS", kind: metricGauge, names: []string{"zk_avg_latency"}},
+	{column: "MAX-LATENCY-MS", kind: metricGauge, names: []string{"zk_max_latency"}},
+	{column: "ZNODE-COUNT", kind: metricGauge, names: []string{"zk_znode_count"}},
+	{column: "WATCH-COUNT", kind: metricGauge, names: []string{"zk_watch_count"}},
+	{column: "CONNECTIONS", kind: metricGauge, names: []string{"zk_num_alive_connections"}},
+	{column: "FOLLOWERS", kind: metricGauge, names: []string{"zk_followers"}},
+	{column: "PENDING-SYNCS", kind: metricGauge, names: []string{"zk_pending_syncs"}},
+	{column: "PACKETS-RECEIVED-PER-SECOND", kind: metricRate, names: []string{"zk_packets_received"}},
+}
+
+// fetchZookeeper returns the Zookeeper metrics by 'mntr' names. If 'mntr'
+// is not available, it falls back to 'srvr' and 'cons'.
+func fetchZookeeper(addr string, timeout time.Duration) (map[string]float64, error) {
+	out, err := fourLetterWord(addr, "mntr", timeout)
+	if err != nil {
+		return nil, err
+	}
+	if vs := parseMntr(out); len(vs) > 0 {
+		return vs, nil
+	}
+
+	// e.g. 'mntr is not executed because it is not in the whitelist.'
+	if out, err = fourLetterWord(addr, "srvr", timeout); err != nil {
+		return nil, err
+	}
+	vs, err := parseSrvr(out)
+	if err != nil {
+		return nil, err
+	}
+	if out, err = fourLetterWord(addr, "cons", timeout); err == nil {
+		vs["zk_num_alive_connections"] = float64(countCons(out))
+	}
+	return vs, nil
+}
+
+func fourLetterWord(addr, cmd string, timeout time.Duration) (string, error) {
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+	if _, err = conn.Write([]byte(cmd)); err != nil {
+		return "", err
+	}
+	bts, err := ioutil.ReadAll(conn)
+	if err != nil {
+		return "", err
+	}
+	return string(bts), nil
+}
+
+// parseMntr parses lines like 'zk_outstanding_requests	0'.
+func parseMntr(out string) map[string]float64 {
+	vs := make(map[string]float64)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !strings.HasPrefix(fields[0], "zk_") {
+			continue
+		}
+		v, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			// e.g. 'zk_version' and 'zk_server_state'
+			continue
+		}
+		vs[fields[0]] = v
+	}
+	return vs
+}
+
+// parseSrvr parses 'srvr' output to 'mntr' names.
+func parseSrvr(out string) (map[string]float64, error) {
+	vs := make(map[string]float64)
+	for _, line := range strings.Split(out, "\n") {
+		ss := strings.SplitN(line, ":", 2)
+		if len(ss) != 2 {
+			continue
+		}
+		key, val := strings.TrimSpace(ss[0]), strings.TrimSpace(ss[1])
+		switch key {
+		case "Latency min/avg/max":
+			ls := strings.Split(val, "/")
+			if len(ls) != 3 {
+				return nil, fmt.Errorf("unexpected latency %q", val)
+			}
+			for i, name := range []string{"zk_min_latency", "zk_avg_latency", "zk_max_latency"} {
+				v, err := strconv.ParseFloat(ls[i], 64)
+				if err != nil {
+					return nil, err
+				}
+				vs[name] = v
+			}
+		case "Received", "Sent", "Connections", "Outstanding", "Node count":
+			v, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return nil, err
+			}
+			vs[srvrToMntr[key]] = v
+		}
+	}
+	if len(vs) == 0 {
+		return nil, fmt.Errorf("unexpected srvr output %q", out)
+	}
+	return vs, nil
+}
+
+var srvrToMntr = map[string]string{
+	"Received":    "zk_packets_received",
+	"Sent":        "zk_packets_sent",
+	"Connections": "zk_num_alive_connections",
+	"Outstanding": "zk_outstanding_requests",
+	"Node count":  "zk_znode_count",
+}
+
+// countCons counts the connections in 'cons' output, whose lines are like
+// ' /10.0.0.1:52314[1](queued=0,recved=1,sent=1,...)'.
+func countCons(out string) int {
+	n := 0
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(line, "(queued=") {
+			n++
+		}
+	}
+	return n
+}
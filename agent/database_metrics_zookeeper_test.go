@@ -0,0 +1,172 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseMntr(t *testing.T) {
+	tests := []struct {
+		out string
+		exp map[string]float64
+	}{
+		{
+			`zk_version	3.4.9-1757313, built on 08/23/2016 06:50 GMT
+zk_avg_latency	0
+zk_max_latency	24
+zk_min_latency	0
+zk_packets_received	4193
+zk_packets_sent	4192
+zk_num_alive_connections	2
+zk_outstanding_requests	0
+zk_server_state	leader
+zk_znode_count	1004
+zk_watch_count	0
+zk_ephemerals_count	0
+zk_approximate_data_size	27
+zk_open_file_descriptor_count	34
+zk_max_file_descriptor_count	4096
+zk_followers	2
+zk_synced_followers	2
+zk_pending_syncs	0
+`,
+			map[string]float64{
+				"zk_avg_latency":                0,
+				"zk_max_latency":                24,
+				"zk_min_latency":                0,
+				"zk_packets_received":           4193,
+				"zk_packets_sent":               4192,
+				"zk_num_alive_connections":      2,
+				"zk_outstanding_requests":       0,
+				"zk_znode_count":                1004,
+				"zk_watch_count":                0,
+				"zk_ephemerals_count":           0,
+				"zk_approximate_data_size":      27,
+				"zk_open_file_descriptor_count": 34,
+				"zk_max_file_descriptor_count":  4096,
+				"zk_followers":                  2,
+				"zk_synced_followers":           2,
+				"zk_pending_syncs":              0,
+			},
+		},
+		{
+			`zk_version	3.5.3-beta-8ce24f9e675cbefffb8f21a47e06b42864475a60, built on 04/03/2017 16:19 GMT
+zk_avg_latency	1
+zk_server_state	follower
+zk_znode_count	5
+`,
+			map[string]float64{"zk_avg_latency": 1, "zk_znode_count": 5},
+		},
+		{"mntr is not executed because it is not in the whitelist.\n", map[string]float64{}},
+		{"", map[string]float64{}},
+	}
+	for i, tt := range tests {
+		vs := parseMntr(tt.out)
+		if !reflect.DeepEqual(vs, tt.exp) {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.exp, vs)
+		}
+	}
+}
+
+func Test_parseSrvr(t *testing.T) {
+	tests := []struct {
+		out string
+		exp map[string]float64
+		ok  bool
+	}{
+		{
+			`Zookeeper version: 3.4.9-1757313, built on 08/23/2016 06:50 GMT
+Latency min/avg/max: 0/1/24
+Received: 4193
+Sent: 4192
+Connections: 2
+Outstanding: 0
+Zxid: 0x100000f9a
+Mode: leader
+Node count: 1004
+`,
+			map[string]float64{
+				"zk_min_latency":           0,
+				"zk_avg_latency":           1,
+				"zk_max_latency":           24,
+				"zk_packets_received":      4193,
+				"zk_packets_sent":          4192,
+				"zk_num_alive_connections": 2,
+				"zk_outstanding_requests":  0,
+				"zk_znode_count":           1004,
+			},
+			true,
+		},
+		{
+			`Zookeeper version: 3.5.3-beta-8ce24f9e675cbefffb8f21a47e06b42864475a60, built on 04/03/2017 16:19 GMT
+Latency min/avg/max: 0/0/0
+Received: 10
+Sent: 9
+Connections: 1
+Outstanding: 0
+Zxid: 0x0
+Mode: follower
+Node count: 5
+`,
+			map[string]float64{
+				"zk_min_latency":           0,
+				"zk_avg_latency":           0,
+				"zk_max_latency":           0,
+				"zk_packets_received":      10,
+				"zk_packets_sent":          9,
+				"zk_num_alive_connections": 1,
+				"zk_outstanding_requests":  0,
+				"zk_znode_count":           5,
+			},
+			true,
+		},
+		{"Latency min/avg/max: 0/1\n", nil, false},
+		{"Received: many\n", nil, false},
+		{"srvr is not executed because it is not in the whitelist.\n", nil, false},
+	}
+	for i, tt := range tests {
+		vs, err := parseSrvr(tt.out)
+		if (err == nil) != tt.ok {
+			t.Fatalf("#%d: expected ok %v, got error %v", i, tt.ok, err)
+		}
+		if tt.ok && !reflect.DeepEqual(vs, tt.exp) {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.exp, vs)
+		}
+	}
+}
+
+func Test_countCons(t *testing.T) {
+	tests := []struct {
+		out string
+		exp int
+	}{
+		{
+			` /10.240.0.20:52314[1](queued=0,recved=4150,sent=4150,sid=0x15b0dd3a33d0000,lop=SETD,est=1489102367539,to=30000,lcxid=0x1035,lzxid=0x100000f9a,lresp=1489102412035,llat=1,minlat=0,avglat=0,maxlat=24)
+ /127.0.0.1:52316[0](queued=0,recved=1,sent=0)
+
+`,
+			2,
+		},
+		{"\n", 0},
+		{"cons is not executed because it is not in the whitelist.\n", 0},
+	}
+	for i, tt := range tests {
+		if n := countCons(tt.out); n != tt.exp {
+			t.Fatalf("#%d: expected %d, got %d", i, tt.exp, n)
+		}
+	}
+}
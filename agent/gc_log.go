@@ -0,0 +1,96 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/gclog"
+)
+
+// gcLogFlags returns the Java flags to log garbage collections to
+// 'zkGCLog'. Java 8 and earlier do not support unified logging.
+func gcLogFlags(fs *flags, req *dbtesterpb.Request) []string {
+	cmd := exec.Command(fs.javaExec, "-version")
+	if useContainer(req) {
+		ccfg := req.ConfigDatabaseContainer
+		cmd = exec.Command(ccfg.Runtime, "run", "--rm", "--entrypoint", "java", ccfg.Image, "-version")
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		plog.Warningf("%q failed (%v)", strings.Join(cmd.Args, " "), err)
+	}
+	if strings.Contains(string(out), `version "1.`) {
+		return []string{
+			"-Xloggc:" + fs.zkGCLog,
+			"-XX:+PrintGCDetails",
+			"-XX:+PrintGCDateStamps",
+		}
+	}
+	return []string{"-Xlog:gc:file=" + fs.zkGCLog + ":time"}
+}
+
+// gcPauseColumns defines the columns of garbage collection pauses.
+var gcPauseColumns = []string{
+	"UNIX-SECOND",
+	"UNIX-MILLISECOND",
+	"TYPE",
+	"PAUSE-MS",
+	"HEAP-BEFORE-MB",
+	"HEAP-AFTER-MB",
+}
+
+// saveGCPauses parses the garbage collection log, and saves the pauses to 'gcPausesCSV'.
+func saveGCPauses(fs *flags) error {
+	f, err := os.Open(fs.zkGCLog)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	ps, err := gclog.Parse(f)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{gcPauseColumns}
+	for _, p := range ps {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", p.Time.Unix()),
+			fmt.Sprintf("%d", p.Time.UnixNano()/1e6),
+			p.Type,
+			fmt.Sprintf("%.3f", float64(p.Duration)/1e6),
+			fmt.Sprintf("%.2f", float64(p.HeapBeforeBytes)/(1<<20)),
+			fmt.Sprintf("%.2f", float64(p.HeapAfterBytes)/(1<<20)),
+		})
+	}
+	plog.Infof("parsed %d GC pauses from %q", len(ps), fs.zkGCLog)
+
+	wf, err := openToOverwrite(fs.gcPausesCSV)
+	if err != nil {
+		return err
+	}
+	defer wf.Close()
+	wr := csv.NewWriter(wf)
+	if err = wr.WriteAll(rows); err != nil {
+		return err
+	}
+	wr.Flush()
+	return wr.Error()
+}
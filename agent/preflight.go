@@ -0,0 +1,272 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/ntp"
+	"github.com/coreos/dbtester/pkg/syscheck"
+
+	humanize "github.com/dustin/go-humanize"
+	"golang.org/x/net/context"
+)
+
+// preflightMinFreeDiskBytes is the minimum free disk space
+// in the file system of database data directory.
+const preflightMinFreeDiskBytes = 1 << 30
+
+// preflightPeerTimeout is the time to hold the database ports, and
+// to wait for the ports of peers, which 'control' checks at the same time.
+const preflightPeerTimeout = 10 * time.Second
+
+// Preflight checks that the agent machine is ready to run the database
+// in the request. It does not start the database or change agent state.
+func (t *transporterServer) Preflight(ctx context.Context, req *dbtesterpb.Request) (*dbtesterpb.PreflightResponse, error) {
+	received := time.Now()
+	plog.Infof("received preflight request with database %q", req.DatabaseID)
+
+	var (
+		execs     []string
+		dataDir   string
+		zookeeper bool
+	)
+	switch req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__v2_3,
+		dbtesterpb.DatabaseID_etcd__v3_1,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__tip:
+		execs, dataDir = []string{globalFlags.etcdExec}, globalFlags.etcdDataDir
+	case dbtesterpb.DatabaseID_zetcd__beta:
+		execs, dataDir = []string{globalFlags.etcdExec, globalFlags.zetcdExec}, globalFlags.etcdDataDir
+	case dbtesterpb.DatabaseID_cetcd__beta:
+		execs, dataDir = []string{globalFlags.etcdExec, globalFlags.cetcdExec}, globalFlags.etcdDataDir
+	case dbtesterpb.DatabaseID_zookeeper__r3_4_9,
+		dbtesterpb.DatabaseID_zookeeper__r3_5_2_alpha,
+		dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		execs, dataDir, zookeeper = []string{globalFlags.javaExec}, globalFlags.zkDataDir, true
+	case dbtesterpb.DatabaseID_consul__v0_7_5,
+		dbtesterpb.DatabaseID_consul__v0_8_0,
+		dbtesterpb.DatabaseID_consul__v0_8_4:
+		execs, dataDir = []string{globalFlags.consulExec}, globalFlags.consulDataDir
+	default:
+		return nil, fmt.Errorf("unknown database %q", req.DatabaseID)
+	}
+
+	var checks []*dbtesterpb.PreflightCheck
+	add := func(name string, err error, detail string) {
+		ck := &dbtesterpb.PreflightCheck{Name: name, Pass: err == nil, Detail: detail}
+		if err != nil {
+			ck.Detail = err.Error()
+		}
+		checks = append(checks, ck)
+	}
+
+	if bcfg := req.ConfigDatabaseBinary; bcfg != nil && bcfg.Source != "" {
+		// the binary is provisioned on start
+		target, _ := execFlag(&globalFlags, req.DatabaseID)
+		for i := range execs {
+			if execs[i] == *target {
+				execs = append(execs[:i], execs[i+1:]...)
+				break
+			}
+		}
+		var err error
+		if !strings.HasPrefix(bcfg.Source, "http://") && !strings.HasPrefix(bcfg.Source, "https://") {
+			_, err = os.Stat(bcfg.Source)
+		}
+		add("binary source", err, bcfg.Source)
+		add("binary cache directory permission", checkWritable(filepath.Dir(globalFlags.binaryCacheDir)), globalFlags.binaryCacheDir)
+	}
+	for _, fpath := range execs {
+		add("binary "+filepath.Base(fpath), checkExecutable(fpath), fpath)
+	}
+	if zookeeper {
+		out, err := exec.Command(globalFlags.javaExec, "-version").CombinedOutput()
+		if err != nil {
+			err = fmt.Errorf("%q -version failed (%v)", globalFlags.javaExec, err)
+		}
+		add("java version", err, firstLine(string(out)))
+		if req.ConfigDatabaseBinary == nil || req.ConfigDatabaseBinary.Source == "" {
+			add("zookeeper work directory", checkDir(globalFlags.zkWorkDir), globalFlags.zkWorkDir)
+		}
+	}
+
+	if useContainer(req) {
+		rt, err := exec.LookPath(req.ConfigDatabaseContainer.Runtime)
+		add("container runtime", err, rt)
+	}
+
+	parent := filepath.Dir(dataDir)
+	add("data directory permission", checkWritable(parent), parent)
+	free, err := freeDiskBytes(parent)
+	if err == nil && free < preflightMinFreeDiskBytes {
+		err = fmt.Errorf("%s free in %q (expected at least %s)", humanize.Bytes(free), parent, humanize.Bytes(preflightMinFreeDiskBytes))
+	}
+	add("free disk space", err, humanize.Bytes(free))
+
+	add("ntpdate binary", checkExecutable(ntp.DefaultNTP), ntp.DefaultNTP)
+	add("disk device", syscheck.CheckDiskDevice(globalFlags.diskDevice), globalFlags.diskDevice)
+	_, err = net.InterfaceByName(globalFlags.networkInterface)
+	add("network interface", err, globalFlags.networkInterface)
+
+	// hold the database ports until peers are done checking this agent
+	ports := databasePorts(req)
+	holdUntil := time.Now().Add(preflightPeerTimeout)
+	for _, port := range ports {
+		addr := fmt.Sprintf(":%d", port)
+		ln, err := net.Listen("tcp", addr)
+		add("port "+addr, err, "available")
+		if err != nil {
+			continue
+		}
+		go holdPort(ln, holdUntil)
+	}
+
+	peerIPs := strings.Split(req.PeerIPsString, "___")
+	var peerAddrs []string
+	for i, ip := range peerIPs {
+		if uint32(i) == req.IPIndex {
+			continue
+		}
+		for _, port := range ports {
+			peerAddrs = append(peerAddrs, fmt.Sprintf("%s:%d", ip, port))
+		}
+	}
+	errs := make([]error, len(peerAddrs))
+	var wg sync.WaitGroup
+	wg.Add(len(peerAddrs))
+	for i := range peerAddrs {
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = syscheck.CheckReachable(peerAddrs[i], preflightPeerTimeout)
+		}(i)
+	}
+	wg.Wait()
+	for i := range peerAddrs {
+		add("peer "+peerAddrs[i], errs[i], "reachable")
+	}
+
+	return &dbtesterpb.PreflightResponse{Checks: checks, ReceivedUnixNano: received.UnixNano(), UnixNano: time.Now().UnixNano()}, nil
+}
+
+// holdPort accepts and closes connections until the given time,
+// so that peers can check that the port is reachable.
+func holdPort(ln net.Listener, until time.Time) {
+	time.AfterFunc(time.Until(until), func() { ln.Close() })
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		conn.Close()
+	}
+}
+
+// databasePorts returns the ports that the database listens on.
+func databasePorts(req *dbtesterpb.Request) []int64 {
+	switch req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__v2_3,
+		dbtesterpb.DatabaseID_etcd__v3_1,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__tip:
+		return []int64{2379, 2380}
+	case dbtesterpb.DatabaseID_zetcd__beta:
+		if req.ConfigProfile != nil {
+			return []int64{2379, 2380, 2181, proxyProfilePort}
+		}
+		return []int64{2379, 2380, 2181}
+	case dbtesterpb.DatabaseID_cetcd__beta:
+		if req.ConfigProfile != nil {
+			return []int64{2379, 2380, 8500, proxyProfilePort}
+		}
+		return []int64{2379, 2380, 8500}
+	case dbtesterpb.DatabaseID_zookeeper__r3_4_9,
+		dbtesterpb.DatabaseID_zookeeper__r3_5_2_alpha,
+		dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		clientPort := int64(2181)
+		switch {
+		case req.Flag_Zookeeper_R3_4_9 != nil && req.Flag_Zookeeper_R3_4_9.ClientPort > 0:
+			clientPort = req.Flag_Zookeeper_R3_4_9.ClientPort
+		case req.Flag_Zookeeper_R3_5_2Alpha != nil && req.Flag_Zookeeper_R3_5_2Alpha.ClientPort > 0:
+			clientPort = req.Flag_Zookeeper_R3_5_2Alpha.ClientPort
+		case req.Flag_Zookeeper_R3_5_3Beta != nil && req.Flag_Zookeeper_R3_5_3Beta.ClientPort > 0:
+			clientPort = req.Flag_Zookeeper_R3_5_3Beta.ClientPort
+		}
+		return []int64{clientPort, 2888, 3888}
+	case dbtesterpb.DatabaseID_consul__v0_7_5,
+		dbtesterpb.DatabaseID_consul__v0_8_0,
+		dbtesterpb.DatabaseID_consul__v0_8_4:
+		return []int64{8300, 8301, 8302, 8500}
+	}
+	return nil
+}
+
+func checkExecutable(fpath string) error {
+	st, err := os.Stat(fpath)
+	if err != nil {
+		return err
+	}
+	if st.IsDir() || st.Mode()&0111 == 0 {
+		return fmt.Errorf("%q is not executable", fpath)
+	}
+	return nil
+}
+
+func checkDir(fpath string) error {
+	st, err := os.Stat(fpath)
+	if err != nil {
+		return err
+	}
+	if !st.IsDir() {
+		return fmt.Errorf("%q is not a directory", fpath)
+	}
+	return nil
+}
+
+// checkWritable creates and removes a temporary file in the directory.
+func checkWritable(dir string) error {
+	if err := checkDir(dir); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, ".dbtester-preflight")
+	if err != nil {
+		return err
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
+
+func freeDiskBytes(dir string) (uint64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(dir, &st); err != nil {
+		return 0, err
+	}
+	return st.Bavail * uint64(st.Bsize), nil
+}
+
+func firstLine(s string) string {
+	return strings.TrimSpace(strings.SplitN(strings.TrimSpace(s), "\n", 2)[0])
+}
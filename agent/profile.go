@@ -0,0 +1,122 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// proxyProfilePort is the port of '/debug/pprof' of zetcd and cetcd proxies.
+const proxyProfilePort = 6060
+
+// profileTarget is a database process that serves '/debug/pprof'.
+type profileTarget struct {
+	name string
+	addr string
+}
+
+// profileTargets returns the database processes to profile.
+func profileTargets(req *dbtesterpb.Request) []profileTarget {
+	ip := strings.Split(req.PeerIPsString, "___")[req.IPIndex]
+	switch req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__v2_3,
+		dbtesterpb.DatabaseID_etcd__v3_1,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__tip:
+		return []profileTarget{{"etcd", ip + ":2379"}}
+	case dbtesterpb.DatabaseID_zetcd__beta:
+		return []profileTarget{{"etcd", ip + ":2379"}, {"zetcd", fmt.Sprintf("%s:%d", ip, proxyProfilePort)}}
+	case dbtesterpb.DatabaseID_cetcd__beta:
+		return []profileTarget{{"etcd", ip + ":2379"}, {"cetcd", fmt.Sprintf("%s:%d", ip, proxyProfilePort)}}
+	case dbtesterpb.DatabaseID_consul__v0_7_5,
+		dbtesterpb.DatabaseID_consul__v0_8_0,
+		dbtesterpb.DatabaseID_consul__v0_8_4:
+		return []profileTarget{{"consul", ip + ":8500"}}
+	}
+	return nil
+}
+
+// resetProfileDir removes the profiles of previous run.
+func resetProfileDir(fs *flags) error {
+	if err := os.RemoveAll(fs.profileDir); err != nil {
+		return err
+	}
+	return os.MkdirAll(fs.profileDir, 0777)
+}
+
+// captureProfiles saves the CPU profile, heap profile and goroutine
+// dump of the database processes to 'profileDir'. It skips if the
+// previous capture is still in progress.
+func (t *transporterServer) captureProfiles(fs *flags) {
+	if !atomic.CompareAndSwapInt32(&t.profiling, 0, 1) {
+		plog.Warningf("profiles are being captured; skipping")
+		return
+	}
+	defer atomic.StoreInt32(&t.profiling, 0)
+
+	cpuSecond := t.req.ConfigProfile.CPUSecond
+	cli := &http.Client{Timeout: time.Duration(cpuSecond)*time.Second + 30*time.Second}
+	ts := time.Now().Unix()
+
+	var wg sync.WaitGroup
+	for _, target := range profileTargets(&t.req) {
+		for _, kind := range []struct{ name, path, ext string }{
+			{"cpu", fmt.Sprintf("/debug/pprof/profile?seconds=%d", cpuSecond), "pprof"},
+			{"heap", "/debug/pprof/heap", "pprof"},
+			{"goroutine", "/debug/pprof/goroutine?debug=2", "txt"},
+		} {
+			url := "http://" + target.addr + kind.path
+			fpath := filepath.Join(fs.profileDir, fmt.Sprintf("%s-%d-%s.%s", target.name, ts, kind.name, kind.ext))
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := saveProfile(cli, url, fpath); err != nil {
+					plog.Warningf("failed to save profile %q (%v)", url, err)
+					return
+				}
+				plog.Infof("saved profile %q to %q", url, fpath)
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+func saveProfile(cli *http.Client, url, fpath string) error {
+	resp, err := cli.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%q returned %q", url, resp.Status)
+	}
+	f, err := openToOverwrite(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, resp.Body)
+	return err
+}
@@ -19,6 +19,7 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -51,6 +52,25 @@ type transporterServer struct {
 
 	metricsCSV *inspect.CSV
 
+	// health is the latest resource usage of the database process,
+	// updated by the metrics collector
+	healthMu sync.Mutex
+	health   dbtesterpb.Health
+
+	// cgroupDir is the cgroup of database processes, if limited;
+	// psiMu protects it and psiRows, appended by the metrics collector
+	psiMu     sync.Mutex
+	cgroupDir string
+	psiRows   [][]string
+
+	// dbMetrics scrapes the database metrics endpoint, if any
+	dbMetrics *databaseMetricsScraper
+
+	// profiling is 1 while capturing profiles, and
+	// profileWg waits for captures to finish writing
+	profiling int32
+	profileWg sync.WaitGroup
+
 	// trigger log uploads to cloud storage
 	// this should be triggered before we shut down
 	// the agent server
@@ -75,11 +95,25 @@ func NewServer() dbtesterpb.TransporterServer {
 }
 
 func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Request) (*dbtesterpb.Response, error) {
+	if req != nil && req.Operation == dbtesterpb.Operation_Status {
+		// polled every second by dashboard; do not log
+		return &dbtesterpb.Response{Success: true, Health: t.getHealth()}, nil
+	}
 	if req != nil {
 		plog.Infof("received gRPC request %q with database %q (clients: %d)", req.Operation, req.DatabaseID, req.CurrentClientNumber)
 	}
 
+	// flags to start the database, with provisioned binary
+	fs := globalFlags
+	var binaryVersion, binarySHA256 string
+
 	if req.Operation == dbtesterpb.Operation_Start {
+		var err error
+		if binaryVersion, binarySHA256, err = provisionBinary(&fs, req); err != nil {
+			plog.Errorf("provisionBinary error %v", err)
+			return nil, err
+		}
+
 		f, err := openToAppend(globalFlags.databaseLog)
 		if err != nil {
 			return nil, err
@@ -98,6 +132,12 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			plog.Infof("proxy-database log path: %q", proxyLog)
 		}
 		plog.Infof("system metrics CSV path: %q", globalFlags.systemMetricsCSV)
+		if req.ConfigProfile != nil {
+			if err := resetProfileDir(&fs); err != nil {
+				return nil, err
+			}
+			plog.Infof("profile directory: %q", globalFlags.profileDir)
+		}
 
 		switch req.DatabaseID {
 		case dbtesterpb.DatabaseID_etcd__v2_3,
@@ -135,9 +175,17 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 		t.req.CurrentClientNumber = req.CurrentClientNumber
 	}
 
-	var diskSpaceUsageBytes int64
+	var (
+		diskSpaceUsageBytes int64
+		maxRSSBytes         uint64
+	)
 	switch req.Operation {
 	case dbtesterpb.Operation_Start:
+		if err := startCgroup(&globalFlags, t); err != nil {
+			plog.Errorf("startCgroup error %v", err)
+			return nil, err
+		}
+
 		switch t.req.DatabaseID {
 		case dbtesterpb.DatabaseID_etcd__v2_3,
 			dbtesterpb.DatabaseID_etcd__v3_1,
@@ -145,13 +193,13 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			dbtesterpb.DatabaseID_etcd__tip,
 			dbtesterpb.DatabaseID_zetcd__beta,
 			dbtesterpb.DatabaseID_cetcd__beta:
-			if err := startEtcd(&globalFlags, t); err != nil {
+			if err := startEtcd(&fs, t); err != nil {
 				plog.Errorf("startEtcd error %v", err)
 				return nil, err
 			}
 			switch t.req.DatabaseID {
 			case dbtesterpb.DatabaseID_zetcd__beta:
-				if err := startZetcd(&globalFlags, t); err != nil {
+				if err := startZetcd(&fs, t); err != nil {
 					plog.Errorf("startZetcd error %v", err)
 					return nil, err
 				}
@@ -164,7 +212,7 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 					plog.Infof("exiting %q", t.proxyCmd.Path)
 				}()
 			case dbtesterpb.DatabaseID_cetcd__beta:
-				if err := startCetcd(&globalFlags, t); err != nil {
+				if err := startCetcd(&fs, t); err != nil {
 					plog.Errorf("startCetcd error %v", err)
 					return nil, err
 				}
@@ -180,14 +228,14 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 		case dbtesterpb.DatabaseID_zookeeper__r3_4_9,
 			dbtesterpb.DatabaseID_zookeeper__r3_5_2_alpha,
 			dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
-			if err := startZookeeper(&globalFlags, t); err != nil {
+			if err := startZookeeper(&fs, t); err != nil {
 				plog.Errorf("startZookeeper error %v", err)
 				return nil, err
 			}
 		case dbtesterpb.DatabaseID_consul__v0_7_5,
 			dbtesterpb.DatabaseID_consul__v0_8_0,
 			dbtesterpb.DatabaseID_consul__v0_8_4:
-			if err := startConsul(&globalFlags, t); err != nil {
+			if err := startConsul(&fs, t); err != nil {
 				plog.Errorf("startConsul error %v", err)
 				return nil, err
 			}
@@ -218,6 +266,10 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 		plog.Infof("waiting a few more seconds before stopping %q", t.cmd.Path)
 		time.Sleep(3 * time.Second)
 
+		// profiles are read from the database, and uploaded with logs
+		plog.Infof("waiting for profile captures to finish")
+		t.profileWg.Wait()
+
 		// TODO: https://github.com/coreos/dbtester/issues/330
 		plog.Infof("sending %q to %q [PID: %d]", syscall.SIGINT, t.cmd.Path, t.pid)
 		if err := t.cmd.Process.Signal(syscall.SIGINT); err != nil {
@@ -258,6 +310,8 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 
 		t.uploadSig <- struct{}{}
 		<-t.csvReady
+		stopCgroup(t)
+		maxRSSBytes = t.maxRSSBytes()
 
 		if t.req.TriggerLogUpload {
 			if err := uploadLog(&globalFlags, t); err != nil {
@@ -279,12 +333,28 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			return nil, err
 		}
 
+	case dbtesterpb.Operation_Profile:
+		if t.req.ConfigProfile == nil {
+			return nil, fmt.Errorf("%q has no profile configuration", t.req.DatabaseID)
+		}
+		t.profileWg.Add(1)
+		go func() {
+			defer t.profileWg.Done()
+			t.captureProfiles(&fs)
+		}()
+
 	default:
 		return nil, fmt.Errorf("Not implemented %v", req.Operation)
 	}
 
 	plog.Info("Transfer success!")
-	return &dbtesterpb.Response{Success: true, DiskSpaceUsageBytes: diskSpaceUsageBytes}, nil
+	return &dbtesterpb.Response{
+		Success:             true,
+		DiskSpaceUsageBytes: diskSpaceUsageBytes,
+		MaxRSSBytes:         maxRSSBytes,
+		BinaryVersion:       binaryVersion,
+		BinarySHA256:        binarySHA256,
+	}, nil
 }
 
 func measureDatabasSize(flg flags, rdb dbtesterpb.DatabaseID) (int64, error) {
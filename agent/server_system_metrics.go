@@ -19,6 +19,8 @@ import (
 	"os"
 	"time"
 
+	"github.com/coreos/dbtester/dbtesterpb"
+
 	"github.com/gyuho/linux-inspect/inspect"
 	"github.com/gyuho/linux-inspect/top"
 )
@@ -54,6 +56,7 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 	if err := t.metricsCSV.Add(); err != nil {
 		return err
 	}
+	t.dbMetrics = newDatabaseMetricsScraper(&t.req)
 
 	go func() {
 		for {
@@ -63,6 +66,13 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 					plog.Errorf("inspect.CSV.Add error (%v)", err)
 					continue
 				}
+				t.setHealth(t.metricsCSV.Rows[len(t.metricsCSV.Rows)-1])
+				if err := t.addPSI(); err != nil {
+					plog.Errorf("addPSI error (%v)", err)
+				}
+				if t.dbMetrics != nil {
+					t.dbMetrics.scrape()
+				}
 
 			case <-t.uploadSig:
 				plog.Infof("upload signal received; saving CSV at %q", t.metricsCSV.FilePath)
@@ -84,6 +94,26 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 					plog.Infof("CSV saved at %q", interpolated.FilePath)
 				}
 
+				if err := savePSI(fs, t); err != nil {
+					plog.Errorf("savePSI(%q) error %v", fs.psiCSV, err)
+				}
+
+				if t.req.GCLog {
+					if err := saveGCPauses(fs); err != nil {
+						plog.Errorf("saveGCPauses(%q) error %v", fs.zkGCLog, err)
+					} else {
+						plog.Infof("CSV saved at %q", fs.gcPausesCSV)
+					}
+				}
+
+				if t.dbMetrics != nil {
+					if err := t.dbMetrics.save(fs.databaseMetricsCSV); err != nil {
+						plog.Errorf("databaseMetricsScraper.save(%q) error %v", fs.databaseMetricsCSV, err)
+					} else {
+						plog.Infof("CSV saved at %q", fs.databaseMetricsCSV)
+					}
+				}
+
 				close(t.csvReady)
 				return
 
@@ -95,3 +125,40 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 	}()
 	return nil
 }
+
+// setHealth updates the health with the latest row of system metrics,
+// which are collected every second.
+func (t *transporterServer) setHealth(row inspect.Proc) {
+	t.healthMu.Lock()
+	t.health = dbtesterpb.Health{
+		UnixNano:                row.UnixNanosecond,
+		CPUPercent:              row.PSEntry.CPUNum,
+		RSSBytes:                row.PSEntry.VMRSSNum,
+		DiskReadBytesPerSecond:  row.ReadBytesDelta,
+		DiskWriteBytesPerSecond: row.WriteBytesDelta,
+	}
+	t.healthMu.Unlock()
+}
+
+// getHealth returns the latest health of the database process.
+func (t *transporterServer) getHealth() *dbtesterpb.Health {
+	t.healthMu.Lock()
+	h := t.health
+	t.healthMu.Unlock()
+	return &h
+}
+
+// maxRSSBytes returns the maximum resident set size of the database process
+// in the collected system metrics. It must be called after the collector stops.
+func (t *transporterServer) maxRSSBytes() uint64 {
+	if t.metricsCSV == nil {
+		return 0
+	}
+	var max uint64
+	for _, row := range t.metricsCSV.Rows {
+		if row.PSEntry.VMRSSNum > max {
+			max = row.PSEntry.VMRSSNum
+		}
+	}
+	return max
+}
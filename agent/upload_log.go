@@ -16,6 +16,7 @@ package agent
 
 import (
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
 	"strings"
 	"time"
@@ -125,6 +126,98 @@ func uploadLog(fs *flags, t *transporterServer) error {
 		}
 	}
 
+	if t.req.ConfigDatabaseCgroup != nil {
+		srcPSIDataPath := fs.psiCSV
+		dstPSIDataPath := filepath.Base(fs.psiCSV)
+		if !strings.HasPrefix(filepath.Base(fs.psiCSV), t.req.DatabaseTag) {
+			dstPSIDataPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.psiCSV))
+		}
+		dstPSIDataPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstPSIDataPath)
+		plog.Infof("uploading pressure stall information data [%q -> %q]", srcPSIDataPath, dstPSIDataPath)
+		for k := 0; k < 30; k++ {
+			if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcPSIDataPath, dstPSIDataPath); uerr != nil {
+				plog.Warningf("upload error... sleep and retry... (%v)", uerr)
+				time.Sleep(2 * time.Second)
+				continue
+			} else {
+				break
+			}
+		}
+		if uerr != nil {
+			return uerr
+		}
+	}
+
+	if t.dbMetrics != nil {
+		srcDatabaseMetricsDataPath := fs.databaseMetricsCSV
+		dstDatabaseMetricsDataPath := filepath.Base(fs.databaseMetricsCSV)
+		if !strings.HasPrefix(filepath.Base(fs.databaseMetricsCSV), t.req.DatabaseTag) {
+			dstDatabaseMetricsDataPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.databaseMetricsCSV))
+		}
+		dstDatabaseMetricsDataPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstDatabaseMetricsDataPath)
+		plog.Infof("uploading database metrics data [%q -> %q]", srcDatabaseMetricsDataPath, dstDatabaseMetricsDataPath)
+		for k := 0; k < 30; k++ {
+			if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcDatabaseMetricsDataPath, dstDatabaseMetricsDataPath); uerr != nil {
+				plog.Warningf("upload error... sleep and retry... (%v)", uerr)
+				time.Sleep(2 * time.Second)
+				continue
+			} else {
+				break
+			}
+		}
+		if uerr != nil {
+			return uerr
+		}
+	}
+
+	if t.req.GCLog {
+		for _, src := range []string{fs.zkGCLog, fs.gcPausesCSV} {
+			dst := filepath.Base(src)
+			if !strings.HasPrefix(filepath.Base(src), t.req.DatabaseTag) {
+				dst = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(src))
+			}
+			dst = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dst)
+			plog.Infof("uploading garbage collection data [%q -> %q]", src, dst)
+			for k := 0; k < 30; k++ {
+				if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, src, dst); uerr != nil {
+					plog.Warningf("upload error... sleep and retry... (%v)", uerr)
+					time.Sleep(2 * time.Second)
+					continue
+				} else {
+					break
+				}
+			}
+			if uerr != nil {
+				return uerr
+			}
+		}
+	}
+
+	if t.req.ConfigProfile != nil {
+		fis, err := ioutil.ReadDir(fs.profileDir)
+		if err != nil {
+			return err
+		}
+		for _, fi := range fis {
+			src := filepath.Join(fs.profileDir, fi.Name())
+			dst := fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, fi.Name())
+			dst = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dst)
+			plog.Infof("uploading profile [%q -> %q]", src, dst)
+			for k := 0; k < 30; k++ {
+				if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, src, dst); uerr != nil {
+					plog.Warningf("upload error... sleep and retry... (%v)", uerr)
+					time.Sleep(2 * time.Second)
+					continue
+				} else {
+					break
+				}
+			}
+			if uerr != nil {
+				return uerr
+			}
+		}
+	}
+
 	{
 		srcAgentLogPath := fs.agentLog
 		dstAgentLogPath := filepath.Base(fs.agentLog)
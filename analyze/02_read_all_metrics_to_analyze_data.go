@@ -33,6 +33,9 @@ type analyzeData struct {
 	benchMetricsFilePath string
 	benchMetrics         testData
 
+	// interval of benchmark metrics rows, which divides 1000
+	sampleIntervalMillisecond int64
+
 	// aggregated from sysAgg and benchMetrics
 	aggregated dataframe.Frame
 
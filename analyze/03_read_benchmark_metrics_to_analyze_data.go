@@ -31,13 +31,25 @@ func (data *analyzeData) importBenchMetrics(fpath string) (err error) {
 		return
 	}
 
+	// results by sub-second sample have unix milliseconds,
+	// otherwise use unix seconds as milliseconds
 	var oldTSCol dataframe.Column
-	oldTSCol, err = tdf.Column("UNIX-SECOND")
+	msScale := int64(1)
+	oldTSCol, err = tdf.Column("UNIX-MILLISECOND")
 	if err != nil {
-		return err
+		oldTSCol, err = tdf.Column("UNIX-SECOND")
+		if err != nil {
+			return err
+		}
+		msScale = 1000
+	}
+	sampleIntervalMillisecond, err := findSampleInterval(oldTSCol, msScale)
+	if err != nil {
+		return fmt.Errorf("%s: %v", fpath, err)
 	}
+	data.sampleIntervalMillisecond = sampleIntervalMillisecond
 
-	// get first(minimum) unix second
+	// get first(minimum) unix millisecond
 	fv1, ok := oldTSCol.FrontNonNil()
 	if !ok {
 		return fmt.Errorf("FrontNonNil %s has empty Unix time %v", fpath, fv1)
@@ -46,9 +58,10 @@ func (data *analyzeData) importBenchMetrics(fpath string) (err error) {
 	if !ok {
 		return fmt.Errorf("cannot Int64 %v", fv1)
 	}
-	data.benchMetrics.frontUnixSecond = int64(ivv1)
+	frontUnixMillisecond := int64(ivv1) * msScale
+	data.benchMetrics.frontUnixSecond = frontUnixMillisecond / 1000
 
-	// get last(maximum) unix second
+	// get last(maximum) unix millisecond
 	fv2, ok := oldTSCol.BackNonNil()
 	if !ok {
 		return fmt.Errorf("BackNonNil %s has empty Unix time %v", fpath, fv2)
@@ -57,7 +70,8 @@ func (data *analyzeData) importBenchMetrics(fpath string) (err error) {
 	if !ok {
 		return fmt.Errorf("cannot Int64 %v", fv2)
 	}
-	data.benchMetrics.lastUnixSecond = int64(ivv2)
+	lastUnixMillisecond := int64(ivv2) * msScale
+	data.benchMetrics.lastUnixSecond = lastUnixMillisecond / 1000
 
 	// UNIX-SECOND, CONTROL-CLIENT-NUM, MIN-LATENCY-MS, AVG-LATENCY-MS, MAX-LATENCY-MS, AVG-THROUGHPUT
 	var oldControlClientNumCol dataframe.Column
@@ -86,7 +100,7 @@ func (data *analyzeData) importBenchMetrics(fpath string) (err error) {
 		return err
 	}
 
-	sec2Data := make(map[int64]rowData)
+	ms2Data := make(map[int64]rowData)
 	for i := 0; i < oldTSCol.Count(); i++ {
 		tv, err := oldTSCol.Value(i)
 		if err != nil {
@@ -96,6 +110,7 @@ func (data *analyzeData) importBenchMetrics(fpath string) (err error) {
 		if !ok {
 			return fmt.Errorf("cannot Int64 %v", tv)
 		}
+		ts *= msScale
 
 		cv, err := oldControlClientNumCol.Value(i)
 		if err != nil {
@@ -144,14 +159,14 @@ func (data *analyzeData) importBenchMetrics(fpath string) (err error) {
 		}
 
 		// handle duplicate timestamps
-		if v, ok := sec2Data[ts]; !ok {
-			sec2Data[ts] = rowData{clientN: cn, minLat: minLat, avgLat: avgLat, maxLat: maxLat, throughput: dataThr}
+		if v, ok := ms2Data[ts]; !ok {
+			ms2Data[ts] = rowData{clientN: cn, minLat: minLat, avgLat: avgLat, maxLat: maxLat, throughput: dataThr}
 		} else {
 			// it is possible that there are duplicate timestamps with
 			// different client numbers, when clients number bump up
-			// these requests happen within this unix second, add up the
+			// these requests happen within this sample, add up the
 			// throughput, and select min,max and avg of latencies
-			sec2Data[ts] = rowData{
+			ms2Data[ts] = rowData{
 				clientN:    cn,
 				minLat:     minFloat64(v.minLat, minLat),
 				avgLat:     (v.avgLat + avgLat) / 2.0,
@@ -166,21 +181,23 @@ func (data *analyzeData) importBenchMetrics(fpath string) (err error) {
 	// OR fill in missing timestamps with zero values
 	//
 	// expected row number
-	expectedRowN := data.benchMetrics.lastUnixSecond - data.benchMetrics.frontUnixSecond + 1
+	expectedRowN := (lastUnixMillisecond-frontUnixMillisecond)/sampleIntervalMillisecond + 1
 	newSecondCol := dataframe.NewColumn("UNIX-SECOND")
+	newMillisecondCol := dataframe.NewColumn("UNIX-MILLISECOND")
 	newControlClientNumCol := dataframe.NewColumn("CONTROL-CLIENT-NUM")
 	newMinLatencyCol := dataframe.NewColumn("MIN-LATENCY-MS")
 	newAvgLatencyCol := dataframe.NewColumn("AVG-LATENCY-MS")
 	newMaxLatencyCol := dataframe.NewColumn("MAX-LATENCY-MS")
 	newAvgThroughputCol := dataframe.NewColumn("AVG-THROUGHPUT")
 	for i := int64(0); i < expectedRowN; i++ {
-		second := data.benchMetrics.frontUnixSecond + i
-		newSecondCol.PushBack(dataframe.NewStringValue(second))
+		ms := frontUnixMillisecond + i*sampleIntervalMillisecond
+		newSecondCol.PushBack(dataframe.NewStringValue(ms / 1000))
+		newMillisecondCol.PushBack(dataframe.NewStringValue(ms))
 
-		v, ok := sec2Data[second]
+		v, ok := ms2Data[ms]
 		if !ok {
 			// fill-in missing rows with closest row
-			closest := findClosest(second, sec2Data)
+			closest := findClosest(ms, sampleIntervalMillisecond, ms2Data)
 			newControlClientNumCol.PushBack(dataframe.NewStringValue(closest.clientN))
 			newMinLatencyCol.PushBack(dataframe.NewStringValue(0.0))
 			newAvgLatencyCol.PushBack(dataframe.NewStringValue(0.0))
@@ -215,11 +232,43 @@ func (data *analyzeData) importBenchMetrics(fpath string) (err error) {
 	if err = df.AddColumn(newAvgThroughputCol); err != nil {
 		return err
 	}
+	if sampleIntervalMillisecond < 1000 {
+		if err = df.AddColumn(newMillisecondCol); err != nil {
+			return err
+		}
+	}
 
 	data.benchMetrics.frame = df
 	return
 }
 
+// findSampleInterval returns the interval of timestamps in milliseconds,
+// which is the smallest difference of consecutive timestamps. It returns
+// 1000 if there is only one timestamp.
+func findSampleInterval(tsCol dataframe.Column, msScale int64) (int64, error) {
+	interval := int64(1000)
+	var prev int64
+	for i := 0; i < tsCol.Count(); i++ {
+		tv, err := tsCol.Value(i)
+		if err != nil {
+			return 0, err
+		}
+		ts, ok := tv.Int64()
+		if !ok {
+			return 0, fmt.Errorf("cannot Int64 %v", tv)
+		}
+		ts *= msScale
+		if i > 0 && ts > prev && ts-prev < interval {
+			interval = ts - prev
+		}
+		prev = ts
+	}
+	if 1000%interval != 0 {
+		return 0, fmt.Errorf("sample interval %d ms does not divide 1000 ms", interval)
+	}
+	return interval, nil
+}
+
 type rowData struct {
 	clientN    int64
 	minLat     float64
@@ -228,14 +277,16 @@ type rowData struct {
 	throughput float64
 }
 
-func findClosest(second int64, sec2Data map[int64]rowData) rowData {
-	v, ok := sec2Data[second]
+// findClosest returns the row of the closest sample, in unix milliseconds
+// by 'step', preferring the earlier samples.
+func findClosest(ms, step int64, ms2Data map[int64]rowData) rowData {
+	v, ok := ms2Data[ms]
 	if ok {
 		return v
 	}
 	var min int64
 	var max int64
-	for k := range sec2Data {
+	for k := range ms2Data {
 		if min == 0 || min > k {
 			min = k
 		}
@@ -243,35 +294,35 @@ func findClosest(second int64, sec2Data map[int64]rowData) rowData {
 			max = k
 		}
 	}
-	r, ok := _findClosestLower(second, sec2Data, min, max)
+	r, ok := _findClosestLower(ms, step, ms2Data, min, max)
 	if ok {
 		return r
 	}
-	r, ok = _findClosestUpper(second, sec2Data, min, max)
+	r, ok = _findClosestUpper(ms, step, ms2Data, min, max)
 	if !ok {
 		panic(fmt.Errorf("something wrong with benchmark data... too many data points are missing"))
 	}
 	return r
 }
 
-func _findClosestUpper(second int64, sec2Data map[int64]rowData, min, max int64) (rowData, bool) {
-	if second < min || second > max {
+func _findClosestUpper(ms, step int64, ms2Data map[int64]rowData, min, max int64) (rowData, bool) {
+	if ms < min || ms > max {
 		return rowData{}, false
 	}
-	v, ok := sec2Data[second]
+	v, ok := ms2Data[ms]
 	if ok {
 		return v, true
 	}
-	return _findClosestUpper(second+1, sec2Data, min, max)
+	return _findClosestUpper(ms+step, step, ms2Data, min, max)
 }
 
-func _findClosestLower(second int64, sec2Data map[int64]rowData, min, max int64) (rowData, bool) {
-	if second < min || second > max {
+func _findClosestLower(ms, step int64, ms2Data map[int64]rowData, min, max int64) (rowData, bool) {
+	if ms < min || ms > max {
 		return rowData{}, false
 	}
-	v, ok := sec2Data[second]
+	v, ok := ms2Data[ms]
 	if ok {
 		return v, true
 	}
-	return _findClosestLower(second-1, sec2Data, min, max)
+	return _findClosestLower(ms-step, step, ms2Data, min, max)
 }
@@ -66,6 +66,22 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 	// this is index, so decrement by 1 to make it as valid index
 	minBenchEndIdx--
 
+	// system metrics are by second, while benchmark metrics can be by
+	// sub-second sample, so each benchmark row is matched to the system
+	// metrics row of its second (same row, if benchmark is by second)
+	front := data.benchMetrics.frontUnixSecond * 1000
+	if colBenchMs, err := data.benchMetrics.frame.Column("UNIX-MILLISECOND"); err == nil {
+		mv, ok := colBenchMs.FrontNonNil()
+		if !ok {
+			return fmt.Errorf("FrontNonNil %s has empty Unix time %v", data.benchMetrics.filePath, mv)
+		}
+		front, _ = mv.Int64()
+	}
+	sysOffset := func(benchIdx int) int {
+		ms := front + int64(benchIdx)*data.sampleIntervalMillisecond
+		return int(ms/1000 - front/1000)
+	}
+
 	// sysStartIdx 3, sysEndIdx 9, expectedSysRowN 7, minBenchEndIdx 5 (5+1 < 7)
 	// so benchmark has 6 rows, but system metrics has 7 rows; benchmark is short of rows
 	// so we should keep system metrics [3, 9)
@@ -75,16 +91,18 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 	// so benchmark has 6 rows, but system metrics has 5 rows; system metrics is short of rows
 	// so we should keep benchmark [0, 5)
 	// THEN minBenchEndIdx = 7 - 3 = 4 (keep [0, 4+1))
-	if minBenchEndIdx+1 < expectedSysRowN {
+	if sysOffset(minBenchEndIdx)+1 < expectedSysRowN {
 		// benchmark is short of rows
 		// adjust system metrics rows to benchmark-metrics
 		// will truncate front of system metrics rows
-		sysEndIdx = sysStartIdx + minBenchEndIdx
+		sysEndIdx = sysStartIdx + sysOffset(minBenchEndIdx)
 	} else {
 		// system metrics is short of rows
 		// adjust benchmark metrics to system-metrics
 		// will truncate front of benchmark metrics rows
-		minBenchEndIdx = sysEndIdx - sysStartIdx
+		for sysOffset(minBenchEndIdx) > sysEndIdx-sysStartIdx {
+			minBenchEndIdx--
+		}
 	}
 
 	// aggregate all system-metrics and benchmark-metrics
@@ -106,7 +124,18 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 		if col.Header() == "UNIX-SECOND" {
 			continue
 		}
-		if err = col.Keep(sysStartIdx, sysEndIdx+1); err != nil {
+		if data.sampleIntervalMillisecond < 1000 {
+			// repeat the system metrics of each second for its samples
+			ncol := dataframe.NewColumn(col.Header())
+			for i := 0; i <= minBenchEndIdx; i++ {
+				v, err := col.Value(sysStartIdx + sysOffset(i))
+				if err != nil {
+					return err
+				}
+				ncol.PushBack(v)
+			}
+			col = ncol
+		} else if err = col.Keep(sysStartIdx, sysEndIdx+1); err != nil {
 			return err
 		}
 		if err = data.aggregated.AddColumn(col); err != nil {
@@ -187,7 +216,8 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 			switch {
 			// cumulative values
 			case hd == "AVG-THROUGHPUT":
-				requestSum += int(vv)
+				// throughput is requests per second, so scale by sample interval
+				requestSum += int(vv) * int(data.sampleIntervalMillisecond) / 1000
 				cumulativeThroughputCol.PushBack(dataframe.NewStringValue(requestSum))
 
 			// average values (need sume first!)
@@ -353,6 +383,10 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 	}
 	secondCol := dataframe.NewColumn("SECOND")
 	for i := 0; i < uc.Count(); i++ {
+		if data.sampleIntervalMillisecond < 1000 {
+			secondCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.3f", float64(int64(i)*data.sampleIntervalMillisecond)/1000)))
+			continue
+		}
 		secondCol.PushBack(dataframe.NewStringValue(i))
 	}
 	if err = data.aggregated.AddColumn(secondCol); err != nil {
@@ -485,7 +519,7 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 
 		point := dbtester.CumulativeKeyNumAndOtherData{
 			UnixSecond: v0,
-			Throughput: int64(vf2),
+			Throughput: int64(vf2) * data.sampleIntervalMillisecond / 1000,
 
 			MinMemoryMB: sec2minVMRSSMB[v0],
 			AvgMemoryMB: vf1,
@@ -37,6 +37,17 @@ func init() {
 	plotter.DefaultGlyphStyle.Radius = vg.Points(2.0)
 }
 
+// savePlot saves the plot to 'outputPath' with '.svg' and '.png' extensions.
+func savePlot(plt *plot.Plot, outputPath string, w, h vg.Length) error {
+	for _, ext := range []string{".svg", ".png"} {
+		plog.Printf("plotting %q", outputPath+ext)
+		if err := plt.Save(w, h, outputPath+ext); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type pair struct {
 	x dataframe.Column
 	y dataframe.Column
@@ -63,7 +74,12 @@ func (all *allAggregatedData) draw(cfg dbtesterpb.ConfigAnalyzeMachinePlot, pair
 
 	var ps []plot.Plotter
 	for i, p := range pairs {
-		pt, err := points(p.y)
+		var pt plotter.XYs
+		if p.x != nil {
+			pt, err = pointsXY(p.x, p.y)
+		} else {
+			pt, err = points(p.y)
+		}
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,214 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/gonum/plot"
+	"github.com/gonum/plot/plotter"
+	"github.com/gonum/plot/plotutil"
+	"github.com/olekukonko/tablewriter"
+)
+
+// matrixSummaryColumns defines cross-combination comparison columns.
+var matrixSummaryColumns = []string{
+	"MATRIX-COMBINATION-INDEX",
+	"MATRIX-COMBINATION",
+	"DATABASE-TAG",
+	"REQUESTS-PER-SECOND",
+	"AVERAGE-LATENCY-MS",
+	"P99-LATENCY-MS",
+	"ERROR-COUNT",
+}
+
+type matrixResult struct {
+	throughput float64
+	avgLatency float64
+	p99Latency float64
+	errCount   int64
+}
+
+// compareMatrixCombinations compares the results of all matrix combinations,
+// and saves the comparison tables and plots in the parent directory of
+// each combination's plot directory. Each group has the configurations
+// of all trials of one combination, whose results are averaged.
+func compareMatrixCombinations(groups [][]*dbtester.Config) error {
+	cfgs := make([]*dbtester.Config, len(groups))
+	for i, group := range groups {
+		cfgs[i] = group[0]
+	}
+	outputDir := dbtester.TrimRunDir(cfgs[0].AnalyzePlotPathPrefix, cfgs[0].RunName())
+
+	// combination index to database ID to result
+	results := make([]map[string]matrixResult, len(groups))
+	for i, group := range groups {
+		results[i] = make(map[string]matrixResult)
+		for _, databaseID := range group[0].AllDatabaseIDList {
+			var rs matrixResult
+			for _, cfg := range group {
+				testdata := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+				mv, err := readResultMetrics(testdata.ClientLatencyDistributionSummaryPath, testdata.ClientLatencyDistributionPercentilePath)
+				if err != nil {
+					return err
+				}
+				rs.throughput += mv["REQUESTS-PER-SECOND"] / float64(len(group))
+				rs.avgLatency += mv["AVERAGE-LATENCY-MS"] / float64(len(group))
+				rs.p99Latency += mv["p99"] / float64(len(group))
+				rs.errCount += int64(mv["ERROR-COUNT"])
+			}
+			results[i][databaseID] = rs
+		}
+	}
+
+	rows := [][]string{matrixSummaryColumns}
+	for i, cfg := range cfgs {
+		for _, databaseID := range cfg.AllDatabaseIDList {
+			rs := results[i][databaseID]
+			rows = append(rows, []string{
+				fmt.Sprintf("%d", i+1),
+				cfg.MatrixCombinationName,
+				cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag,
+				fmt.Sprintf("%.2f", rs.throughput),
+				fmt.Sprintf("%.4f", rs.avgLatency),
+				fmt.Sprintf("%.4f", rs.p99Latency),
+				fmt.Sprintf("%d", rs.errCount),
+			})
+		}
+	}
+
+	csvPath := filepath.Join(outputDir, "MATRIX-SUMMARY.csv")
+	plog.Printf("saving matrix summary data to %q", csvPath)
+	file, err := openToOverwrite(csvPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	wr := csv.NewWriter(file)
+	if err = wr.WriteAll(rows); err != nil {
+		return err
+	}
+	wr.Flush()
+	if err = wr.Error(); err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(rows[0])
+	for _, row := range rows[1:] {
+		tw.Append(row)
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+	txtPath := filepath.Join(outputDir, "MATRIX-SUMMARY.txt")
+	plog.Printf("saving matrix summary data to %q", txtPath)
+	if err = toFile(buf.String(), txtPath); err != nil {
+		return err
+	}
+
+	for _, pc := range []struct {
+		column string
+		yAxis  string
+		value  func(matrixResult) float64
+	}{
+		{"REQUESTS-PER-SECOND", "Throughput(Requests/Second)", func(rs matrixResult) float64 { return rs.throughput }},
+		{"AVERAGE-LATENCY-MS", "Latency(millisecond)", func(rs matrixResult) float64 { return rs.avgLatency }},
+		{"P99-LATENCY-MS", "Latency(millisecond)", func(rs matrixResult) float64 { return rs.p99Latency }},
+	} {
+		plt, err := plot.New()
+		if err != nil {
+			return err
+		}
+		plt.Title.Text = fmt.Sprintf("%s, %s by matrix combination", cfgs[0].TestTitle, pc.column)
+		plt.X.Label.Text = "Matrix combination index"
+		plt.Y.Label.Text = pc.yAxis
+		plt.Legend.Top = true
+
+		var ps []plot.Plotter
+		for j, databaseID := range cfgs[0].AllDatabaseIDList {
+			pts := make(plotter.XYs, len(cfgs))
+			for i := range cfgs {
+				pts[i].X = float64(i + 1)
+				pts[i].Y = pc.value(results[i][databaseID])
+			}
+			l, err := plotter.NewLine(pts)
+			if err != nil {
+				return err
+			}
+			l.Color = dbtesterpb.GetRGBI(databaseID, j)
+			l.Dashes = plotutil.Dashes(j)
+			ps = append(ps, l)
+			plt.Legend.Add(cfgs[0].DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseDescription, l)
+		}
+		plt.Add(ps...)
+
+		if err = savePlot(plt, filepath.Join(outputDir, "MATRIX-"+pc.column), plotWidth, plotHeight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readResultMetrics reads the client-side latency summary and percentiles
+// of one database. It returns the values by summary row name
+// (e.g. 'REQUESTS-PER-SECOND') and percentile (e.g. 'p99'), and the sum
+// of all errors as 'ERROR-COUNT'.
+func readResultMetrics(summaryPath, percentilePath string) (map[string]float64, error) {
+	mv := make(map[string]float64)
+	rows, err := readCSVRows(summaryPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if len(row) < 2 {
+			continue
+		}
+		fv, err := strconv.ParseFloat(row[1], 64)
+		if err != nil {
+			continue // header
+		}
+		if strings.HasPrefix(row[0], "ERROR:") {
+			mv["ERROR-COUNT"] += fv
+			continue
+		}
+		mv[row[0]] = fv
+	}
+
+	rows, err = readCSVRows(percentilePath)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if len(row) < 2 || !strings.HasPrefix(row[0], "p") {
+			continue
+		}
+		fv, err := strconv.ParseFloat(row[1], 64)
+		if err != nil {
+			continue // header
+		}
+		mv[row[0]] = fv
+	}
+	return mv, nil
+}
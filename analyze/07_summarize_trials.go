@@ -0,0 +1,207 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/coreos/dbtester"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// trialMetrics defines the metrics to summarize across trials.
+var trialMetrics = []string{
+	"REQUESTS-PER-SECOND",
+	"AVERAGE-LATENCY-MS",
+	"p50",
+	"p90",
+	"p99",
+	"p99.9",
+}
+
+// tTable975 is the 0.975 quantile of Student's t-distribution,
+// by degrees of freedom from 1 to 30.
+var tTable975 = []float64{
+	12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+	2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+	2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+}
+
+// trialStats is the mean, sample standard deviation and
+// 95% confidence interval of one metric across trials.
+type trialStats struct {
+	n      int
+	mean   float64
+	stddev float64
+	lower  float64
+	upper  float64
+}
+
+func newTrialStats(vs []float64) trialStats {
+	st := trialStats{n: len(vs)}
+	if len(vs) == 0 {
+		return st
+	}
+	for _, v := range vs {
+		st.mean += v
+	}
+	st.mean /= float64(len(vs))
+	if len(vs) > 1 {
+		for _, v := range vs {
+			st.stddev += (v - st.mean) * (v - st.mean)
+		}
+		st.stddev = math.Sqrt(st.stddev / float64(len(vs)-1))
+	}
+
+	t := 1.96
+	if df := len(vs) - 1; df >= 1 && df <= len(tTable975) {
+		t = tTable975[df-1]
+	}
+	margin := t * st.stddev / math.Sqrt(float64(len(vs)))
+	st.lower, st.upper = st.mean-margin, st.mean+margin
+	return st
+}
+
+// hasCI returns true if the confidence interval is defined,
+// which needs at least 2 trials.
+func (st trialStats) hasCI() bool {
+	return st.n > 1
+}
+
+// overlaps returns true if two confidence intervals overlap,
+// in which case the difference is not statistically significant.
+// It returns false if either interval is not defined.
+func (st trialStats) overlaps(other trialStats) bool {
+	if !st.hasCI() || !other.hasCI() {
+		return false
+	}
+	return st.lower <= other.upper && other.lower <= st.upper
+}
+
+// summarizeTrials summarizes the results of all trials of the same benchmark,
+// and saves the summary to the aggregated output paths without trial directory.
+// Comparisons between databases whose confidence intervals overlap are flagged.
+func summarizeTrials(cfgs []*dbtester.Config) error {
+	cfg := cfgs[0]
+	header := []string{""}
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		header = append(header, cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag)
+	}
+
+	// database ID to metric to values by trial
+	values := make(map[string]map[string][]float64)
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		values[databaseID] = make(map[string][]float64)
+		for _, c := range cfgs {
+			testdata := c.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+			mv, err := readResultMetrics(testdata.ClientLatencyDistributionSummaryPath, testdata.ClientLatencyDistributionPercentilePath)
+			if err != nil {
+				return err
+			}
+			for _, metric := range trialMetrics {
+				values[databaseID][metric] = append(values[databaseID][metric], mv[metric])
+			}
+		}
+	}
+
+	rows := [][]string{header}
+	var overlapped []string
+	for _, metric := range trialMetrics {
+		stats := make([]trialStats, len(cfg.AllDatabaseIDList))
+		for i, databaseID := range cfg.AllDatabaseIDList {
+			stats[i] = newTrialStats(values[databaseID][metric])
+		}
+
+		for ti, c := range cfgs {
+			row := []string{fmt.Sprintf("%s %s", metric, strings.ToUpper(c.TrialName()))}
+			for _, databaseID := range cfg.AllDatabaseIDList {
+				row = append(row, fmt.Sprintf("%.4f", values[databaseID][metric][ti]))
+			}
+			rows = append(rows, row)
+		}
+
+		rowMean := []string{metric + " MEAN"}
+		rowStddev := []string{metric + " STDDEV"}
+		rowCI := []string{metric + " 95%-CI"}
+		rowOverlap := []string{metric + " CI-OVERLAP"}
+		for i := range cfg.AllDatabaseIDList {
+			rowMean = append(rowMean, fmt.Sprintf("%.4f", stats[i].mean))
+			if !stats[i].hasCI() {
+				rowStddev = append(rowStddev, "-")
+				rowCI = append(rowCI, "-")
+				rowOverlap = append(rowOverlap, "-")
+				continue
+			}
+			rowStddev = append(rowStddev, fmt.Sprintf("%.4f", stats[i].stddev))
+			rowCI = append(rowCI, fmt.Sprintf("[%.4f, %.4f]", stats[i].lower, stats[i].upper))
+
+			var tags []string
+			for j := range cfg.AllDatabaseIDList {
+				if i == j || !stats[i].overlaps(stats[j]) {
+					continue
+				}
+				tags = append(tags, header[j+1])
+				if i < j {
+					overlapped = append(overlapped, fmt.Sprintf("%s: %s and %s", metric, header[i+1], header[j+1]))
+				}
+			}
+			if len(tags) == 0 {
+				rowOverlap = append(rowOverlap, "-")
+			} else {
+				rowOverlap = append(rowOverlap, strings.Join(tags, " "))
+			}
+		}
+		rows = append(rows, rowMean, rowStddev, rowCI, rowOverlap)
+	}
+
+	name := cfg.TrialName()
+	csvPath := dbtester.TrimRunDir(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV, name)
+	plog.Printf("saving trial summary data to %q", csvPath)
+	file, err := openToOverwrite(csvPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	wr := csv.NewWriter(file)
+	if err = wr.WriteAll(rows); err != nil {
+		return err
+	}
+	wr.Flush()
+	if err = wr.Error(); err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(rows[0])
+	for _, row := range rows[1:] {
+		tw.Append(row)
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+	stxt := buf.String()
+	if len(overlapped) > 0 {
+		stxt += fmt.Sprintf("\n\n95%% confidence intervals overlap in %d trials (difference is not significant):\n%s\n", len(cfgs), strings.Join(overlapped, "\n"))
+	}
+	txtPath := changeExtToTxt(dbtester.TrimRunDir(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathTXT, name))
+	plog.Printf("saving trial summary data to %q", txtPath)
+	return toFile(stxt, txtPath)
+}
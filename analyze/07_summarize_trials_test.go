@@ -0,0 +1,82 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTrialStats(t *testing.T) {
+	var many []float64
+	for i := 0; i < 32; i++ {
+		many = append(many, float64(1+2*(i%2)))
+	}
+	tests := []struct {
+		vs     []float64
+		hasCI  bool
+		mean   float64
+		stddev float64
+		// margin is the half width of 95% confidence interval
+		margin float64
+	}{
+		{nil, false, 0, 0, 0},
+		{[]float64{5}, false, 5, 0, 0},
+		{[]float64{1, 3}, true, 2, math.Sqrt2, 12.706},
+		{[]float64{10, 12, 14}, true, 12, 2, 4.303 * 2 / math.Sqrt(3)},
+		// more than 30 degrees of freedom falls back to the normal distribution
+		{many, true, 2, math.Sqrt(32.0 / 31.0), 1.96 * math.Sqrt(32.0/31.0) / math.Sqrt(32)},
+	}
+	for i, tt := range tests {
+		st := newTrialStats(tt.vs)
+		if st.hasCI() != tt.hasCI {
+			t.Fatalf("#%d: expected CI %v, got %v", i, tt.hasCI, st.hasCI())
+		}
+		for _, v := range []struct {
+			name     string
+			exp, got float64
+		}{
+			{"mean", tt.mean, st.mean},
+			{"stddev", tt.stddev, st.stddev},
+			{"lower", tt.mean - tt.margin, st.lower},
+			{"upper", tt.mean + tt.margin, st.upper},
+		} {
+			if math.Abs(v.exp-v.got) > 1e-9 {
+				t.Fatalf("#%d: expected %s %f, got %f", i, v.name, v.exp, v.got)
+			}
+		}
+	}
+}
+
+func TestTrialStatsOverlaps(t *testing.T) {
+	tests := []struct {
+		a, b []float64
+		exp  bool
+	}{
+		{[]float64{10, 12, 14}, []float64{11, 13, 15}, true},
+		{[]float64{10, 10.1, 10.2}, []float64{20, 20.1, 20.2}, false},
+		// touching intervals overlap
+		{[]float64{0, 2}, []float64{2 * 12.706, 2*12.706 + 2}, true},
+		// one trial has no confidence interval to compare
+		{[]float64{10}, []float64{10}, false},
+		{[]float64{10}, []float64{10, 12, 14}, false},
+	}
+	for i, tt := range tests {
+		a, b := newTrialStats(tt.a), newTrialStats(tt.b)
+		if a.overlaps(b) != tt.exp || b.overlaps(a) != tt.exp {
+			t.Fatalf("#%d: expected overlaps %v, got %v and %v", i, tt.exp, a.overlaps(b), b.overlaps(a))
+		}
+	}
+}
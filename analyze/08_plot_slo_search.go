@@ -0,0 +1,153 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/gonum/plot"
+	"github.com/gonum/plot/plotter"
+	"github.com/gonum/plot/plotutil"
+	"github.com/olekukonko/tablewriter"
+)
+
+// sloSearchSummaryColumns defines SLO search summary columns.
+var sloSearchSummaryColumns = []string{
+	"DATABASE-TAG",
+	"MAX-SUSTAINABLE-REQUESTS-PER-SECOND",
+	"P99-LATENCY-MS",
+	"TRIALS",
+}
+
+// analyzeSLOSearch summarizes the maximum sustainable throughput of each database,
+// and plots the p99 latency by rate limit with the SLO.
+func analyzeSLOSearch(cfg *dbtester.Config) error {
+	// column indexes of dbtester.SLOSearchColumns
+	rateIdx, p99Idx, meetsIdx := 0, 3, 5
+
+	plt, err := plot.New()
+	if err != nil {
+		return err
+	}
+	plt.Title.Text = fmt.Sprintf("%s, P99-LATENCY-MS by rate limit", cfg.TestTitle)
+	plt.X.Label.Text = "Rate limit(Requests/Second)"
+	plt.Y.Label.Text = "Latency(millisecond)"
+	plt.Legend.Top = true
+
+	var (
+		ps      []plot.Plotter
+		maxRate float64
+		rows    = [][]string{sloSearchSummaryColumns}
+	)
+	for i, databaseID := range cfg.AllDatabaseIDList {
+		fpath := cfg.AnalyzeSLOSearchPath(databaseID)
+		plog.Printf("reading SLO search results %q", fpath)
+		crows, err := readCSVRows(fpath)
+		if err != nil {
+			return err
+		}
+		if len(crows) < 2 {
+			return fmt.Errorf("%q has no SLO search trial", fpath)
+		}
+
+		var (
+			pts         = make(plotter.XYs, len(crows)-1)
+			best        = "0"
+			bestLatency = "-"
+		)
+		for j, row := range crows[1:] {
+			rate, err := strconv.ParseFloat(row[rateIdx], 64)
+			if err != nil {
+				return err
+			}
+			p99, err := strconv.ParseFloat(row[p99Idx], 64)
+			if err != nil {
+				return err
+			}
+			pts[j].X = rate
+			pts[j].Y = p99
+			maxRate = maxFloat64(maxRate, rate)
+
+			// rows are sorted by rate limit
+			if row[meetsIdx] == "true" {
+				best, bestLatency = row[rateIdx], row[p99Idx]
+			}
+		}
+		tag := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag
+		rows = append(rows, []string{tag, best, bestLatency, fmt.Sprintf("%d", len(crows)-1)})
+
+		l, err := plotter.NewLine(pts)
+		if err != nil {
+			return err
+		}
+		l.Color = dbtesterpb.GetRGBI(databaseID, i)
+		l.Dashes = plotutil.Dashes(i)
+		ps = append(ps, l)
+		plt.Legend.Add(cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseDescription, l)
+	}
+
+	sloPts := make(plotter.XYs, 2)
+	sloPts[0].Y = cfg.ConfigSLOSearch.P99LatencyMs
+	sloPts[1].X, sloPts[1].Y = maxRate, cfg.ConfigSLOSearch.P99LatencyMs
+	slo, err := plotter.NewLine(sloPts)
+	if err != nil {
+		return err
+	}
+	slo.Dashes = plotutil.Dashes(len(cfg.AllDatabaseIDList))
+	ps = append(ps, slo)
+	plt.Legend.Add(fmt.Sprintf("SLO (p99 %.2f ms)", cfg.ConfigSLOSearch.P99LatencyMs), slo)
+	plt.Add(ps...)
+
+	if err = savePlot(plt, filepath.Join(cfg.AnalyzePlotPathPrefix, "SLO-SEARCH-P99-LATENCY-MS"), plotWidth, plotHeight); err != nil {
+		return err
+	}
+
+	csvPath := filepath.Join(cfg.AnalyzePlotPathPrefix, "SLO-SEARCH-SUMMARY.csv")
+	plog.Printf("saving SLO search summary data to %q", csvPath)
+	file, err := openToOverwrite(csvPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	wr := csv.NewWriter(file)
+	if err = wr.WriteAll(rows); err != nil {
+		return err
+	}
+	wr.Flush()
+	if err = wr.Error(); err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(rows[0])
+	for _, row := range rows[1:] {
+		tw.Append(row)
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+	stxt := fmt.Sprintf("SLO: p99 latency <= %.2f ms, error rate <= %.4f\n\n", cfg.ConfigSLOSearch.P99LatencyMs, cfg.ConfigSLOSearch.MaxErrorRate) + buf.String()
+	txtPath := filepath.Join(cfg.AnalyzePlotPathPrefix, "SLO-SEARCH-SUMMARY.txt")
+	plog.Printf("saving SLO search summary data to %q", txtPath)
+	return toFile(stxt, txtPath)
+}
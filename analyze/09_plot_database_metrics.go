@@ -0,0 +1,162 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/gonum/plot"
+	"github.com/gonum/plot/plotter"
+	"github.com/gonum/plot/plotutil"
+)
+
+// databaseMetrics is the database metrics of all servers, averaged by unix second.
+type databaseMetrics struct {
+	columns []string
+	// unixSeconds is sorted
+	unixSeconds []int64
+	// columnToValues maps column to the averages of servers at each unix second
+	columnToValues map[string][]float64
+}
+
+// readDatabaseMetrics reads the database metrics CSV files scraped by agents.
+// The first column of each file is 'UNIX-SECOND', same as system metrics.
+func readDatabaseMetrics(fpaths ...string) (*databaseMetrics, error) {
+	var (
+		columns   []string
+		secToSums = make(map[int64][]float64)
+		secToCnt  = make(map[int64]int)
+	)
+	for _, fpath := range fpaths {
+		plog.Printf("reading database metrics %q", fpath)
+		rows, err := readCSVRows(fpath)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 || len(rows[0]) < 2 || rows[0][0] != "UNIX-SECOND" {
+			return nil, fmt.Errorf("%q has no database metrics header", fpath)
+		}
+		if columns == nil {
+			columns = rows[0][1:]
+		} else if fmt.Sprint(columns) != fmt.Sprint(rows[0][1:]) {
+			return nil, fmt.Errorf("%q has columns %q, expected %q", fpath, rows[0][1:], columns)
+		}
+		for _, row := range rows[1:] {
+			sec, err := strconv.ParseInt(row[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			sums, ok := secToSums[sec]
+			if !ok {
+				sums = make([]float64, len(columns))
+				secToSums[sec] = sums
+			}
+			for i := range columns {
+				v, err := strconv.ParseFloat(row[i+1], 64)
+				if err != nil {
+					return nil, err
+				}
+				sums[i] += v
+			}
+			secToCnt[sec]++
+		}
+	}
+
+	dm := &databaseMetrics{columns: columns, columnToValues: make(map[string][]float64)}
+	for sec := range secToSums {
+		dm.unixSeconds = append(dm.unixSeconds, sec)
+	}
+	sort.Slice(dm.unixSeconds, func(i, j int) bool { return dm.unixSeconds[i] < dm.unixSeconds[j] })
+	for i, col := range columns {
+		vs := make([]float64, len(dm.unixSeconds))
+		for j, sec := range dm.unixSeconds {
+			vs[j] = secToSums[sec][i] / float64(secToCnt[sec])
+		}
+		dm.columnToValues[col] = vs
+	}
+	return dm, nil
+}
+
+// plotDatabaseMetrics plots each database metrics column by second,
+// for the databases with 'server_database_metrics_path_list'.
+func plotDatabaseMetrics(cfg *dbtester.Config) error {
+	var (
+		databaseIDs []string
+		columns     []string
+		idToMetrics = make(map[string]*databaseMetrics)
+	)
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		fpaths := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].ServerDatabaseMetricsPathList
+		if len(fpaths) == 0 {
+			continue
+		}
+		dm, err := readDatabaseMetrics(fpaths...)
+		if err != nil {
+			return err
+		}
+		databaseIDs = append(databaseIDs, databaseID)
+		idToMetrics[databaseID] = dm
+		for _, col := range dm.columns {
+			if !containsString(columns, col) {
+				columns = append(columns, col)
+			}
+		}
+	}
+
+	for _, col := range columns {
+		plt, err := plot.New()
+		if err != nil {
+			return err
+		}
+		plt.Title.Text = fmt.Sprintf("%s, %s", cfg.TestTitle, col)
+		plt.X.Label.Text = "Second"
+		plt.Y.Label.Text = col
+		plt.Legend.Top = true
+
+		var ps []plot.Plotter
+		for i, databaseID := range databaseIDs {
+			dm := idToMetrics[databaseID]
+			vs, ok := dm.columnToValues[col]
+			if !ok || len(vs) == 0 {
+				continue
+			}
+			pts := make(plotter.XYs, len(vs))
+			for j := range vs {
+				pts[j].X = float64(dm.unixSeconds[j] - dm.unixSeconds[0] + 1)
+				pts[j].Y = vs[j]
+			}
+			l, err := plotter.NewLine(pts)
+			if err != nil {
+				return err
+			}
+			l.Color = dbtesterpb.GetRGBI(databaseID, i)
+			l.Dashes = plotutil.Dashes(i)
+			ps = append(ps, l)
+			plt.Legend.Add(cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseDescription, l)
+		}
+		plt.Add(ps...)
+
+		if err = savePlot(plt, filepath.Join(cfg.AnalyzePlotPathPrefix, "DATABASE-METRICS-"+col), plotWidth, plotHeight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
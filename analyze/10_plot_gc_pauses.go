@@ -0,0 +1,207 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/gonum/plot"
+	"github.com/gonum/plot/plotter"
+	"github.com/gonum/plot/plotutil"
+	"github.com/gonum/plot/vg/draw"
+)
+
+// gcPause is a garbage collection pause of a server.
+type gcPause struct {
+	unixMillisecond int64
+	pauseMs         float64
+}
+
+// readGCPauses reads the garbage collection pauses of all servers,
+// saved by agents with 'gc_log' option.
+func readGCPauses(fpaths ...string) ([]gcPause, error) {
+	var ps []gcPause
+	for _, fpath := range fpaths {
+		plog.Printf("reading GC pauses %q", fpath)
+		rows, err := readCSVRows(fpath)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			return nil, fmt.Errorf("%q has no GC pause header", fpath)
+		}
+		msIdx, pauseIdx := columnIndex(rows[0], "UNIX-MILLISECOND"), columnIndex(rows[0], "PAUSE-MS")
+		if msIdx == -1 || pauseIdx == -1 {
+			return nil, fmt.Errorf("%q has unexpected header %q", fpath, rows[0])
+		}
+		for _, row := range rows[1:] {
+			ms, err := strconv.ParseInt(row[msIdx], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			pause, err := strconv.ParseFloat(row[pauseIdx], 64)
+			if err != nil {
+				return nil, err
+			}
+			ps = append(ps, gcPause{unixMillisecond: ms, pauseMs: pause})
+		}
+	}
+	return ps, nil
+}
+
+// gcPauseSummaryRows returns the summary rows of total and maximum
+// garbage collection pauses of all servers. It returns nil if no
+// database has 'server_gc_pauses_path_list'.
+func gcPauseSummaryRows(cfg *dbtester.Config) ([][]string, error) {
+	found := false
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		if len(cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].ServerGCPausesPathList) > 0 {
+			found = true
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	rowTotal := []string{"SERVER-GC-PAUSE-TOTAL"}
+	rowMax := []string{"SERVER-GC-PAUSE-MAX"}
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		fpaths := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].ServerGCPausesPathList
+		if len(fpaths) == 0 {
+			rowTotal = append(rowTotal, "-")
+			rowMax = append(rowMax, "-")
+			continue
+		}
+		ps, err := readGCPauses(fpaths...)
+		if err != nil {
+			return nil, err
+		}
+		var total, max float64
+		for _, p := range ps {
+			total += p.pauseMs
+			max = maxFloat64(max, p.pauseMs)
+		}
+		rowTotal = append(rowTotal, fmt.Sprintf("%.2f ms", total))
+		rowMax = append(rowMax, fmt.Sprintf("%.2f ms", max))
+	}
+	return [][]string{rowTotal, rowMax}, nil
+}
+
+// plotGCPauses overlays the garbage collection pauses of all servers
+// on the client latency by second, for each database with
+// 'server_gc_pauses_path_list'.
+func plotGCPauses(cfg *dbtester.Config) error {
+	for i, databaseID := range cfg.AllDatabaseIDList {
+		amc := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+		if len(amc.ServerGCPausesPathList) == 0 {
+			continue
+		}
+		ps, err := readGCPauses(amc.ServerGCPausesPathList...)
+		if err != nil {
+			return err
+		}
+
+		first, latPts, err := readClientTimeseries(amc.ClientLatencyThroughputTimeseriesPath, "AVG-LATENCY-MS")
+		if err != nil {
+			return err
+		}
+		pausePts := make(plotter.XYs, len(ps))
+		for j, p := range ps {
+			pausePts[j].X = float64(p.unixMillisecond-first)/1000 + 1
+			pausePts[j].Y = p.pauseMs
+		}
+
+		plt, err := plot.New()
+		if err != nil {
+			return err
+		}
+		plt.Title.Text = fmt.Sprintf("%s, AVG-LATENCY-MS with GC pauses", cfg.TestTitle)
+		plt.X.Label.Text = "Second"
+		plt.Y.Label.Text = "Latency(millisecond)"
+		plt.Legend.Top = true
+
+		l, err := plotter.NewLine(latPts)
+		if err != nil {
+			return err
+		}
+		l.Color = dbtesterpb.GetRGBI(databaseID, i)
+		l.Dashes = plotutil.Dashes(0)
+		plt.Add(l)
+		plt.Legend.Add(cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseDescription, l)
+
+		if len(pausePts) > 0 {
+			sc, err := plotter.NewScatter(pausePts)
+			if err != nil {
+				return err
+			}
+			sc.Color = dbtesterpb.GetRGBIII(databaseID, i)
+			sc.Shape = draw.CrossGlyph{}
+			plt.Add(sc)
+			plt.Legend.Add("GC pause (ms)", sc)
+		}
+
+		tag := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag
+		if err = savePlot(plt, filepath.Join(cfg.AnalyzePlotPathPrefix, "AVG-LATENCY-MS-GC-PAUSES-"+tag), plotWidth, plotHeight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readClientTimeseries reads the column of the client timeseries.
+// It returns the first UNIX millisecond, and the points whose X is the
+// second relative to the first, starting from 1. Results by sub-second
+// samples have 'UNIX-MILLISECOND', otherwise 'UNIX-SECOND' is used.
+func readClientTimeseries(fpath, column string) (int64, plotter.XYs, error) {
+	rows, err := readCSVRows(fpath)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(rows) < 2 {
+		return 0, nil, fmt.Errorf("%q has no timeseries data", fpath)
+	}
+	tsIdx, msScale := columnIndex(rows[0], "UNIX-MILLISECOND"), int64(1)
+	if tsIdx == -1 {
+		tsIdx, msScale = columnIndex(rows[0], "UNIX-SECOND"), 1000
+	}
+	colIdx := columnIndex(rows[0], column)
+	if tsIdx == -1 || colIdx == -1 {
+		return 0, nil, fmt.Errorf("%q has unexpected header %q", fpath, rows[0])
+	}
+	first, err := strconv.ParseInt(rows[1][tsIdx], 10, 64)
+	if err != nil {
+		return 0, nil, err
+	}
+	first *= msScale
+	pts := make(plotter.XYs, len(rows)-1)
+	for i, row := range rows[1:] {
+		ts, err := strconv.ParseInt(row[tsIdx], 10, 64)
+		if err != nil {
+			return 0, nil, err
+		}
+		v, err := strconv.ParseFloat(row[colIdx], 64)
+		if err != nil {
+			return 0, nil, err
+		}
+		pts[i].X = float64(ts*msScale-first)/1000 + 1
+		pts[i].Y = v
+	}
+	return first, pts, nil
+}
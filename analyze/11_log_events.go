@@ -0,0 +1,222 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/logevent"
+
+	"github.com/gonum/plot"
+	"github.com/gonum/plot/plotter"
+	"github.com/gonum/plot/plotutil"
+)
+
+// serverLogEvent is a database log event of a server.
+type serverLogEvent struct {
+	server int
+	logevent.Event
+}
+
+// readLogEvents extracts the events from the database logs of all servers,
+// for each database with 'server_database_log_path_list'.
+func readLogEvents(cfg *dbtester.Config) (map[string][]serverLogEvent, error) {
+	databaseIDToEvents := make(map[string][]serverLogEvent)
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		fpaths := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].ServerDatabaseLogPathList
+		if len(fpaths) == 0 {
+			continue
+		}
+		rules := logevent.RulesFor(databaseID)
+		evs := []serverLogEvent{}
+		for i, fpath := range fpaths {
+			plog.Printf("extracting log events from %q", fpath)
+			f, err := os.Open(fpath)
+			if err != nil {
+				return nil, err
+			}
+			es, err := logevent.Parse(f, rules)
+			f.Close()
+			if err != nil {
+				return nil, err
+			}
+			for _, ev := range es {
+				evs = append(evs, serverLogEvent{server: i + 1, Event: ev})
+			}
+		}
+		sort.SliceStable(evs, func(i, j int) bool { return evs[i].Time.Before(evs[j].Time) })
+		databaseIDToEvents[databaseID] = evs
+	}
+	return databaseIDToEvents, nil
+}
+
+// saveLogEvents saves the log events of each database to 'LOG-EVENTS-<tag>.csv'.
+func saveLogEvents(cfg *dbtester.Config, databaseIDToEvents map[string][]serverLogEvent) error {
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		evs, ok := databaseIDToEvents[databaseID]
+		if !ok {
+			continue
+		}
+		tag := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag
+		fpath := filepath.Join(cfg.AnalyzePlotPathPrefix, "LOG-EVENTS-"+tag+".csv")
+		plog.Printf("saving %d log events to %q", len(evs), fpath)
+
+		rows := [][]string{{"UNIX-SECOND", "UNIX-MILLISECOND", "SERVER", "EVENT", "DURATION-MS", "MESSAGE"}}
+		for _, ev := range evs {
+			dur := ""
+			if ev.Duration > 0 {
+				dur = fmt.Sprintf("%.3f", ev.Duration.Seconds()*1000)
+			}
+			rows = append(rows, []string{
+				fmt.Sprintf("%d", ev.Time.Unix()),
+				fmt.Sprintf("%d", ev.Time.UnixNano()/1e6),
+				fmt.Sprintf("%d", ev.server),
+				ev.Name,
+				dur,
+				ev.Line,
+			})
+		}
+
+		f, err := openToOverwrite(fpath)
+		if err != nil {
+			return err
+		}
+		wr := csv.NewWriter(f)
+		if err = wr.WriteAll(rows); err != nil {
+			f.Close()
+			return err
+		}
+		f.Close()
+	}
+	return nil
+}
+
+// logEventSummaryRows returns the summary rows of event counts by event name.
+// It returns nil if no database has 'server_database_log_path_list'.
+func logEventSummaryRows(cfg *dbtester.Config, databaseIDToEvents map[string][]serverLogEvent) [][]string {
+	if len(databaseIDToEvents) == 0 {
+		return nil
+	}
+	var names []string
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		if _, ok := databaseIDToEvents[databaseID]; !ok {
+			continue
+		}
+		for _, name := range logevent.Names(logevent.RulesFor(databaseID)) {
+			if !containsString(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	rows := make([][]string, 0, len(names))
+	for _, name := range names {
+		row := []string{"LOG-EVENT-" + name}
+		for _, databaseID := range cfg.AllDatabaseIDList {
+			evs, ok := databaseIDToEvents[databaseID]
+			if !ok || !containsString(logevent.Names(logevent.RulesFor(databaseID)), name) {
+				row = append(row, "-")
+				continue
+			}
+			cnt := 0
+			for _, ev := range evs {
+				if ev.Name == name {
+					cnt++
+				}
+			}
+			row = append(row, fmt.Sprintf("%d", cnt))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// plotLogEvents annotates the log events on the client latency and
+// throughput by second, for each database with 'server_database_log_path_list'.
+func plotLogEvents(cfg *dbtester.Config, databaseIDToEvents map[string][]serverLogEvent) error {
+	for i, databaseID := range cfg.AllDatabaseIDList {
+		evs, ok := databaseIDToEvents[databaseID]
+		if !ok {
+			continue
+		}
+		amc := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+		for _, col := range []struct{ column, label string }{
+			{"AVG-LATENCY-MS", "Latency(millisecond)"},
+			{"AVG-THROUGHPUT", "Throughput(Requests/Second)"},
+		} {
+			first, pts, err := readClientTimeseries(amc.ClientLatencyThroughputTimeseriesPath, col.column)
+			if err != nil {
+				return err
+			}
+
+			plt, err := plot.New()
+			if err != nil {
+				return err
+			}
+			plt.Title.Text = fmt.Sprintf("%s, %s with log events", cfg.TestTitle, col.column)
+			plt.X.Label.Text = "Second"
+			plt.Y.Label.Text = col.label
+			plt.Legend.Top = true
+
+			l, err := plotter.NewLine(pts)
+			if err != nil {
+				return err
+			}
+			l.Color = dbtesterpb.GetRGBI(databaseID, i)
+			l.Dashes = plotutil.Dashes(0)
+			plt.Add(l)
+			plt.Legend.Add(cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseDescription, l)
+
+			// place the event markers on the line, at the sample of the event
+			for j, name := range logevent.Names(logevent.RulesFor(databaseID)) {
+				var evPts plotter.XYs
+				for _, ev := range evs {
+					if ev.Name != name {
+						continue
+					}
+					x := float64(ev.Time.UnixNano()/1e6-first)/1000 + 1
+					idx := sort.Search(len(pts), func(k int) bool { return pts[k].X > x }) - 1
+					if idx < 0 || (idx == len(pts)-1 && x >= pts[idx].X+1) {
+						continue
+					}
+					evPts = append(evPts, struct{ X, Y float64 }{pts[idx].X, pts[idx].Y})
+				}
+				if len(evPts) == 0 {
+					continue
+				}
+				sc, err := plotter.NewScatter(evPts)
+				if err != nil {
+					return err
+				}
+				sc.Color = plotutil.Color(j)
+				sc.Shape = plotutil.Shape(j)
+				plt.Add(sc)
+				plt.Legend.Add(name, sc)
+			}
+
+			tag := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag
+			if err = savePlot(plt, filepath.Join(cfg.AnalyzePlotPathPrefix, col.column+"-LOG-EVENTS-"+tag), plotWidth, plotHeight); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/gonum/plot"
+	"github.com/gonum/plot/plotter"
+	"github.com/gonum/plot/plotutil"
+)
+
+// percentileColumns are the latency percentiles and error count columns
+// of the client timeseries by second and by number of keys.
+var percentileColumns = []struct {
+	column string
+	label  string
+	byKey  bool
+}{
+	{"P50-LATENCY-MS", "Latency(millisecond)", true},
+	{"P90-LATENCY-MS", "Latency(millisecond)", true},
+	{"P99-LATENCY-MS", "Latency(millisecond)", true},
+	{"P99.9-LATENCY-MS", "Latency(millisecond)", true},
+	{"ERROR-COUNT", "Errors", false},
+}
+
+// plotLatencyPercentiles plots the latency percentiles and error counts
+// of all databases by second, and the latency percentiles by number of keys.
+// Databases whose results do not have the column are skipped.
+func plotLatencyPercentiles(cfg *dbtester.Config) error {
+	for _, col := range percentileColumns {
+		if err := plotPercentileColumn(cfg, col.column, col.label, false); err != nil {
+			return err
+		}
+		if !col.byKey {
+			continue
+		}
+		if err := plotPercentileColumn(cfg, col.column, col.label, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func plotPercentileColumn(cfg *dbtester.Config, column, label string, byKey bool) error {
+	plt, err := plot.New()
+	if err != nil {
+		return err
+	}
+	plt.Title.Text = fmt.Sprintf("%s, %s", cfg.TestTitle, column)
+	plt.X.Label.Text = "Second"
+	if byKey {
+		plt.Title.Text += " by Key"
+		plt.X.Label.Text = "Keys"
+	}
+	plt.Y.Label.Text = label
+	plt.Legend.Top = true
+
+	added := 0
+	for i, databaseID := range cfg.AllDatabaseIDList {
+		amc := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+
+		var pts plotter.XYs
+		if byKey {
+			pts, err = readClientColumnByKey(amc.ClientLatencyByKeyNumberPath, column)
+		} else {
+			var rows [][]string
+			rows, err = readCSVRows(amc.ClientLatencyThroughputTimeseriesPath)
+			if err != nil {
+				return err
+			}
+			if len(rows) < 2 || columnIndex(rows[0], column) == -1 {
+				plog.Printf("%q has no %q; skipping", amc.ClientLatencyThroughputTimeseriesPath, column)
+				continue
+			}
+			_, pts, err = readClientTimeseries(amc.ClientLatencyThroughputTimeseriesPath, column)
+		}
+		if err != nil {
+			return err
+		}
+		if pts == nil {
+			continue
+		}
+
+		l, err := plotter.NewLine(pts)
+		if err != nil {
+			return err
+		}
+		l.Color = dbtesterpb.GetRGBI(databaseID, i)
+		l.Dashes = plotutil.Dashes(0)
+		plt.Add(l)
+		plt.Legend.Add(cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseDescription, l)
+		added++
+	}
+	if added == 0 {
+		return nil
+	}
+
+	name := column
+	if byKey {
+		name += "-BY-KEY"
+	}
+	if err = savePlot(plt, filepath.Join(cfg.AnalyzePlotPathPrefix, name), plotWidth, plotHeight); err != nil {
+		return err
+	}
+	return nil
+}
+
+// readClientColumnByKey reads the column of the client latency by number
+// of keys. It returns nil if the results do not have the column.
+func readClientColumnByKey(fpath, column string) (plotter.XYs, error) {
+	rows, err := readCSVRows(fpath)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) < 2 {
+		return nil, nil
+	}
+	keyIdx, colIdx := columnIndex(rows[0], "KEYS"), columnIndex(rows[0], column)
+	if keyIdx == -1 || colIdx == -1 {
+		plog.Printf("%q has no %q; skipping", fpath, column)
+		return nil, nil
+	}
+	pts := make(plotter.XYs, len(rows)-1)
+	for i, row := range rows[1:] {
+		keys, err := strconv.ParseFloat(row[keyIdx], 64)
+		if err != nil {
+			return nil, err
+		}
+		v, err := strconv.ParseFloat(row[colIdx], 64)
+		if err != nil {
+			return nil, err
+		}
+		pts[i].X = keys
+		pts[i].Y = v
+	}
+	return pts, nil
+}
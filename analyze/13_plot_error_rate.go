@@ -0,0 +1,143 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/errclass"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gonum/plot"
+	"github.com/gonum/plot/plotter"
+	"github.com/gonum/plot/plotutil"
+)
+
+// classifySummaryError returns the error class of the 'ERROR: "..."' row
+// of the client latency distribution summary.
+func classifySummaryError(databaseID, header string) string {
+	msg := strings.TrimSpace(strings.TrimPrefix(header, "ERROR:"))
+	if s, err := strconv.Unquote(msg); err == nil {
+		msg = s
+	}
+	return errclass.Classify(errclass.RulesFor(databaseID), msg)
+}
+
+// errorClassSummaryRows returns the number of client errors by class
+// of each database, only for the classes with errors.
+func errorClassSummaryRows(cfg *dbtester.Config, databaseIDToErrClasses map[string]map[string]int64) [][]string {
+	var rows [][]string
+	for _, class := range errclass.Classes {
+		found := false
+		for _, classes := range databaseIDToErrClasses {
+			if classes[class] > 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		row := []string{"CLIENT-ERROR-" + class}
+		for _, databaseID := range cfg.AllDatabaseIDList {
+			row = append(row, humanize.Comma(databaseIDToErrClasses[databaseID][class]))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// plotErrorRates plots the error rates of all databases by second, and
+// the error rates by class next to the throughput of each database.
+// Databases whose results do not have the error rates are skipped.
+func plotErrorRates(cfg *dbtester.Config) error {
+	if err := plotPercentileColumn(cfg, "ERROR-RATE", "Errors/Second", false); err != nil {
+		return err
+	}
+
+	for i, databaseID := range cfg.AllDatabaseIDList {
+		amc := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+		rows, err := readCSVRows(amc.ClientLatencyThroughputTimeseriesPath)
+		if err != nil {
+			return err
+		}
+		if len(rows) < 2 || columnIndex(rows[0], "ERROR-RATE") == -1 {
+			plog.Printf("%q has no %q; skipping", amc.ClientLatencyThroughputTimeseriesPath, "ERROR-RATE")
+			continue
+		}
+
+		plt, err := plot.New()
+		if err != nil {
+			return err
+		}
+		plt.Title.Text = fmt.Sprintf("%s, ERROR-RATE by class", cfg.TestTitle)
+		plt.X.Label.Text = "Second"
+		plt.Y.Label.Text = "Requests/Second"
+		plt.Legend.Top = true
+
+		_, pts, err := readClientTimeseries(amc.ClientLatencyThroughputTimeseriesPath, "AVG-THROUGHPUT")
+		if err != nil {
+			return err
+		}
+		l, err := plotter.NewLine(pts)
+		if err != nil {
+			return err
+		}
+		l.Color = dbtesterpb.GetRGBI(databaseID, i)
+		l.Dashes = plotutil.Dashes(0)
+		plt.Add(l)
+		plt.Legend.Add(cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseDescription, l)
+
+		for j, class := range errclass.Classes {
+			column := "ERROR-RATE-" + class
+			if columnIndex(rows[0], column) == -1 {
+				continue
+			}
+			_, pts, err := readClientTimeseries(amc.ClientLatencyThroughputTimeseriesPath, column)
+			if err != nil {
+				return err
+			}
+			hasErrors := false
+			for _, pt := range pts {
+				if pt.Y > 0 {
+					hasErrors = true
+					break
+				}
+			}
+			if !hasErrors {
+				continue
+			}
+			l, err := plotter.NewLine(pts)
+			if err != nil {
+				return err
+			}
+			l.Color = plotutil.Color(j)
+			l.Dashes = plotutil.Dashes(1)
+			plt.Add(l)
+			plt.Legend.Add(class, l)
+		}
+
+		tag := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag
+		if err = savePlot(plt, filepath.Join(cfg.AnalyzePlotPathPrefix, "ERROR-RATE-BY-CLASS-"+tag), plotWidth, plotHeight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/coreos/dbtester"
+
+	"github.com/gonum/plot"
+	"github.com/gonum/plot/plotter"
+	"github.com/gonum/plot/plotutil"
+)
+
+// endpointColumns are the columns of the client timeseries by endpoint to plot.
+var endpointColumns = []struct {
+	column string
+	label  string
+}{
+	{"P99-LATENCY-MS", "Latency(millisecond)"},
+	{"AVG-THROUGHPUT", "Throughput(Requests/Second)"},
+}
+
+// plotEndpoints plots the client latency and throughput of each database
+// endpoint, for the databases whose results are tagged with endpoints.
+func plotEndpoints(cfg *dbtester.Config) error {
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		fpath := cfg.AnalyzeEndpointTimeseriesPath(databaseID)
+		if _, err := os.Stat(fpath); err != nil {
+			continue
+		}
+		rows, err := readCSVRows(fpath)
+		if err != nil {
+			return err
+		}
+		if len(rows) < 2 {
+			continue
+		}
+		epIdx, roleIdx, msIdx := columnIndex(rows[0], "ENDPOINT"), columnIndex(rows[0], "ROLE"), columnIndex(rows[0], "UNIX-MILLISECOND")
+		if epIdx == -1 || roleIdx == -1 || msIdx == -1 {
+			return fmt.Errorf("%q has no ENDPOINT, ROLE or UNIX-MILLISECOND", fpath)
+		}
+
+		// x is the seconds since the first sample of all endpoints
+		firstMs := int64(-1)
+		for _, row := range rows[1:] {
+			ms, err := strconv.ParseInt(row[msIdx], 10, 64)
+			if err != nil {
+				return err
+			}
+			if firstMs == -1 || ms < firstMs {
+				firstMs = ms
+			}
+		}
+
+		tag := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag
+		for _, col := range endpointColumns {
+			colIdx := columnIndex(rows[0], col.column)
+			if colIdx == -1 {
+				plog.Printf("%q has no %q; skipping", fpath, col.column)
+				continue
+			}
+
+			var eps []string
+			epToRole := make(map[string]string)
+			epToPts := make(map[string]plotter.XYs)
+			for _, row := range rows[1:] {
+				ms, err := strconv.ParseInt(row[msIdx], 10, 64)
+				if err != nil {
+					return err
+				}
+				v, err := strconv.ParseFloat(row[colIdx], 64)
+				if err != nil {
+					return err
+				}
+				ep := row[epIdx]
+				if _, ok := epToPts[ep]; !ok {
+					eps = append(eps, ep)
+					epToRole[ep] = row[roleIdx]
+				}
+				epToPts[ep] = append(epToPts[ep], struct{ X, Y float64 }{float64(ms-firstMs)/1000 + 1, v})
+			}
+
+			plt, err := plot.New()
+			if err != nil {
+				return err
+			}
+			plt.Title.Text = fmt.Sprintf("%s, %s by endpoint", cfg.TestTitle, col.column)
+			plt.X.Label.Text = "Second"
+			plt.Y.Label.Text = col.label
+			plt.Legend.Top = true
+
+			for i, ep := range eps {
+				l, err := plotter.NewLine(epToPts[ep])
+				if err != nil {
+					return err
+				}
+				l.Color = plotutil.Color(i)
+				l.Dashes = plotutil.Dashes(i)
+				plt.Add(l)
+				plt.Legend.Add(fmt.Sprintf("%s (%s)", ep, epToRole[ep]), l)
+			}
+
+			if err = savePlot(plt, filepath.Join(cfg.AnalyzePlotPathPrefix, col.column+"-BY-ENDPOINT-"+tag), plotWidth, plotHeight); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/coreos/dbtester"
+)
+
+// resultsJSONLPath is the file name of the machine-readable results of all databases.
+const resultsJSONLPath = "results.jsonl"
+
+// saveResultsJSONL saves the machine-readable results of all databases,
+// one JSON object per line in the order of 'all_database_id_list'.
+// Databases without 'results.json' (e.g. results of older versions) are skipped.
+func saveResultsJSONL(cfg *dbtester.Config) error {
+	var lines [][]byte
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		fpath := cfg.AnalyzeResultsPath(databaseID)
+		if _, err := os.Stat(fpath); err != nil {
+			plog.Printf("%q does not exist; skipping", fpath)
+			continue
+		}
+		rs, err := dbtester.ReadResults(fpath)
+		if err != nil {
+			return err
+		}
+		bts, err := json.Marshal(rs)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, bts)
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+
+	outputPath := filepath.Join(filepath.Dir(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV), resultsJSONLPath)
+	f, err := openToOverwrite(outputPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	for _, line := range lines {
+		if _, err = f.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	plog.Printf("saved %d results at %q", len(lines), outputPath)
+	return nil
+}
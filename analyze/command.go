@@ -46,7 +46,7 @@ func init() {
 }
 
 func commandFunc(cmd *cobra.Command, args []string) error {
-	return do(configPath)
+	return Do(configPath)
 }
 
 type allAggregatedData struct {
@@ -57,12 +57,56 @@ type allAggregatedData struct {
 	allDatabaseIDList           []string
 }
 
-func do(configPath string) error {
-	cfg, err := dbtester.ReadConfig(configPath, true)
+// Do aggregates, plots and summarizes all test results in the configuration.
+// If the configuration has 'repeat' option, it analyzes each trial, and
+// summarizes all trials. If the configuration has 'matrix' section,
+// it analyzes each combination, and compares all combinations. If the
+// configuration has 'slo_search' section, it only analyzes the search results.
+func Do(configPath string) error {
+	cfgs, err := dbtester.ReadConfigMatrix(configPath, true)
 	if err != nil {
 		return err
 	}
 
+	// trials of the same matrix combination
+	var groups [][]*dbtester.Config
+	for i, cfg := range cfgs {
+		if name := cfg.RunName(); name != "" {
+			plog.Printf("analyzing %q", name)
+		}
+		if cfg.ConfigSLOSearch.Enabled() {
+			err = analyzeSLOSearch(cfg)
+		} else {
+			err = do(cfg)
+		}
+		if err != nil {
+			return err
+		}
+		if i == 0 || cfg.MatrixCombinationName != cfgs[i-1].MatrixCombinationName {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], cfg)
+	}
+
+	if cfgs[0].ConfigSLOSearch.Enabled() {
+		return nil
+	}
+	for _, group := range groups {
+		if len(group) > 1 {
+			if err = summarizeTrials(group); err != nil {
+				return err
+			}
+		}
+	}
+	if len(groups) > 1 {
+		return compareMatrixCombinations(groups)
+	}
+	return nil
+}
+
+func do(cfg *dbtester.Config) error {
+	var err error
+
 	all := &allAggregatedData{
 		title:                       cfg.TestTitle,
 		data:                        make([]*analyzeData, 0, len(cfg.DatabaseIDToConfigAnalyzeMachineInitial)),
@@ -272,6 +316,7 @@ func do(configPath string) error {
 	row30AvgDiskSpaceUsage := []string{"SERVER-AVG-DISK-SPACE-USAGE"}                   // DISK-SPACE-USAGE
 
 	databaseIDToErrs := make(map[string][]string)
+	databaseIDToErrClasses := make(map[string]map[string]int64)
 	for i, databaseID := range cfg.AllDatabaseIDList {
 		testgroup := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 		testdata := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
@@ -395,6 +440,8 @@ func do(configPath string) error {
 					row06FastestLatency = append(row06FastestLatency, fmt.Sprintf("%s ms", row[1]))
 				case "AVERAGE-LATENCY-MS":
 					row07AverageLatency = append(row07AverageLatency, fmt.Sprintf("%s ms", row[1]))
+				case "PARTIAL":
+					plog.Warningf("%q has partial results of interrupted run", databaseID)
 				}
 
 				if strings.HasPrefix(row[0], "ERROR:") {
@@ -404,6 +451,11 @@ func do(configPath string) error {
 					}
 					totalErrCnt += iv
 
+					if _, ok := databaseIDToErrClasses[databaseID]; !ok {
+						databaseIDToErrClasses[databaseID] = make(map[string]int64)
+					}
+					databaseIDToErrClasses[databaseID][classifySummaryError(databaseID, row[0])] += iv
+
 					c1 := strings.TrimSpace(strings.Replace(row[0], "ERROR:", "", -1))
 					c2 := humanize.Comma(iv)
 					es := fmt.Sprintf("%s (count %s)", c1, c2)
@@ -560,6 +612,19 @@ func do(configPath string) error {
 		row29SectorsWrittenDeltaSum,
 		row30AvgDiskSpaceUsage,
 	}
+	gcRows, err := gcPauseSummaryRows(cfg)
+	if err != nil {
+		return err
+	}
+	databaseIDToLogEvents, err := readLogEvents(cfg)
+	if err != nil {
+		return err
+	}
+	logEventRows := logEventSummaryRows(cfg, databaseIDToLogEvents)
+	errClassRows := errorClassSummaryRows(cfg, databaseIDToErrClasses)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, errClassRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, gcRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, logEventRows...)
 	file, err := openToOverwrite(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV)
 	if err != nil {
 		return err
@@ -614,6 +679,9 @@ func do(configPath string) error {
 		row29SectorsWrittenDeltaSum,
 		row30AvgDiskSpaceUsage,
 	}
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, errClassRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, gcRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, logEventRows...)
 	buf := new(bytes.Buffer)
 	tw := tablewriter.NewWriter(buf)
 	tw.SetHeader(aggRowsForSummaryTXT[0])
@@ -1039,7 +1107,14 @@ func do(configPath string) error {
 				return err
 			}
 			col.UpdateHeader(makeHeader(plotConfig.Column, tag))
-			pairs = append(pairs, pair{y: col})
+			p := pair{y: col}
+			if ad.sampleIntervalMillisecond < 1000 {
+				// rows are sub-second samples, so plot by seconds
+				if p.x, err = ad.aggregated.Column("SECOND"); err != nil {
+					return err
+				}
+			}
+			pairs = append(pairs, p)
 			dataColumns = append(dataColumns, col)
 		}
 		if err = all.draw(plotConfig, pairs...); err != nil {
@@ -1139,6 +1214,31 @@ func do(configPath string) error {
 		}
 	}
 
+	if err = plotDatabaseMetrics(cfg); err != nil {
+		return err
+	}
+	if err = plotGCPauses(cfg); err != nil {
+		return err
+	}
+	if err = saveLogEvents(cfg, databaseIDToLogEvents); err != nil {
+		return err
+	}
+	if err = plotLogEvents(cfg, databaseIDToLogEvents); err != nil {
+		return err
+	}
+	if err = plotLatencyPercentiles(cfg); err != nil {
+		return err
+	}
+	if err = plotErrorRates(cfg); err != nil {
+		return err
+	}
+	if err = plotEndpoints(cfg); err != nil {
+		return err
+	}
+	if err = saveResultsJSONL(cfg); err != nil {
+		return err
+	}
+
 	return cfg.WriteREADME(stxt)
 }
 
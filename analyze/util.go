@@ -15,6 +15,7 @@
 package analyze
 
 import (
+	"encoding/csv"
 	"fmt"
 	"os"
 )
@@ -65,3 +66,31 @@ func toFile(txt, fpath string) error {
 	_, err = f.WriteString(txt)
 	return err
 }
+
+// readCSVRows reads all rows of the CSV file,
+// which may have different number of fields per row.
+func readCSVRows(fpath string) ([][]string, error) {
+	f, err := openToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rd := csv.NewReader(f)
+	rd.FieldsPerRecord = -1
+	return rd.ReadAll()
+}
+
+// columnIndex returns the index of the column in the header, or -1.
+func columnIndex(header []string, column string) int {
+	for i, h := range header {
+		if h == column {
+			return i
+		}
+	}
+	return -1
+}
+
+func containsString(ss []string, s string) bool {
+	return columnIndex(ss, s) != -1
+}
@@ -24,7 +24,8 @@ import (
 )
 
 // BroadcaseRequest sends request to all endpoints.
-func (cfg *Config) BroadcaseRequest(databaseID string, op dbtesterpb.Operation) (map[int]dbtesterpb.Response, error) {
+// Canceling the context cancels the requests in flight.
+func (cfg *Config) BroadcaseRequest(ctx context.Context, databaseID string, op dbtesterpb.Operation) (map[int]dbtesterpb.Response, error) {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
 		return nil, fmt.Errorf("database id %q does not exist", databaseID)
@@ -56,7 +57,7 @@ func (cfg *Config) BroadcaseRequest(databaseID string, op dbtesterpb.Operation)
 			// give enough timeout
 			// e.g. uploading logs takes longer
 			cli := dbtesterpb.NewTransporterClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 			resp, err := cli.Transfer(ctx, req)
 			cancel()
 			if err != nil {
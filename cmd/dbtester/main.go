@@ -20,6 +20,7 @@
 //	Available Commands:
 //	agent       Database 'agent' in remote servers.
 //	analyze     Analyzes test dbtester test results.
+//	compare     Compares test results to the baseline.
 //	control     Controls tests.
 //
 package main
@@ -30,6 +31,7 @@ import (
 
 	"github.com/coreos/dbtester/agent"
 	"github.com/coreos/dbtester/analyze"
+	"github.com/coreos/dbtester/compare"
 	"github.com/coreos/dbtester/control"
 	"github.com/spf13/cobra"
 )
@@ -49,6 +51,7 @@ func init() {
 func init() {
 	rootCommand.AddCommand(agent.Command)
 	rootCommand.AddCommand(analyze.Command)
+	rootCommand.AddCommand(compare.Command)
 	rootCommand.AddCommand(control.Command)
 }
 
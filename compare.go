@@ -0,0 +1,326 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+)
+
+// CompareThresholds are the regression thresholds in percent,
+// compared to the baseline.
+type CompareThresholds struct {
+	ThroughputDropPercent  float64
+	LatencyIncreasePercent float64
+	MemoryIncreasePercent  float64
+	DiskIncreasePercent    float64
+}
+
+// compareMetric is a metric to compare across result sets.
+type compareMetric struct {
+	name string
+	unit string
+	// csvRow is the row of the aggregated CSV
+	csvRow string
+	// isBytes is true if the aggregated CSV value is humanized bytes
+	isBytes bool
+	// higherIsBetter is true if the increase is an improvement
+	higherIsBetter bool
+	threshold      func(CompareThresholds) float64
+}
+
+var compareMetrics = []compareMetric{
+	{name: "AVG-THROUGHPUT", unit: "req/sec", csvRow: "AVG-THROUGHPUT", higherIsBetter: true, threshold: func(th CompareThresholds) float64 { return th.ThroughputDropPercent }},
+	{name: "AVG-LATENCY", unit: "ms", csvRow: "AVG-LATENCY", threshold: func(th CompareThresholds) float64 { return th.LatencyIncreasePercent }},
+	{name: "P50-LATENCY", unit: "ms", csvRow: "Latency p50", threshold: func(th CompareThresholds) float64 { return th.LatencyIncreasePercent }},
+	{name: "P90-LATENCY", unit: "ms", csvRow: "Latency p90", threshold: func(th CompareThresholds) float64 { return th.LatencyIncreasePercent }},
+	{name: "P99-LATENCY", unit: "ms", csvRow: "Latency p99", threshold: func(th CompareThresholds) float64 { return th.LatencyIncreasePercent }},
+	{name: "P99.9-LATENCY", unit: "ms", csvRow: "Latency p99.9", threshold: func(th CompareThresholds) float64 { return th.LatencyIncreasePercent }},
+	{name: "SERVER-MAX-MEMORY-USAGE", unit: "bytes", csvRow: "SERVER-MAX-MEMORY-USAGE", isBytes: true, threshold: func(th CompareThresholds) float64 { return th.MemoryIncreasePercent }},
+	{name: "SERVER-AVG-DISK-SPACE-USAGE", unit: "bytes", csvRow: "SERVER-AVG-DISK-SPACE-USAGE", isBytes: true, threshold: func(th CompareThresholds) float64 { return th.DiskIncreasePercent }},
+}
+
+// ResultSet is the results of the databases in one benchmark run.
+type ResultSet struct {
+	Path      string
+	Databases []ResultSetDatabase
+}
+
+// ResultSetDatabase is the metric values of a database, in raw units.
+// Metrics that are not in the results are missing from 'Values'.
+type ResultSetDatabase struct {
+	Name   string
+	Values map[string]float64
+}
+
+// Regression is a metric that is worse than the baseline beyond the threshold.
+type Regression struct {
+	Database     string
+	Baseline     string
+	Metric       string
+	DeltaPercent float64
+}
+
+func (r Regression) String() string {
+	return fmt.Sprintf("%s %s %+.2f%% compared to %s", r.Database, r.Metric, r.DeltaPercent, r.Baseline)
+}
+
+// ReadResultSet reads the aggregated CSV of 'analyze' ('all_aggregated_output_path_csv'),
+// the machine-readable results of a database ('results.json'), or of all
+// databases ('results.jsonl').
+func ReadResultSet(fpath string) (ResultSet, error) {
+	switch filepath.Ext(fpath) {
+	case ".csv":
+		return readResultSetCSV(fpath)
+	case ".json":
+		rs, err := ReadResults(fpath)
+		if err != nil {
+			return ResultSet{}, err
+		}
+		return ResultSet{Path: fpath, Databases: []ResultSetDatabase{resultsToDatabase(rs)}}, nil
+	case ".jsonl":
+		return readResultSetJSONL(fpath)
+	default:
+		return ResultSet{}, fmt.Errorf("%q is not supported; expected .csv, .json or .jsonl", fpath)
+	}
+}
+
+func readResultSetCSV(fpath string) (ResultSet, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return ResultSet{}, err
+	}
+	defer f.Close()
+	rd := csv.NewReader(f)
+	rd.FieldsPerRecord = -1
+	rows, err := rd.ReadAll()
+	if err != nil {
+		return ResultSet{}, fmt.Errorf("%v (%q)", err, fpath)
+	}
+	if len(rows) < 2 || len(rows[0]) < 2 {
+		return ResultSet{}, fmt.Errorf("%q has no database", fpath)
+	}
+
+	// first row is the header of database tags, with empty first column
+	set := ResultSet{Path: fpath}
+	for _, tag := range rows[0][1:] {
+		set.Databases = append(set.Databases, ResultSetDatabase{Name: tag, Values: make(map[string]float64)})
+	}
+	for _, m := range compareMetrics {
+		for _, row := range rows[1:] {
+			if row[0] != m.csvRow {
+				continue
+			}
+			for i, s := range row[1:] {
+				if i >= len(set.Databases) {
+					break
+				}
+				v, err := parseHumanized(s, m.isBytes)
+				if err != nil {
+					return ResultSet{}, fmt.Errorf("%q has invalid %q value %q (%v)", fpath, m.csvRow, s, err)
+				}
+				set.Databases[i].Values[m.name] = v
+			}
+		}
+	}
+	return set, nil
+}
+
+// parseHumanized parses the value of aggregated CSV
+// (e.g. '35,740 req/sec', '27.9170 ms' or '1.2 GB').
+func parseHumanized(s string, isBytes bool) (float64, error) {
+	s = strings.TrimSpace(s)
+	if isBytes {
+		v, err := humanize.ParseBytes(s)
+		return float64(v), err
+	}
+	fields := strings.Fields(strings.Replace(s, ",", "", -1))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty value")
+	}
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+func readResultSetJSONL(fpath string) (ResultSet, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return ResultSet{}, err
+	}
+	defer f.Close()
+
+	set := ResultSet{Path: fpath}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 1024*1024), 64*1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var rs Results
+		if err = json.Unmarshal(scanner.Bytes(), &rs); err != nil {
+			return ResultSet{}, fmt.Errorf("%v (%q)", err, fpath)
+		}
+		if rs.SchemaVersion != ResultsSchemaVersion {
+			return ResultSet{}, fmt.Errorf("%q has schema version %d, expected %d", fpath, rs.SchemaVersion, ResultsSchemaVersion)
+		}
+		set.Databases = append(set.Databases, resultsToDatabase(&rs))
+	}
+	if err = scanner.Err(); err != nil {
+		return ResultSet{}, err
+	}
+	if len(set.Databases) == 0 {
+		return ResultSet{}, fmt.Errorf("%q has no database", fpath)
+	}
+	return set, nil
+}
+
+// resultsToDatabase returns the metric values of machine-readable results.
+// Server memory and disk usage are missing if the databases are not stopped.
+func resultsToDatabase(rs *Results) ResultSetDatabase {
+	db := ResultSetDatabase{Name: rs.DatabaseTag, Values: make(map[string]float64)}
+	if db.Name == "" {
+		db.Name = rs.DatabaseID
+	}
+	db.Values["AVG-THROUGHPUT"] = rs.Summary.RequestsPerSecond
+	db.Values["AVG-LATENCY"] = rs.Summary.AverageLatencyMs
+	for _, p := range rs.Percentiles {
+		switch p.Percentile {
+		case 50:
+			db.Values["P50-LATENCY"] = p.LatencyMs
+		case 90:
+			db.Values["P90-LATENCY"] = p.LatencyMs
+		case 99:
+			db.Values["P99-LATENCY"] = p.LatencyMs
+		case 99.9:
+			db.Values["P99.9-LATENCY"] = p.LatencyMs
+		}
+	}
+	if rs.Server != nil {
+		db.Values["SERVER-MAX-MEMORY-USAGE"] = float64(rs.Server.MaxMemoryBytes)
+		db.Values["SERVER-AVG-DISK-SPACE-USAGE"] = rs.Server.AvgDiskSpaceUsageBytes
+	}
+	return db
+}
+
+// CompareResultSets compares the databases of each result set to the
+// databases of the first (baseline) result set in the same order, and
+// returns the comparison in markdown and the regressions beyond thresholds.
+// It returns an error if paired databases are of different kinds (e.g.
+// etcd and Zookeeper), and warns if their names differ (e.g. versions).
+func CompareResultSets(sets []ResultSet, th CompareThresholds) (string, []Regression, error) {
+	if len(sets) < 2 {
+		return "", nil, fmt.Errorf("expected at least 2 result sets, got %d", len(sets))
+	}
+	base := sets[0]
+	for _, set := range sets[1:] {
+		if len(set.Databases) != len(base.Databases) {
+			return "", nil, fmt.Errorf("%q has %d databases, while baseline %q has %d", set.Path, len(set.Databases), base.Path, len(base.Databases))
+		}
+		for i, db := range set.Databases {
+			bname := base.Databases[i].Name
+			if db.Name == bname {
+				continue
+			}
+			if databaseKind(db.Name) != databaseKind(bname) {
+				return "", nil, fmt.Errorf("%q has %q at %d, while baseline %q has %q (databases must be in the same order)", set.Path, db.Name, i+1, base.Path, bname)
+			}
+			plog.Warningf("comparing %q of %q to %q of baseline %q", db.Name, set.Path, bname, base.Path)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	var regs []Regression
+	for i, bdb := range base.Databases {
+		buf.WriteString(fmt.Sprintf("<br><br><hr>\n##### %s\n\n", bdb.Name))
+
+		buf.WriteString(fmt.Sprintf("| | %s (baseline) |", bdb.Name))
+		for _, set := range sets[1:] {
+			buf.WriteString(fmt.Sprintf(" %s |", set.Databases[i].Name))
+		}
+		buf.WriteString("\n|---|---|")
+		for range sets[1:] {
+			buf.WriteString("---|")
+		}
+		buf.WriteString("\n")
+
+		for _, m := range compareMetrics {
+			bv, ok := bdb.Values[m.name]
+			found := ok
+			for _, set := range sets[1:] {
+				if _, ok := set.Databases[i].Values[m.name]; ok {
+					found = true
+				}
+			}
+			if !found {
+				continue
+			}
+
+			buf.WriteString(fmt.Sprintf("| %s | %s |", m.name, formatCompareValue(m, bv, ok)))
+			for _, set := range sets[1:] {
+				db := set.Databases[i]
+				v, vok := db.Values[m.name]
+				cell := formatCompareValue(m, v, vok)
+				if ok && vok && bv != 0 {
+					delta := 100 * (v - bv) / bv
+					cell += fmt.Sprintf(" (%+.2f%%)", delta)
+					worse := delta
+					if m.higherIsBetter {
+						worse = -delta
+					}
+					if limit := m.threshold(th); limit >= 0 && worse > limit {
+						cell += " **REGRESSION**"
+						regs = append(regs, Regression{Database: db.Name, Baseline: bdb.Name, Metric: m.name, DeltaPercent: delta})
+					}
+				}
+				buf.WriteString(" " + cell + " |")
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString("\n\n")
+	}
+	return buf.String(), regs, nil
+}
+
+// databaseKind returns the database of the tag or ID without
+// version (e.g. 'etcd' of 'etcd-v3.2-go1.8.3' or 'etcd__v3_2').
+func databaseKind(name string) string {
+	if i := strings.IndexAny(name, "-_"); i > 0 {
+		name = name[:i]
+	}
+	return strings.ToLower(name)
+}
+
+func formatCompareValue(m compareMetric, v float64, ok bool) string {
+	if !ok {
+		return "-"
+	}
+	switch m.unit {
+	case "bytes":
+		return humanize.Bytes(uint64(v))
+	case "req/sec":
+		return fmt.Sprintf("%s %s", humanize.Comma(int64(math.Floor(v+0.5))), m.unit)
+	default:
+		return fmt.Sprintf("%.4f %s", v, m.unit)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compare compares benchmark results to the baseline, and detects regressions.
+package compare
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/coreos/dbtester"
+	"github.com/spf13/cobra"
+)
+
+// Command implements 'compare' command.
+var Command = &cobra.Command{
+	Use:   "compare [baseline] [results...]",
+	Short: "Compares test results to the baseline.",
+	RunE:  commandFunc,
+}
+
+var outputPath string
+var thresholds dbtester.CompareThresholds
+
+func init() {
+	Command.Flags().StringVarP(&outputPath, "output", "o", "", "File path to write the comparison in markdown, in addition to stdout.")
+	Command.Flags().Float64Var(&thresholds.ThroughputDropPercent, "max-throughput-drop", 5, "Percent of throughput drop to flag as regression, negative to disable.")
+	Command.Flags().Float64Var(&thresholds.LatencyIncreasePercent, "max-latency-increase", 10, "Percent of latency increase (average and percentiles) to flag as regression, negative to disable.")
+	Command.Flags().Float64Var(&thresholds.MemoryIncreasePercent, "max-memory-increase", 10, "Percent of server memory usage increase to flag as regression, negative to disable.")
+	Command.Flags().Float64Var(&thresholds.DiskIncreasePercent, "max-disk-increase", 10, "Percent of server disk space usage increase to flag as regression, negative to disable.")
+}
+
+func commandFunc(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("expected baseline and at least 1 result to compare, got %d", len(args))
+	}
+
+	sets := make([]dbtester.ResultSet, 0, len(args))
+	for _, fpath := range args {
+		set, err := dbtester.ReadResultSet(fpath)
+		if err != nil {
+			return err
+		}
+		sets = append(sets, set)
+	}
+
+	md, regs, err := dbtester.CompareResultSets(sets, thresholds)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(os.Stdout, md)
+	if outputPath != "" {
+		plog.Printf("writing comparison at %q", outputPath)
+		if err = ioutil.WriteFile(outputPath, []byte(md), 0644); err != nil {
+			return err
+		}
+	}
+
+	if len(regs) > 0 {
+		for _, r := range regs {
+			plog.Warningf("regression: %s", r)
+		}
+		return fmt.Errorf("%d regressions compared to %q", len(regs), args[0])
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testAggregatedCSV = `,etcd-v3.1-go1.8.3
+TOTAL-SECONDS,27.9797 sec
+AVG-THROUGHPUT,"35,740 req/sec"
+AVG-LATENCY,27.9170 ms
+Latency p50,22.047040 ms
+Latency p90,53.916881 ms
+Latency p99,73.229996 ms
+Latency p99.9,94.903421 ms
+SERVER-MAX-MEMORY-USAGE,1.2 GB
+SERVER-AVG-DISK-SPACE-USAGE,2.6 GB
+`
+
+func TestCompareResultSets(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "compare")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	csvPath := filepath.Join(dir, "aggregated.csv")
+	if err = ioutil.WriteFile(csvPath, []byte(testAggregatedCSV), 0644); err != nil {
+		t.Fatal(err)
+	}
+	base, err := ReadResultSet(csvPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expBase := ResultSetDatabase{Name: "etcd-v3.1-go1.8.3", Values: map[string]float64{
+		"AVG-THROUGHPUT":              35740,
+		"AVG-LATENCY":                 27.917,
+		"P50-LATENCY":                 22.04704,
+		"P90-LATENCY":                 53.916881,
+		"P99-LATENCY":                 73.229996,
+		"P99.9-LATENCY":               94.903421,
+		"SERVER-MAX-MEMORY-USAGE":     1200000000,
+		"SERVER-AVG-DISK-SPACE-USAGE": 2600000000,
+	}}
+	if len(base.Databases) != 1 || !reflect.DeepEqual(base.Databases[0], expBase) {
+		t.Fatalf("expected %+v, got %+v", expBase, base.Databases)
+	}
+
+	rs := Results{SchemaVersion: ResultsSchemaVersion, DatabaseID: "etcd__v3_2", DatabaseTag: "etcd-v3.2-go1.8.3"}
+	rs.Summary.RequestsPerSecond = 30000
+	rs.Summary.AverageLatencyMs = 28
+	rs.Percentiles = []ResultsPercentile{{Percentile: 50, LatencyMs: 22}, {Percentile: 99, LatencyMs: 90}}
+	rs.Server = &ResultsServer{MaxMemoryBytes: 1500000000, AvgDiskSpaceUsageBytes: 2600000000}
+	bts, err := json.Marshal(rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonlPath := filepath.Join(dir, "results.jsonl")
+	if err = ioutil.WriteFile(jsonlPath, append(bts, '\n'), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cur, err := ReadResultSet(jsonlPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	th := CompareThresholds{ThroughputDropPercent: 5, LatencyIncreasePercent: 10, MemoryIncreasePercent: 10, DiskIncreasePercent: -1}
+	md, regs, err := CompareResultSets([]ResultSet{base, cur}, th)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var metrics []string
+	for _, r := range regs {
+		metrics = append(metrics, r.Metric)
+	}
+	if !reflect.DeepEqual(metrics, []string{"AVG-THROUGHPUT", "P99-LATENCY", "SERVER-MAX-MEMORY-USAGE"}) {
+		t.Fatalf("unexpected regressions %v", regs)
+	}
+	for _, s := range []string{
+		"| | etcd-v3.1-go1.8.3 (baseline) | etcd-v3.2-go1.8.3 |",
+		"| AVG-THROUGHPUT | 35,740 req/sec | 30,000 req/sec (-16.06%) **REGRESSION** |",
+		"| AVG-LATENCY | 27.9170 ms | 28.0000 ms (+0.30%) |",
+		"| P90-LATENCY | 53.9169 ms | - |",
+		"| SERVER-MAX-MEMORY-USAGE | 1.2 GB | 1.5 GB (+25.00%) **REGRESSION** |",
+		"| SERVER-AVG-DISK-SPACE-USAGE | 2.6 GB | 2.6 GB (+0.00%) |",
+	} {
+		if !strings.Contains(md, s) {
+			t.Fatalf("expected %q in\n%s", s, md)
+		}
+	}
+
+	if _, _, err = CompareResultSets([]ResultSet{base}, th); err == nil {
+		t.Fatal("expected error with 1 result set")
+	}
+
+	other := ResultSet{Path: "zookeeper.json", Databases: []ResultSetDatabase{{Name: "zookeeper-r3.5.3-beta-java8", Values: map[string]float64{"AVG-THROUGHPUT": 30000}}}}
+	if _, _, err = CompareResultSets([]ResultSet{base, other}, th); err == nil {
+		t.Fatal("expected error with different databases")
+	}
+}
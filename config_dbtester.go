@@ -19,6 +19,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/coreos/dbtester/dbtesterpb"
 
@@ -50,6 +51,31 @@ type Config struct {
 	AnalyzePlotPathPrefix                              string                                `yaml:"analyze_plot_path_prefix"`
 	AnalyzePlotList                                    []dbtesterpb.ConfigAnalyzeMachinePlot `yaml:"analyze_plot_list"`
 	dbtesterpb.ConfigAnalyzeMachineREADME              `yaml:"analyze_readme"`
+
+	ConfigMatrix ConfigMatrix `yaml:"matrix"`
+
+	ConfigSLOSearch ConfigSLOSearch `yaml:"slo_search"`
+
+	// Repeat is the number of trials to run the same benchmark,
+	// restarting the database between trials.
+	Repeat int `yaml:"repeat"`
+
+	// MatrixCombinationName is the name of matrix combination,
+	// empty if the configuration has no 'matrix' section.
+	MatrixCombinationName string `yaml:"-"`
+	// TrialIndex is the 1-based index of the trial,
+	// zero if the configuration does not repeat.
+	TrialIndex int `yaml:"-"`
+
+	// profiler captures profiles during benchmarks, if started
+	profiler *Profiler
+	// metrics records live request metrics during benchmarks, if started
+	metrics *Metrics
+	// dashboard draws live progress during benchmarks, if started
+	dashboard *Dashboard
+	// binaries are the database binaries reported by agents on start,
+	// saved in the machine-readable results
+	binaries []ResultsBinary
 }
 
 // ReadConfig reads control configuration file.
@@ -58,16 +84,33 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	return readConfig(bts, analyze, nil, 0)
+}
+
+func readConfig(bts []byte, analyze bool, comb MatrixCombination, trial int) (*Config, error) {
 	cfg := Config{}
-	if err = yaml.Unmarshal(bts, &cfg); err != nil {
+	err := yaml.Unmarshal(bts, &cfg)
+	if err != nil {
 		return nil, err
 	}
+	if comb != nil {
+		if err = cfg.applyMatrixCombination(comb); err != nil {
+			return &cfg, err
+		}
+	}
+	cfg.TrialIndex = trial
+	if name := cfg.RunName(); name != "" {
+		cfg.moveResultsDir(name)
+	}
 
 	for _, id := range cfg.AllDatabaseIDList {
 		if !dbtesterpb.IsValidDatabaseID(id) {
 			return nil, fmt.Errorf("databaseID %q is unknown", id)
 		}
 	}
+	if err = cfg.ConfigSLOSearch.validate(); err != nil {
+		return nil, err
+	}
 
 	if cfg.ConfigClientMachineInitial.PathPrefix != "" {
 		cfg.ConfigClientMachineInitial.LogPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.LogPath)
@@ -85,6 +128,31 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		if !dbtesterpb.IsValidDatabaseID(databaseID) {
 			return nil, fmt.Errorf("databaseID %q is unknown", databaseID)
 		}
+		if err = validateExtraFlags(databaseID, group); err != nil {
+			return nil, err
+		}
+		if c := group.ConfigDatabaseContainer; c != nil {
+			if c.Image == "" {
+				return nil, fmt.Errorf("%q got container with no image", databaseID)
+			}
+			if c.Runtime == "" {
+				c.Runtime = "docker"
+			}
+		}
+		if group.ConfigDatabaseContainer != nil && group.ConfigDatabaseCgroup != nil {
+			return nil, fmt.Errorf("%q got both container and cgroup (container has its own limits)", databaseID)
+		}
+		if p := group.ConfigProfile; p != nil {
+			if strings.HasPrefix(databaseID, "zookeeper") {
+				return nil, fmt.Errorf("%q got profile, which is only for Go databases", databaseID)
+			}
+			if p.CooldownSecond == 0 {
+				p.CooldownSecond = 60
+			}
+			if p.CPUSecond == 0 {
+				p.CPUSecond = 10
+			}
+		}
 
 		group.DatabaseID = databaseID
 		group.DatabaseTag = MakeTag(group.DatabaseDescription)
@@ -119,18 +187,51 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 				amc.ServerSystemMetricsInterpolatedPathList[i] = amc.PathPrefix + "-" + amc.ServerSystemMetricsInterpolatedPathList[i]
 			}
 			amc.AllAggregatedOutputPath = amc.PathPrefix + "-" + amc.AllAggregatedOutputPath
+			for i := range amc.ServerDatabaseMetricsPathList {
+				amc.ServerDatabaseMetricsPathList[i] = amc.PathPrefix + "-" + amc.ServerDatabaseMetricsPathList[i]
+			}
+			for i := range amc.ServerGCPausesPathList {
+				amc.ServerGCPausesPathList[i] = amc.PathPrefix + "-" + amc.ServerGCPausesPathList[i]
+			}
+			for i := range amc.ServerDatabaseLogPathList {
+				amc.ServerDatabaseLogPathList[i] = amc.PathPrefix + "-" + amc.ServerDatabaseLogPathList[i]
+			}
 		}
 
 		cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID] = amc
 	}
 
 	for databaseID, ctrl := range cfg.DatabaseIDToConfigClientMachineAgentControl {
-		if databaseID != dbtesterpb.DatabaseID_etcd__v3_1.String() &&
-			databaseID != dbtesterpb.DatabaseID_etcd__v3_2.String() &&
-			databaseID != dbtesterpb.DatabaseID_etcd__tip.String() &&
+		if !sharesConnections(databaseID) &&
 			ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber != ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber {
 			return nil, fmt.Errorf("%q got connected %d != clients %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber, ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber)
 		}
+		if n := int64(len(ctrl.ConfigClientMachineBenchmarkOptions.LoadGeneratorEndpoints)); n > 0 {
+			if len(ctrl.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) > 0 {
+				return nil, fmt.Errorf("%q got load generator endpoints with variable client numbers", databaseID)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber < n {
+				return nil, fmt.Errorf("%q got clients %d < load generators %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber, n)
+			}
+			// each load generator needs at least one connection to share among its clients
+			if ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber < n {
+				return nil, fmt.Errorf("%q got connections %d < load generators %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber, n)
+			}
+			// the rate limit is split, and 0 would not rate limit a load generator
+			if rl := ctrl.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond; rl > 0 && rl < n {
+				return nil, fmt.Errorf("%q got rate limit %d < load generators %d", databaseID, rl, n)
+			}
+			// the latency trigger only sees the requests that 'control' sends
+			if p := ctrl.ConfigProfile; p != nil && p.P99LatencyMs > 0 {
+				return nil, fmt.Errorf("%q got profile 'p99_latency_ms' with load generator endpoints", databaseID)
+			}
+		}
+		switch n := ctrl.ConfigClientMachineBenchmarkOptions.SampleIntervalMillisecond; {
+		case n == 0:
+			ctrl.ConfigClientMachineBenchmarkOptions.SampleIntervalMillisecond = defaultSampleIntervalMillisecond
+		case n < 0 || 1000%n != 0:
+			return nil, fmt.Errorf("%q got sample interval %d ms, which does not divide 1000 ms", databaseID, n)
+		}
 	}
 
 	const (
@@ -356,6 +457,18 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 
 const maxEtcdQuotaSize = 8000000000
 
+// defaultSampleIntervalMillisecond is the default interval of
+// client latency and throughput timeseries.
+const defaultSampleIntervalMillisecond = 1000
+
+// sampleInterval returns the interval of client latency and throughput timeseries.
+func sampleInterval(gcfg dbtesterpb.ConfigClientMachineAgentControl) time.Duration {
+	if gcfg.ConfigClientMachineBenchmarkOptions.SampleIntervalMillisecond <= 0 {
+		return defaultSampleIntervalMillisecond * time.Millisecond
+	}
+	return time.Duration(gcfg.ConfigClientMachineBenchmarkOptions.SampleIntervalMillisecond) * time.Millisecond
+}
+
 // ToRequest converts configuration to 'dbtesterpb.Request'.
 func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int) (req *dbtesterpb.Request, err error) {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
@@ -379,6 +492,15 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 			GoogleCloudStorageBucketName:   cfg.ConfigClientMachineInitial.GoogleCloudStorageBucketName,
 			GoogleCloudStorageSubDirectory: cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory,
 		},
+		ExtraFlags:    gcfg.ExtraFlags,
+		ExtraJVMFlags: gcfg.ExtraJVMFlags,
+		ExtraZooCfg:   gcfg.ExtraZooCfg,
+
+		ConfigDatabaseBinary:    gcfg.ConfigDatabaseBinary,
+		ConfigDatabaseContainer: gcfg.ConfigDatabaseContainer,
+		ConfigDatabaseCgroup:    gcfg.ConfigDatabaseCgroup,
+		GCLog:                   gcfg.GCLog,
+		ConfigProfile:           gcfg.ConfigProfile,
 	}
 
 	switch req.DatabaseID {
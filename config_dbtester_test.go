@@ -15,7 +15,10 @@
 package dbtester
 
 import (
+	"fmt"
+	"io/ioutil"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/coreos/dbtester/dbtesterpb"
@@ -84,6 +87,7 @@ func TestConfig(t *testing.T) {
 					KeySizeBytes:               256,
 					ValueSizeBytes:             1024,
 					StaleRead:                  false,
+					SampleIntervalMillisecond:  1000,
 				},
 				ConfigClientMachineBenchmarkSteps: &dbtesterpb.ConfigClientMachineBenchmarkSteps{
 					Step1StartDatabase:  true,
@@ -124,6 +128,7 @@ func TestConfig(t *testing.T) {
 					KeySizeBytes:               256,
 					ValueSizeBytes:             1024,
 					StaleRead:                  false,
+					SampleIntervalMillisecond:  1000,
 				},
 				ConfigClientMachineBenchmarkSteps: &dbtesterpb.ConfigClientMachineBenchmarkSteps{
 					Step1StartDatabase:  true,
@@ -153,6 +158,7 @@ func TestConfig(t *testing.T) {
 					KeySizeBytes:               256,
 					ValueSizeBytes:             1024,
 					StaleRead:                  false,
+					SampleIntervalMillisecond:  1000,
 				},
 				ConfigClientMachineBenchmarkSteps: &dbtesterpb.ConfigClientMachineBenchmarkSteps{
 					Step1StartDatabase:  true,
@@ -558,3 +564,99 @@ func TestConfig(t *testing.T) {
 		t.Fatalf("configuration expected\n%+v\n, got\n%+v\n", expected2, req2)
 	}
 }
+
+func TestConfigLoadGenerators(t *testing.T) {
+	bts, err := ioutil.ReadFile("config_dbtester_test.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		connN, clientN, rateLimit int64
+		ok                        bool
+	}{
+		{2, 2, 0, true},
+		{100, 1000, 0, true},
+		{100, 1000, 2, true},
+		{1, 2, 0, false},
+		{2, 1, 0, false},
+		{100, 1000, 1, false},
+	}
+	for i, tt := range tests {
+		// etcd tip is the first database with variable client numbers
+		yml := strings.Replace(string(bts), `      connection_number: 0
+      client_number: 0
+      # if specified, overwrite 'connection_number', 'connection_number'
+      connection_client_numbers: [1, 10, 50, 100, 300, 500, 700, 1000]
+`, fmt.Sprintf(`      connection_number: %d
+      client_number: %d
+      load_generator_endpoints: ["10.240.0.20:3600", "10.240.0.21:3600"]
+      rate_limit_requests_per_second: %d
+`, tt.connN, tt.clientN, tt.rateLimit), 1)
+		yml = strings.Replace(yml, `
+      # 0, to not rate limit
+      rate_limit_requests_per_second: 0
+`, "", 1)
+		_, err = readConfig([]byte(yml), false, nil, 0)
+		if (err == nil) != tt.ok {
+			t.Fatalf("#%d: connections %d, clients %d, rate limit %d: expected ok %v, got error %v", i, tt.connN, tt.clientN, tt.rateLimit, tt.ok, err)
+		}
+	}
+}
+
+func TestConfigLoadGeneratorsProfile(t *testing.T) {
+	bts, err := ioutil.ReadFile("config_dbtester_test.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	yml := strings.Replace(string(bts), `      connection_number: 0
+      client_number: 0
+      # if specified, overwrite 'connection_number', 'connection_number'
+      connection_client_numbers: [1, 10, 50, 100, 300, 500, 700, 1000]
+`, `      connection_number: 2
+      client_number: 2
+      load_generator_endpoints: ["10.240.0.20:3600", "10.240.0.21:3600"]
+`, 1)
+	yml = strings.Replace(yml, `    etcd__tip:
+      # --snapshot-count`, `    profile:
+      p99_latency_ms: 100
+
+    etcd__tip:
+      # --snapshot-count`, 1)
+	if _, err = readConfig([]byte(yml), false, nil, 0); err == nil {
+		t.Fatal("expected error for p99 latency trigger with load generators")
+	}
+}
+
+func TestConfigCheckAnalyzeInputs(t *testing.T) {
+	c, err := ReadConfig("config_dbtester_test.yaml", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range c.AllDatabaseIDList {
+		if err = c.CheckAnalyzeInputs(id); err != nil {
+			t.Fatalf("%q: unexpected error %v", id, err)
+		}
+	}
+
+	id := c.AllDatabaseIDList[0]
+	amc := c.DatabaseIDToConfigAnalyzeMachineInitial[id]
+	latency := amc.ClientLatencyDistributionSummaryPath
+	amc.ClientLatencyDistributionSummaryPath = "other-client-latency-distribution-summary.csv"
+	c.DatabaseIDToConfigAnalyzeMachineInitial[id] = amc
+	if err = c.CheckAnalyzeInputs(id); err == nil {
+		t.Fatal("expected error with mismatched client file name")
+	}
+	amc.ClientLatencyDistributionSummaryPath = latency
+	amc.ServerSystemMetricsInterpolatedPathList = []string{"1-server-system-metrics-interpolated.csv"}
+	c.DatabaseIDToConfigAnalyzeMachineInitial[id] = amc
+	if err = c.CheckAnalyzeInputs(id); err == nil {
+		t.Fatal("expected error with server file name without database tag")
+	}
+
+	gcfg := c.DatabaseIDToConfigClientMachineAgentControl[c.AllDatabaseIDList[1]]
+	gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs = false
+	c.DatabaseIDToConfigClientMachineAgentControl[c.AllDatabaseIDList[1]] = gcfg
+	if err = c.CheckAnalyzeInputs(c.AllDatabaseIDList[1]); err == nil {
+		t.Fatal("expected error without uploading logs")
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// agentManagedFlags are the database flags that agents set by themselves,
+// which cannot be overwritten with 'extra_flags'. Flags are without dashes.
+var agentManagedFlags = map[string][]string{
+	"etcd": {
+		"name",
+		"data-dir",
+		"snapshot-count",
+		"quota-backend-bytes",
+		"listen-client-urls",
+		"advertise-client-urls",
+		"listen-peer-urls",
+		"initial-advertise-peer-urls",
+		"initial-cluster-token",
+		"initial-cluster",
+		"initial-cluster-state",
+	},
+	"zetcd": {
+		"zkaddr",
+		"endpoint",
+		"endpoints",
+	},
+	"cetcd": {
+		"consuladdr",
+		"etcd",
+	},
+	"consul": {
+		"server",
+		"data-dir",
+		"bind",
+		"client",
+		"bootstrap-expect",
+		"join",
+	},
+}
+
+// agentManagedJVMFlags are the Java flags that agents set for Zookeeper.
+// Heap sizes and 'jute.maxbuffer' have their own Zookeeper flags.
+var agentManagedJVMFlags = []string{
+	"cp",
+	"classpath",
+	"Xms",
+	"Xmx",
+	"Djute.maxbuffer",
+}
+
+// agentManagedZooCfg are the Zookeeper configuration keys that agents write.
+var agentManagedZooCfg = []string{
+	"tickTime",
+	"dataDir",
+	"clientPort",
+	"initLimit",
+	"syncLimit",
+	"maxClientCnxns",
+	"snapCount",
+	"4lw.commands.whitelist",
+	"server.",
+}
+
+// validateExtraFlags returns an error if the extra flags overwrite
+// the flags managed by agents, or the database does not support them.
+func validateExtraFlags(databaseID string, gcfg dbtesterpb.ConfigClientMachineAgentControl) error {
+	family := strings.Split(databaseID, "__")[0]
+	if family != "zookeeper" && (len(gcfg.ExtraJVMFlags) > 0 || len(gcfg.ExtraZooCfg) > 0 || gcfg.GCLog) {
+		return fmt.Errorf("%q got 'extra_jvm_flags', 'extra_zoo_cfg' or 'gc_log', which are only for Zookeeper", databaseID)
+	}
+
+	if len(gcfg.ExtraFlags) > 0 {
+		if family == "zookeeper" {
+			return fmt.Errorf("%q got 'extra_flags' (use 'extra_jvm_flags' or 'extra_zoo_cfg' for Zookeeper)", databaseID)
+		}
+		for _, fl := range gcfg.ExtraFlags {
+			if !strings.HasPrefix(fl, "-") {
+				// value of the previous flag, or subcommand
+				continue
+			}
+			name := strings.TrimLeft(strings.SplitN(fl, "=", 2)[0], "-")
+			for _, managed := range agentManagedFlags[family] {
+				if name == managed {
+					return fmt.Errorf("%q got 'extra_flags' %q, which is managed by agent", databaseID, fl)
+				}
+			}
+		}
+	}
+
+	for _, fl := range gcfg.ExtraJVMFlags {
+		name := strings.TrimLeft(strings.SplitN(fl, "=", 2)[0], "-")
+		for _, managed := range agentManagedJVMFlags {
+			if name == managed || (strings.HasPrefix(managed, "X") && strings.HasPrefix(name, managed)) {
+				return fmt.Errorf("%q got 'extra_jvm_flags' %q, which is managed by agent", databaseID, fl)
+			}
+		}
+	}
+
+	for _, line := range gcfg.ExtraZooCfg {
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("%q got 'extra_zoo_cfg' %q, expected 'key=value'", databaseID, line)
+		}
+		key := strings.TrimSpace(kv[0])
+		for _, managed := range agentManagedZooCfg {
+			if key == managed || (strings.HasSuffix(managed, ".") && strings.HasPrefix(key, managed)) {
+				return fmt.Errorf("%q got 'extra_zoo_cfg' %q, which is managed by agent", databaseID, line)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestValidateExtraFlags(t *testing.T) {
+	tests := []struct {
+		databaseID string
+		gcfg       dbtesterpb.ConfigClientMachineAgentControl
+		ok         bool
+	}{
+		{"etcd__tip", dbtesterpb.ConfigClientMachineAgentControl{ExtraFlags: []string{"--heartbeat-interval=100", "--election-timeout", "1000"}}, true},
+		{"etcd__tip", dbtesterpb.ConfigClientMachineAgentControl{ExtraFlags: []string{"--data-dir=/tmp"}}, false},
+		{"etcd__v2_3", dbtesterpb.ConfigClientMachineAgentControl{ExtraFlags: []string{"-snapshot-count", "10"}}, false},
+		{"etcd__tip", dbtesterpb.ConfigClientMachineAgentControl{ExtraJVMFlags: []string{"-XX:+UseG1GC"}}, false},
+		{"consul__v0_8_4", dbtesterpb.ConfigClientMachineAgentControl{ExtraFlags: []string{"-raft-protocol=3"}}, true},
+		{"consul__v0_8_4", dbtesterpb.ConfigClientMachineAgentControl{ExtraFlags: []string{"-join", "10.0.0.1"}}, false},
+		{"zetcd__beta", dbtesterpb.ConfigClientMachineAgentControl{ExtraFlags: []string{"-zkaddr=:2182"}}, false},
+		{"zookeeper__r3_5_3_beta", dbtesterpb.ConfigClientMachineAgentControl{ExtraFlags: []string{"-foo"}}, false},
+		{"zookeeper__r3_5_3_beta", dbtesterpb.ConfigClientMachineAgentControl{ExtraJVMFlags: []string{"-XX:+UseG1GC"}, ExtraZooCfg: []string{"preAllocSize=65536"}}, true},
+		{"zookeeper__r3_5_3_beta", dbtesterpb.ConfigClientMachineAgentControl{ExtraJVMFlags: []string{"-Xmx50G"}}, false},
+		{"zookeeper__r3_5_3_beta", dbtesterpb.ConfigClientMachineAgentControl{ExtraZooCfg: []string{"server.4=10.0.0.4:2888:3888"}}, false},
+		{"zookeeper__r3_5_3_beta", dbtesterpb.ConfigClientMachineAgentControl{ExtraZooCfg: []string{"preAllocSize"}}, false},
+	}
+	for i, tt := range tests {
+		err := validateExtraFlags(tt.databaseID, tt.gcfg)
+		if (err == nil) != tt.ok {
+			t.Fatalf("#%d: expected ok %v, got error %v", i, tt.ok, err)
+		}
+	}
+}
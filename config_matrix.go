@@ -0,0 +1,295 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ConfigMatrix defines benchmark options and database flags to sweep over.
+// Each combination of values runs as a separate test, with its own results
+// directory under 'path_prefix'.
+type ConfigMatrix struct {
+	KeySizeBytes   []int64 `yaml:"key_size_bytes"`
+	ValueSizeBytes []int64 `yaml:"value_size_bytes"`
+	ClientNumbers  []int64 `yaml:"client_numbers"`
+	// ConnectionNumbers only applies to the databases whose clients
+	// share connections (etcd); others have one connection per client.
+	ConnectionNumbers          []int64 `yaml:"connection_numbers"`
+	RateLimitRequestsPerSecond []int64 `yaml:"rate_limit_requests_per_second"`
+
+	// DatabaseFlags maps a database flag (e.g. 'snap_count', 'quota_size_bytes')
+	// to its values. Databases without the flag are not affected.
+	DatabaseFlags map[string][]int64 `yaml:"database_flags"`
+}
+
+type matrixValue struct {
+	key   string
+	value int64
+}
+
+// MatrixCombination is one combination of matrix values.
+type MatrixCombination []matrixValue
+
+// Name returns the name of the combination, which is used as results directory.
+func (mc MatrixCombination) Name() string {
+	ss := make([]string, len(mc))
+	for i, v := range mc {
+		ss[i] = fmt.Sprintf("%s-%d", strings.Replace(v.key, "_", "-", -1), v.value)
+	}
+	return strings.Join(ss, "_")
+}
+
+// Combinations returns all combinations of matrix values.
+func (m ConfigMatrix) Combinations() []MatrixCombination {
+	type dimension struct {
+		key    string
+		values []int64
+	}
+	dims := []dimension{
+		{"key_size_bytes", m.KeySizeBytes},
+		{"value_size_bytes", m.ValueSizeBytes},
+		{"client_number", m.ClientNumbers},
+		{"connection_number", m.ConnectionNumbers},
+		{"rate_limit_requests_per_second", m.RateLimitRequestsPerSecond},
+	}
+	var flagKeys []string
+	for k := range m.DatabaseFlags {
+		flagKeys = append(flagKeys, k)
+	}
+	sort.Strings(flagKeys)
+	for _, k := range flagKeys {
+		dims = append(dims, dimension{k, m.DatabaseFlags[k]})
+	}
+
+	var combs []MatrixCombination
+	for _, dim := range dims {
+		if len(dim.values) == 0 {
+			continue
+		}
+		if len(combs) == 0 {
+			combs = []MatrixCombination{{}}
+		}
+		var expanded []MatrixCombination
+		for _, comb := range combs {
+			for _, v := range dim.values {
+				copied := make(MatrixCombination, len(comb), len(comb)+1)
+				copy(copied, comb)
+				expanded = append(expanded, append(copied, matrixValue{key: dim.key, value: v}))
+			}
+		}
+		combs = expanded
+	}
+	return combs
+}
+
+// ReadConfigMatrix reads control configuration file, and returns
+// one configuration per matrix combination and trial. It returns only
+// one configuration if the file has no 'matrix' section and 'repeat'
+// is less than 2.
+func ReadConfigMatrix(fpath string, analyze bool) ([]*Config, error) {
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	cfg := Config{}
+	if err = yaml.Unmarshal(bts, &cfg); err != nil {
+		return nil, err
+	}
+
+	combs := cfg.ConfigMatrix.Combinations()
+	if len(combs) == 0 {
+		combs = []MatrixCombination{nil}
+	}
+	trials := []int{0}
+	if cfg.Repeat > 1 {
+		trials = make([]int, cfg.Repeat)
+		for i := range trials {
+			trials[i] = i + 1
+		}
+	}
+
+	cfgs := make([]*Config, 0, len(combs)*len(trials))
+	for _, comb := range combs {
+		for _, trial := range trials {
+			c, err := readConfig(bts, analyze, comb, trial)
+			if err != nil {
+				if c != nil && c.RunName() != "" {
+					err = fmt.Errorf("%v (%q)", err, c.RunName())
+				}
+				return nil, err
+			}
+			cfgs = append(cfgs, c)
+		}
+	}
+	return cfgs, nil
+}
+
+// TrialName returns the name of the trial, which is used as results directory.
+// It returns empty string if the configuration does not repeat.
+func (cfg *Config) TrialName() string {
+	if cfg.TrialIndex == 0 {
+		return ""
+	}
+	return fmt.Sprintf("trial-%d", cfg.TrialIndex)
+}
+
+// RunName returns the results directory of the matrix combination and trial,
+// relative to the configured paths.
+func (cfg *Config) RunName() string {
+	return path.Join(cfg.MatrixCombinationName, cfg.TrialName())
+}
+
+// TrimRunDir removes the results directory 'name' from the path,
+// which was added for a matrix combination or trial.
+func TrimRunDir(p, name string) string {
+	if name == "" {
+		return p
+	}
+	p = strings.Replace(p, name+"/", "", 1)
+	return strings.TrimSuffix(strings.TrimSuffix(p, name), "/")
+}
+
+// applyMatrixCombination overwrites benchmark options and database flags
+// with the combination values.
+func (cfg *Config) applyMatrixCombination(comb MatrixCombination) error {
+	cfg.MatrixCombinationName = comb.Name()
+
+	for _, v := range comb {
+		found := false
+		for databaseID, gcfg := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+			switch v.key {
+			case "key_size_bytes":
+				gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes = v.value
+				found = true
+			case "value_size_bytes":
+				gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes = v.value
+				found = true
+			case "client_number":
+				gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber = v.value
+				// one connection per client if connections are not shared,
+				// or not configured (e.g. with 'connection_client_numbers')
+				if !sharesConnections(databaseID) || gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber == 0 {
+					gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber = v.value
+				}
+				gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers = nil
+				found = true
+			case "connection_number":
+				if sharesConnections(databaseID) {
+					gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber = v.value
+					found = true
+				}
+			case "rate_limit_requests_per_second":
+				gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond = v.value
+				found = true
+			default:
+				if setDatabaseFlag(&gcfg, databaseID, v.key, v.value) {
+					found = true
+				}
+			}
+			cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+		}
+		if !found {
+			return fmt.Errorf("no database has flag %q", v.key)
+		}
+	}
+	return nil
+}
+
+// sharesConnections returns true if the clients of the database share
+// connections, so that connection number may differ from client number.
+func sharesConnections(databaseID string) bool {
+	switch databaseID {
+	case dbtesterpb.DatabaseID_etcd__v3_1.String(),
+		dbtesterpb.DatabaseID_etcd__v3_2.String(),
+		dbtesterpb.DatabaseID_etcd__tip.String():
+		return true
+	}
+	return false
+}
+
+// moveResultsDir moves all results paths to the directory 'name'.
+// It must be called before paths are prefixed.
+func (cfg *Config) moveResultsDir(name string) {
+	cfg.ConfigClientMachineInitial.PathPrefix = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, name)
+	if cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory != "" {
+		cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory = path.Join(cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, name)
+	}
+
+	for databaseID, amc := range cfg.DatabaseIDToConfigAnalyzeMachineInitial {
+		if amc.PathPrefix != "" {
+			amc.PathPrefix = insertDir(strings.TrimSpace(amc.PathPrefix), name)
+		}
+		cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID] = amc
+	}
+	cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV = insertDir(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV, name)
+	cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathTXT = insertDir(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathTXT, name)
+	cfg.AnalyzePlotPathPrefix = filepath.Join(cfg.AnalyzePlotPathPrefix, name)
+	cfg.ConfigAnalyzeMachineREADME.OutputPath = insertDir(cfg.ConfigAnalyzeMachineREADME.OutputPath, name)
+	for i := range cfg.ConfigAnalyzeMachineREADME.Images {
+		cfg.ConfigAnalyzeMachineREADME.Images[i].Path = insertDir(cfg.ConfigAnalyzeMachineREADME.Images[i].Path, name)
+	}
+}
+
+// setDatabaseFlag sets the database flag field, whose yaml tag is 'key'.
+// It returns false if the database does not have the flag.
+func setDatabaseFlag(gcfg interface{}, databaseID, key string, value int64) bool {
+	gv := reflect.ValueOf(gcfg).Elem()
+	gt := gv.Type()
+	for i := 0; i < gt.NumField(); i++ {
+		if gt.Field(i).Tag.Get("yaml") != databaseID {
+			continue
+		}
+		fv := gv.Field(i)
+		if fv.Kind() != reflect.Ptr || fv.IsNil() {
+			return false
+		}
+		fv = fv.Elem()
+		for j := 0; j < fv.NumField(); j++ {
+			if fv.Type().Field(j).Tag.Get("yaml") != key {
+				continue
+			}
+			switch fv.Field(j).Kind() {
+			case reflect.Int64:
+				fv.Field(j).SetInt(value)
+				return true
+			case reflect.Uint64:
+				fv.Field(j).SetUint(uint64(value))
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// insertDir inserts the directory before the last element of slash-separated path.
+// It does not clean the path, so that it works with URLs.
+func insertDir(p, dir string) string {
+	if p == "" {
+		return p
+	}
+	idx := strings.LastIndex(p, "/")
+	return p[:idx+1] + dir + "/" + p[idx+1:]
+}
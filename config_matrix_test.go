@@ -0,0 +1,116 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestConfigMatrix(t *testing.T) {
+	m := ConfigMatrix{
+		ValueSizeBytes: []int64{256, 1024},
+		ClientNumbers:  []int64{100, 1000},
+		DatabaseFlags:  map[string][]int64{"snap_count": {10000}},
+	}
+	var names []string
+	for _, comb := range m.Combinations() {
+		names = append(names, comb.Name())
+	}
+	expected := []string{
+		"value-size-bytes-256_client-number-100_snap-count-10000",
+		"value-size-bytes-256_client-number-1000_snap-count-10000",
+		"value-size-bytes-1024_client-number-100_snap-count-10000",
+		"value-size-bytes-1024_client-number-1000_snap-count-10000",
+	}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected %q, got %q", expected, names)
+	}
+
+	bts, err := ioutil.ReadFile("config_dbtester_test.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	comb := m.Combinations()[3]
+	cfg, err := readConfig(bts, false, comb, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.MatrixCombinationName != expected[3] {
+		t.Fatalf("expected %q, got %q", expected[3], cfg.MatrixCombinationName)
+	}
+	if cfg.ConfigClientMachineInitial.PathPrefix != "/home/gyuho/"+expected[3] {
+		t.Fatalf("unexpected path prefix %q", cfg.ConfigClientMachineInitial.PathPrefix)
+	}
+	zk := cfg.DatabaseIDToConfigClientMachineAgentControl["zookeeper__r3_5_2_alpha"]
+	if zk.ConfigClientMachineBenchmarkOptions.ValueSizeBytes != 1024 || zk.ConfigClientMachineBenchmarkOptions.ClientNumber != 1000 {
+		t.Fatalf("unexpected benchmark options %+v", zk.ConfigClientMachineBenchmarkOptions)
+	}
+	if zk.Flag_Zookeeper_R3_5_2Alpha.SnapCount != 10000 {
+		t.Fatalf("expected snap_count 10000, got %d", zk.Flag_Zookeeper_R3_5_2Alpha.SnapCount)
+	}
+	amc := cfg.DatabaseIDToConfigAnalyzeMachineInitial["zookeeper__r3_5_2_alpha"]
+	if amc.PathPrefix != "2017Q1-01-etcd-zookeeper-consul/01-write-1M-keys-client-variable/"+expected[3]+"/zookeeper-r3.5.2-alpha-java8" {
+		t.Fatalf("unexpected analyze path prefix %q", amc.PathPrefix)
+	}
+}
+
+func TestConfigTrial(t *testing.T) {
+	bts, err := ioutil.ReadFile("config_dbtester_test.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	comb := MatrixCombination{{key: "client_number", value: 100}}
+	cfg, err := readConfig(bts, false, comb, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.RunName() != "client-number-100/trial-2" {
+		t.Fatalf("unexpected run name %q", cfg.RunName())
+	}
+	if cfg.ConfigClientMachineInitial.PathPrefix != "/home/gyuho/client-number-100/trial-2" {
+		t.Fatalf("unexpected path prefix %q", cfg.ConfigClientMachineInitial.PathPrefix)
+	}
+	fpath := cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV
+	if TrimRunDir(fpath, cfg.TrialName()) != "2017Q1-01-etcd-zookeeper-consul/01-write-1M-keys-client-variable/client-number-100/all-aggregated.csv" {
+		t.Fatalf("unexpected trimmed path %q", TrimRunDir(fpath, cfg.TrialName()))
+	}
+	if TrimRunDir(fpath, cfg.RunName()) != "2017Q1-01-etcd-zookeeper-consul/01-write-1M-keys-client-variable/all-aggregated.csv" {
+		t.Fatalf("unexpected trimmed path %q", TrimRunDir(fpath, cfg.RunName()))
+	}
+}
+
+func TestConfigMatrixConnectionNumber(t *testing.T) {
+	bts, err := ioutil.ReadFile("config_dbtester_test.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	comb := MatrixCombination{{key: "client_number", value: 1000}, {key: "connection_number", value: 100}}
+	cfg, err := readConfig(bts, false, comb, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for databaseID, exp := range map[string][2]int64{
+		"etcd__tip":               {100, 1000},
+		"zookeeper__r3_5_2_alpha": {1000, 1000},
+		"consul__v0_7_5":          {1000, 1000},
+	} {
+		opts := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].ConfigClientMachineBenchmarkOptions
+		if opts.ConnectionNumber != exp[0] || opts.ClientNumber != exp[1] {
+			t.Fatalf("%q: expected connections %d, clients %d, got %d, %d", databaseID, exp[0], exp[1], opts.ConnectionNumber, opts.ClientNumber)
+		}
+	}
+}
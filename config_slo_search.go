@@ -0,0 +1,287 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/errclass"
+	"github.com/gyuho/dataframe"
+	"golang.org/x/net/context"
+)
+
+// sloSearchMinThroughputRatio is the minimum ratio of the achieved throughput
+// to the rate limit. Below this ratio, the database cannot sustain the rate,
+// even if the latencies meet the SLO.
+const sloSearchMinThroughputRatio = 0.9
+
+// ConfigSLOSearch defines the latency SLO to search the maximum
+// sustainable throughput of each database. If 'p99_latency_ms' is set,
+// 'control' binary-searches 'rate_limit_requests_per_second' with short
+// trials, instead of running the benchmark once.
+type ConfigSLOSearch struct {
+	// P99LatencyMs is the maximum p99 latency in milliseconds.
+	P99LatencyMs float64 `yaml:"p99_latency_ms"`
+	// MaxErrorRate is the maximum ratio of failed requests (e.g. 0.001 for 0.1%).
+	MaxErrorRate float64 `yaml:"max_error_rate"`
+
+	MinRateLimitRequestsPerSecond int64 `yaml:"min_rate_limit_requests_per_second"`
+	MaxRateLimitRequestsPerSecond int64 `yaml:"max_rate_limit_requests_per_second"`
+	// PrecisionRequestsPerSecond stops the search when the range
+	// of rate limits gets narrower than this.
+	PrecisionRequestsPerSecond int64 `yaml:"precision_requests_per_second"`
+	// MaxTrials is the maximum number of trials per database.
+	MaxTrials int64 `yaml:"max_trials"`
+	// TrialSeconds is the duration of each trial, which decides
+	// the number of requests with the rate limit.
+	TrialSeconds int64 `yaml:"trial_seconds"`
+
+	// ResultPath is the file name to save the rate limit and latency curve.
+	ResultPath string `yaml:"result_path"`
+}
+
+// Enabled returns true if the configuration searches the maximum throughput.
+func (sc ConfigSLOSearch) Enabled() bool {
+	return sc.P99LatencyMs > 0
+}
+
+func (sc *ConfigSLOSearch) validate() error {
+	if !sc.Enabled() {
+		return nil
+	}
+	if sc.MinRateLimitRequestsPerSecond <= 0 || sc.MaxRateLimitRequestsPerSecond < sc.MinRateLimitRequestsPerSecond {
+		return fmt.Errorf("invalid SLO search rate limit range [%d, %d]", sc.MinRateLimitRequestsPerSecond, sc.MaxRateLimitRequestsPerSecond)
+	}
+	if sc.PrecisionRequestsPerSecond == 0 {
+		sc.PrecisionRequestsPerSecond = (sc.MaxRateLimitRequestsPerSecond - sc.MinRateLimitRequestsPerSecond) / 100
+		if sc.PrecisionRequestsPerSecond == 0 {
+			sc.PrecisionRequestsPerSecond = 1
+		}
+	}
+	if sc.MaxTrials == 0 {
+		sc.MaxTrials = 10
+	}
+	if sc.TrialSeconds == 0 {
+		sc.TrialSeconds = 30
+	}
+	if sc.ResultPath == "" {
+		sc.ResultPath = "client-slo-search.csv"
+	}
+	return nil
+}
+
+// ClientSLOSearchPath returns the path to save the SLO search results in client machine.
+func (cfg *Config) ClientSLOSearchPath() string {
+	return filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigSLOSearch.ResultPath)
+}
+
+// AnalyzeSLOSearchPath returns the path of the database's SLO search results to analyze.
+func (cfg *Config) AnalyzeSLOSearchPath(databaseID string) string {
+	return cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].PathPrefix + "-" + cfg.ConfigSLOSearch.ResultPath
+}
+
+// SLOSearchColumns defines the columns of SLO search results.
+var SLOSearchColumns = []string{
+	"RATE-LIMIT-REQUESTS-PER-SECOND",
+	"REQUESTS-PER-SECOND",
+	"AVERAGE-LATENCY-MS",
+	"P99-LATENCY-MS",
+	"ERROR-RATE",
+	"MEETS-SLO",
+}
+
+type sloSearchTrial struct {
+	rateLimit  int64
+	throughput float64
+	avgLatency float64
+	p99Latency float64
+	errRate    float64
+	meetsSLO   bool
+}
+
+func newSLOSearchTrial(rateLimit int64, st reportStats) sloSearchTrial {
+	tr := sloSearchTrial{
+		rateLimit:  rateLimit,
+		throughput: st.RPS,
+		avgLatency: 1000 * st.Average,
+	}
+	if st.Histogram != nil {
+		tr.p99Latency = toMillisecond(time.Duration(st.Histogram.ValueAtPercentile(99)))
+	}
+	errN := 0
+	for _, v := range st.ErrorDist {
+		errN += v
+	}
+	if total := int64(errN) + st.count(); total > 0 {
+		tr.errRate = float64(errN) / float64(total)
+	}
+	return tr
+}
+
+func (sc ConfigSLOSearch) meets(tr sloSearchTrial) bool {
+	return tr.p99Latency <= sc.P99LatencyMs &&
+		tr.errRate <= sc.MaxErrorRate &&
+		tr.throughput >= sloSearchMinThroughputRatio*float64(tr.rateLimit)
+}
+
+// SearchSLO searches the maximum throughput of the running database that
+// meets the latency SLO, by binary search on the rate limit. It saves the
+// rate limit and latency of all trials to 'ClientSLOSearchPath'. If the
+// context is canceled, it saves the trials finished so far.
+func (cfg *Config) SearchSLO(ctx context.Context, databaseID string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	if len(gcfg.ConfigClientMachineBenchmarkOptions.LoadGeneratorEndpoints) > 0 {
+		return fmt.Errorf("%q got SLO search with load generator endpoints", databaseID)
+	}
+	sc := cfg.ConfigSLOSearch
+
+	vals, err := newValues(gcfg)
+	if err != nil {
+		return err
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions.Type != "write" {
+		// reads need the key to exist
+		wcfg := gcfg
+		wopts := *gcfg.ConfigClientMachineBenchmarkOptions
+		wcfg.ConfigClientMachineBenchmarkOptions = &wopts
+		wcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber = 1
+		wcfg.ConfigClientMachineBenchmarkOptions.ClientNumber = 1
+		wcfg.ConfigClientMachineBenchmarkOptions.RequestNumber = 1
+		wcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond = 0
+		wcfg.ConfigClientMachineBenchmarkOptions.SameKey = true
+		h, done, err := newWriteHandlers(wcfg)
+		if err != nil {
+			return err
+		}
+		b := newBenchmark(1, 1, sampleInterval(wcfg), errclass.RulesFor(wcfg.DatabaseID), h, done, func(ctx context.Context, inflightReqs chan<- request) {
+			generateWrites(ctx, wcfg, 0, vals, inflightReqs)
+		})
+		b.startRequests(ctx)
+		b.waitAll()
+		if len(b.stats.ErrorDist) > 0 {
+			return fmt.Errorf("failed to write key for SLO search (%v)", b.stats.ErrorDist)
+		}
+	}
+
+	var (
+		trials    []sloSearchTrial
+		keyOffset int64
+	)
+	runTrial := func(rateLimit int64) (sloSearchTrial, error) {
+		copied := gcfg
+		opts := *gcfg.ConfigClientMachineBenchmarkOptions
+		copied.ConfigClientMachineBenchmarkOptions = &opts
+		copied.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond = rateLimit
+		copied.ConfigClientMachineBenchmarkOptions.RequestNumber = rateLimit * sc.TrialSeconds
+		h, done, reqGen, err := newRequestHandlers(copied, keyOffset, vals)
+		if err != nil {
+			return sloSearchTrial{}, err
+		}
+		keyOffset += copied.ConfigClientMachineBenchmarkOptions.RequestNumber
+
+		plog.Infof("SLO search trial #%d [database: %q | rate limit: %d | requests: %d]", len(trials)+1, databaseID, rateLimit, copied.ConfigClientMachineBenchmarkOptions.RequestNumber)
+		b := newBenchmark(copied.ConfigClientMachineBenchmarkOptions.RequestNumber, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, sampleInterval(copied), errclass.RulesFor(copied.DatabaseID), h, done, reqGen)
+		b.profiler = cfg.profiler
+		b.metrics = cfg.metrics
+		b.dashboard = cfg.dashboard
+		b.startRequests(ctx)
+		b.waitAll()
+		printStats(b.stats)
+		if ctx.Err() != nil {
+			return sloSearchTrial{}, ctx.Err()
+		}
+
+		tr := newSLOSearchTrial(rateLimit, b.stats)
+		tr.meetsSLO = sc.meets(tr)
+		plog.Infof("SLO search trial #%d done [rate limit: %d | throughput: %.2f | p99: %.4f ms | error rate: %.4f | meets SLO: %v]",
+			len(trials)+1, rateLimit, tr.throughput, tr.p99Latency, tr.errRate, tr.meetsSLO)
+		trials = append(trials, tr)
+		return tr, nil
+	}
+
+	search := func() (best int64, err error) {
+		lo, hi := sc.MinRateLimitRequestsPerSecond, sc.MaxRateLimitRequestsPerSecond
+		tr, err := runTrial(lo)
+		if err != nil || !tr.meetsSLO {
+			return 0, err
+		}
+		best = lo
+		if tr, err = runTrial(hi); err != nil {
+			return best, err
+		}
+		if tr.meetsSLO {
+			return hi, nil
+		}
+		for hi-lo > sc.PrecisionRequestsPerSecond && int64(len(trials)) < sc.MaxTrials {
+			mid := lo + (hi-lo)/2
+			if tr, err = runTrial(mid); err != nil {
+				return best, err
+			}
+			if tr.meetsSLO {
+				best, lo = mid, mid
+			} else {
+				hi = mid
+			}
+		}
+		return best, nil
+	}
+
+	best, err := search()
+	switch {
+	case ctx.Err() != nil:
+		plog.Warningf("SLO search of %q is interrupted after %d trials", databaseID, len(trials))
+	case err != nil:
+		return err
+	case best == 0:
+		plog.Warningf("%q does not meet SLO even with minimum rate limit %d", databaseID, sc.MinRateLimitRequestsPerSecond)
+	default:
+		plog.Infof("%q maximum sustainable throughput under SLO is %d requests/sec", databaseID, best)
+	}
+
+	return cfg.saveSLOSearch(trials)
+}
+
+func (cfg *Config) saveSLOSearch(trials []sloSearchTrial) error {
+	sort.Slice(trials, func(i, j int) bool { return trials[i].rateLimit < trials[j].rateLimit })
+
+	cols := make([]dataframe.Column, len(SLOSearchColumns))
+	for i := range SLOSearchColumns {
+		cols[i] = dataframe.NewColumn(SLOSearchColumns[i])
+	}
+	for _, tr := range trials {
+		cols[0].PushBack(dataframe.NewStringValue(tr.rateLimit))
+		cols[1].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", tr.throughput)))
+		cols[2].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", tr.avgLatency)))
+		cols[3].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", tr.p99Latency)))
+		cols[4].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.6f", tr.errRate)))
+		cols[5].PushBack(dataframe.NewStringValue(fmt.Sprintf("%v", tr.meetsSLO)))
+	}
+
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	fpath := cfg.ClientSLOSearchPath()
+	plog.Infof("saving SLO search results to %q", fpath)
+	return fr.CSV(fpath)
+}
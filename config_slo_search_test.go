@@ -0,0 +1,113 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"golang.org/x/net/context"
+)
+
+func TestConfigSLOSearch(t *testing.T) {
+	sc := ConfigSLOSearch{
+		P99LatencyMs:                  50,
+		MaxErrorRate:                  0.01,
+		MinRateLimitRequestsPerSecond: 100,
+		MaxRateLimitRequestsPerSecond: 10100,
+	}
+	if err := sc.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if sc.PrecisionRequestsPerSecond != 100 || sc.TrialSeconds != 30 || sc.ResultPath != "client-slo-search.csv" {
+		t.Fatalf("unexpected defaults %+v", sc)
+	}
+
+	h := newLatencyHistogram()
+	h.RecordN(int64(10*time.Millisecond), 98)
+	h.RecordN(int64(100*time.Millisecond), 2)
+	st := reportStats{Histogram: h, RPS: 1000, Total: time.Second, ErrorDist: map[string]int{"timeout": 1}}
+
+	tr := newSLOSearchTrial(1000, st)
+	if tr.p99Latency != 100 {
+		t.Fatalf("expected p99 100 ms, got %f", tr.p99Latency)
+	}
+	if sc.meets(tr) {
+		t.Fatalf("expected %+v to not meet SLO", tr)
+	}
+
+	st.Histogram = newLatencyHistogram()
+	st.Histogram.RecordN(int64(10*time.Millisecond), 100)
+	if tr = newSLOSearchTrial(1000, st); !sc.meets(tr) {
+		t.Fatalf("expected %+v to meet SLO", tr)
+	}
+	if tr = newSLOSearchTrial(2000, st); sc.meets(tr) {
+		t.Fatalf("expected %+v to not meet SLO with throughput lower than rate limit", tr)
+	}
+}
+
+func TestConfigSearchSLOKeepsOptions(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "dbtester-slo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	opts := &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+		Type:             "read",
+		RequestNumber:    1000,
+		ConnectionNumber: 10,
+		ClientNumber:     100,
+		KeySizeBytes:     8,
+		ValueSizeBytes:   256,
+	}
+	expected := *opts
+	cfg := &Config{
+		ConfigClientMachineInitial: dbtesterpb.ConfigClientMachineInitial{PathPrefix: dir},
+		ConfigSLOSearch: ConfigSLOSearch{
+			P99LatencyMs:                  50,
+			MinRateLimitRequestsPerSecond: 100,
+			MaxRateLimitRequestsPerSecond: 10100,
+		},
+		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+			"cetcd__beta": {
+				DatabaseID:                          "cetcd__beta",
+				DatabaseEndpoints:                   []string{"127.0.0.1:8500"},
+				ConfigClientMachineBenchmarkOptions: opts,
+			},
+		},
+	}
+	if err = cfg.ConfigSLOSearch.validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	// interrupted before the first trial sends any request
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err = cfg.SearchSLO(ctx, "cetcd__beta"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(filepath.Join(dir, cfg.ConfigSLOSearch.ResultPath)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*opts, expected) {
+		t.Fatalf("expected options %+v unchanged, got %+v", expected, *opts)
+	}
+}
@@ -17,11 +17,16 @@ package control
 
 import (
 	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/analyze"
 	"github.com/coreos/dbtester/dbtesterpb"
 	"github.com/coreos/dbtester/pkg/ntp"
 
@@ -30,6 +35,7 @@ import (
 	"github.com/gyuho/linux-inspect/inspect"
 	"github.com/gyuho/linux-inspect/top"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
 )
 
 // Command implements 'control' command.
@@ -44,6 +50,18 @@ var configPath string
 var diskDevice string
 var networkInterface string
 
+var allDatabaseIDs bool
+var coolDown time.Duration
+var runAnalyze bool
+var statusSummaryPath string
+var preflightOnly bool
+
+var metricsAddr string
+var metricsPushURL string
+var metricsPushInterval time.Duration
+
+var showDashboard bool
+
 func init() {
 	dn, err := df.GetDevice("/")
 	if err != nil {
@@ -64,17 +82,189 @@ func init() {
 	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
 	Command.PersistentFlags().StringVar(&diskDevice, "disk-device", dn, "Disk device to collect disk statistics metrics from.")
 	Command.PersistentFlags().StringVar(&networkInterface, "network-interface", nt, "Network interface to record in/outgoing packets.")
+
+	Command.Flags().BoolVar(&allDatabaseIDs, "all-database-ids", false, "'true' to run all databases in 'all_database_id_list' sequentially (overrides '--database-id').")
+	Command.Flags().DurationVar(&coolDown, "cool-down", time.Minute, "Duration to wait between databases, with '--all-database-ids'.")
+	Command.Flags().BoolVar(&runAnalyze, "analyze", false, "'true' to download the uploaded results to the analyze paths and run 'analyze' with the same configuration, after all databases are finished (requires 'step4_upload_logs').")
+	Command.Flags().StringVar(&statusSummaryPath, "status-summary-path", "control-status-summary.csv", "File path to save the status of each database, with '--all-database-ids'.")
+	Command.Flags().BoolVar(&preflightOnly, "preflight", false, "'true' to validate the configuration and check the agent machines, without starting anything.")
+	Command.Flags().StringVar(&metricsAddr, "metrics-addr", "", "Address to serve live benchmark metrics at '/metrics' in Prometheus format (e.g. ':9090'), empty to disable.")
+	Command.Flags().StringVar(&metricsPushURL, "metrics-push-url", "", "Prometheus push gateway URL to push live benchmark metrics to (e.g. 'http://localhost:9091'), empty to disable.")
+	Command.Flags().DurationVar(&metricsPushInterval, "metrics-push-interval", 10*time.Second, "Interval to push metrics, with '--metrics-push-url'.")
+	Command.Flags().BoolVar(&showDashboard, "dashboard", false, "'true' to draw live throughput, latencies, errors and agent health in the terminal, in place of the progress bar.")
 }
 
 func commandFunc(cmd *cobra.Command, args []string) error {
-	if !dbtesterpb.IsValidDatabaseID(databaseID) {
+	if !allDatabaseIDs && !dbtesterpb.IsValidDatabaseID(databaseID) {
 		return fmt.Errorf("database id %q is unknown", databaseID)
 	}
 
-	cfg, err := dbtester.ReadConfig(configPath, false)
+	cfgs, err := dbtester.ReadConfigMatrix(configPath, false)
 	if err != nil {
 		return err
 	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if preflightOnly {
+		return preflight(ctx, cfgs)
+	}
+	if runAnalyze {
+		// fail before the run, rather than after hours of benchmarks
+		for _, cfg := range cfgs {
+			for _, id := range cfg.AllDatabaseIDList {
+				if err = cfg.CheckAnalyzeInputs(id); err != nil {
+					return fmt.Errorf("cannot analyze %q (%v)", cfg.RunName(), err)
+				}
+			}
+		}
+	}
+	if metricsAddr != "" || metricsPushURL != "" || showDashboard {
+		// metrics and dashboard only see the requests that 'control' sends
+		for _, cfg := range cfgs {
+			for id, gcfg := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+				if len(gcfg.ConfigClientMachineBenchmarkOptions.LoadGeneratorEndpoints) > 0 && (allDatabaseIDs || id == databaseID) {
+					return fmt.Errorf("%q got load generator endpoints, which cannot be used with metrics or dashboard", id)
+				}
+			}
+		}
+	}
+	go notifyInterrupt(ctx, cancel)
+
+	if metricsAddr != "" || metricsPushURL != "" {
+		metrics = dbtester.NewMetrics()
+		stop := serveMetrics(metrics)
+		defer stop()
+	}
+
+	var failed []string
+	for i, cfg := range cfgs {
+		if name := cfg.RunName(); name != "" {
+			if i > 0 {
+				println()
+				plog.Infof("cooling down %v before %q", coolDown, name)
+				sleepContext(ctx, coolDown)
+				println()
+			}
+			if ctx.Err() != nil {
+				break
+			}
+			plog.Infof("running %q (%d out of %d)", name, i+1, len(cfgs))
+			if err = os.MkdirAll(cfg.ConfigClientMachineInitial.PathPrefix, 0777); err != nil {
+				return err
+			}
+		}
+
+		if allDatabaseIDs {
+			err = runAll(ctx, cfg)
+		} else {
+			err = run(ctx, cfg, databaseID)
+		}
+		if err != nil {
+			if len(cfgs) == 1 {
+				return err
+			}
+			plog.Warningf("%q failed (%v)", cfg.RunName(), err)
+			failed = append(failed, cfg.RunName())
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("interrupted; partial results are saved (%v)", ctx.Err())
+		}
+	}
+
+	if runAnalyze {
+		println()
+		for _, cfg := range cfgs {
+			for _, id := range cfg.AllDatabaseIDList {
+				plog.Infof("downloading results of %q to analyze", id)
+				if err = cfg.DownloadFromGoogle(id); err != nil {
+					return err
+				}
+			}
+		}
+		plog.Infof("analyzing with %q", configPath)
+		if err = analyze.Do(configPath); err != nil {
+			return err
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d out of %d runs failed (%s)", len(failed), len(cfgs), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// notifyInterrupt cancels the context on SIGINT or SIGTERM, so that 'control'
+// stops generating load, stops the databases and saves partial results.
+// It exits immediately on the second signal.
+func notifyInterrupt(ctx context.Context, cancel context.CancelFunc) {
+	notifier := make(chan os.Signal, 2)
+	signal.Notify(notifier, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(notifier)
+
+	select {
+	case sig := <-notifier:
+		plog.Warningf("received %q; stopping databases and saving partial results (send again to exit immediately)", sig)
+		cancel()
+	case <-ctx.Done():
+		return
+	}
+	sig := <-notifier
+	plog.Fatalf("received %q again; exiting without cleanup", sig)
+}
+
+// metrics records live request metrics of all runs, if enabled.
+var metrics *dbtester.Metrics
+
+// serveMetrics serves the metrics at '--metrics-addr', and pushes them
+// to '--metrics-push-url'. The returned function stops both, pushing the
+// last values before it returns.
+func serveMetrics(m *dbtester.Metrics) (stop func()) {
+	var srv *http.Server
+	if metricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", m)
+		srv = &http.Server{Addr: metricsAddr, Handler: mux}
+		go func() {
+			plog.Infof("serving metrics at %q", metricsAddr+"/metrics")
+			if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				plog.Warningf("failed to serve metrics (%v)", err)
+			}
+		}()
+	}
+
+	pctx, pcancel := context.WithCancel(context.Background())
+	pdonec := make(chan struct{})
+	go func() {
+		defer close(pdonec)
+		if metricsPushURL != "" {
+			plog.Infof("pushing metrics to %q every %v", metricsPushURL, metricsPushInterval)
+			m.PushEvery(pctx, metricsPushURL, metricsPushInterval)
+		}
+	}()
+
+	return func() {
+		pcancel()
+		<-pdonec
+		if srv != nil {
+			srv.Close()
+		}
+	}
+}
+
+// sleepContext sleeps for the duration, or until the context is canceled.
+func sleepContext(ctx context.Context, d time.Duration) {
+	select {
+	case <-time.After(d):
+	case <-ctx.Done():
+	}
+}
+
+// run starts, stresses and stops the database of 'databaseID'.
+// If the context is canceled, it stops the database and saves
+// partial results, and returns an error. If starting or stressing
+// fails, it stops the database and returns the error.
+func run(ctx context.Context, cfg *dbtester.Config, databaseID string) error {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
 		return fmt.Errorf("%q is not found", databaseID)
@@ -90,6 +280,7 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	var err error
 	pid := int64(os.Getpid())
 	plog.Infof("starting collecting system metrics at %q [disk device: %q | network interface: %q | PID: %d]", cfg.ConfigClientMachineInitial.ClientSystemMetricsPath, diskDevice, networkInterface, pid)
 	if err = os.RemoveAll(cfg.ConfigClientMachineInitial.ClientSystemMetricsPath); err != nil {
@@ -114,6 +305,11 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	}
 
 	donec, sysdonec := make(chan struct{}), make(chan struct{})
+	var stopMetrics sync.Once
+	defer stopMetrics.Do(func() {
+		close(donec)
+		<-sysdonec
+	})
 	go func() {
 		for {
 			select {
@@ -154,32 +350,74 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	plog.Infof("npt update output: %q", no)
 	plog.Infof("npt update error: %v", nerr)
 
+	// failed is the error of step 1 or 2, returned after step 3
+	// stops the databases, so that failed runs do not leave them running
+	var failed error
+
 	println()
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase {
 		plog.Info("step 1: starting databases...")
-		if _, err = cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Start); err != nil {
-			return err
+		var idxToResp map[int]dbtesterpb.Response
+		idxToResp, err = cfg.BroadcaseRequest(ctx, databaseID, dbtesterpb.Operation_Start)
+		if err == nil {
+			err = cfg.SaveBinarySummary(databaseID, idxToResp)
+		}
+		if err != nil && ctx.Err() == nil {
+			failed = err
 		}
 	}
 
-	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase {
+	prof := cfg.NewProfiler(databaseID)
+	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase && ctx.Err() == nil && failed == nil {
 		println()
-		time.Sleep(5 * time.Second)
+		sleepContext(ctx, 5*time.Second)
 		println()
-		plog.Info("step 2: starting tests...")
-		if err = cfg.Stress(databaseID); err != nil {
-			return err
+		if prof != nil {
+			plog.Info("step 2: starting profiler...")
+			prof.Start(ctx)
+		}
+		if metrics != nil {
+			metrics.Start(cfg, databaseID)
+		}
+		var dash *dbtester.Dashboard
+		if showDashboard {
+			dash = cfg.NewDashboard(databaseID, os.Stdout)
+			dash.Start(ctx)
+		}
+		if cfg.ConfigSLOSearch.Enabled() {
+			plog.Info("step 2: starting SLO search...")
+			err = cfg.SearchSLO(ctx, databaseID)
+		} else {
+			plog.Info("step 2: starting tests...")
+			err = cfg.Stress(ctx, databaseID)
+		}
+		if prof != nil {
+			prof.Stop()
+		}
+		if metrics != nil {
+			metrics.Stop(cfg)
+		}
+		if dash != nil {
+			dash.Stop()
+		}
+		if err != nil && ctx.Err() == nil {
+			failed = err
 		}
 	}
 
-	if gcfg.ConfigClientMachineBenchmarkSteps.Step3StopDatabase {
+	// stop the databases even if the steps do not,
+	// so that interrupted or failed runs do not leave them running
+	interrupted := ctx.Err() != nil
+	if gcfg.ConfigClientMachineBenchmarkSteps.Step3StopDatabase || ((interrupted || failed != nil) && gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase) {
 		println()
-		time.Sleep(5 * time.Second)
+		if !interrupted && failed == nil {
+			time.Sleep(5 * time.Second)
+		}
 		println()
 		plog.Info("step 3: stopping tests...")
 		var idxToResp map[int]dbtesterpb.Response
 		for i := 0; i < 5; i++ {
-			idxToResp, err = cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Stop)
+			idxToResp, err = cfg.BroadcaseRequest(context.Background(), databaseID, dbtesterpb.Operation_Stop)
 			if err != nil {
 				plog.Warningf("#%d: STOP failed at %v", i, err)
 				time.Sleep(300 * time.Millisecond)
@@ -194,14 +432,29 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		println()
 		time.Sleep(time.Second)
 		println()
-		plog.Info("step 3: saving responses...")
-		if err = cfg.SaveDiskSpaceUsageSummary(databaseID, idxToResp); err != nil {
-			return err
+		if failed == nil {
+			plog.Info("step 3: saving responses...")
+			if err = cfg.SaveDiskSpaceUsageSummary(databaseID, idxToResp); err != nil {
+				return err
+			}
+			if err = cfg.SaveServerResults(databaseID, idxToResp); err != nil {
+				return err
+			}
 		}
 	}
 
-	close(donec)
-	<-sysdonec
+	stopMetrics.Do(func() {
+		close(donec)
+		<-sysdonec
+	})
+
+	if failed != nil {
+		return failed
+	}
+
+	if interrupted {
+		return fmt.Errorf("%q is interrupted; partial results are saved", databaseID)
+	}
 
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs {
 		println()
@@ -217,6 +470,25 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientSystemMetricsInterpolatedPath); err != nil {
 			return err
 		}
+		if gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ClientBinarySummaryPath()); err != nil {
+				return err
+			}
+		}
+		if prof != nil {
+			for _, fpath := range prof.Paths() {
+				if err = cfg.UploadToGoogle(databaseID, fpath); err != nil {
+					return err
+				}
+			}
+		}
+		if cfg.ConfigSLOSearch.Enabled() {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ClientSLOSearchPath()); err != nil {
+				return err
+			}
+			plog.Info("all done!")
+			return nil
+		}
 		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath); err != nil {
 			return err
 		}
@@ -235,6 +507,18 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath); err != nil {
 			return err
 		}
+		if err = cfg.UploadToGoogle(databaseID, cfg.ClientResultsPath()); err != nil {
+			return err
+		}
+		for _, fpath := range []string{cfg.ClientEndpointSummaryPath(), cfg.ClientEndpointTimeseriesPath()} {
+			if _, err = os.Stat(fpath); err != nil {
+				// no endpoint is tagged (e.g. results of load generators of older versions)
+				continue
+			}
+			if err = cfg.UploadToGoogle(databaseID, fpath); err != nil {
+				return err
+			}
+		}
 	}
 
 	plog.Info("all done!")
@@ -0,0 +1,66 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"math"
+	"net"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/ntp"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// loadGeneratorCommand implements 'control load-generator' command.
+var loadGeneratorCommand = &cobra.Command{
+	Use:   "load-generator",
+	Short: "Generates load on the requests of 'control' in other client machines.",
+	RunE:  loadGeneratorCommandFunc,
+}
+
+var loadGeneratorPort string
+
+func init() {
+	loadGeneratorCommand.Flags().StringVar(&loadGeneratorPort, "load-generator-port", ":3600", "Port to serve load generator gRPC server.")
+	Command.AddCommand(loadGeneratorCommand)
+}
+
+func loadGeneratorCommandFunc(cmd *cobra.Command, args []string) error {
+	cfgs, err := dbtester.ReadConfigMatrix(configPath, false)
+	if err != nil {
+		return err
+	}
+
+	// requests from load generators are aligned by unix second
+	no, nerr := ntp.DefaultSync()
+	plog.Infof("npt update output: %q", no)
+	plog.Infof("npt update error: %v", nerr)
+
+	var (
+		grpcServer = grpc.NewServer(grpc.MaxSendMsgSize(math.MaxInt32))
+		sender     = dbtester.NewLoadGeneratorServer(cfgs)
+	)
+	ln, err := net.Listen("tcp", loadGeneratorPort)
+	if err != nil {
+		return err
+	}
+	dbtesterpb.RegisterLoadGeneratorServer(grpcServer, sender)
+
+	plog.Infof("load generator started with gRPC %s", loadGeneratorPort)
+	return grpcServer.Serve(ln)
+}
@@ -0,0 +1,223 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/syscheck"
+
+	"github.com/olekukonko/tablewriter"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// preflightMaxClockOffset is the maximum clock offset between
+// client and agent machines. Latencies and system metrics are
+// aligned by unix second.
+const preflightMaxClockOffset = 500 * time.Millisecond
+
+var benchmarkTypes = map[string]bool{
+	"write":        true,
+	"read":         true,
+	"read-oneshot": true,
+}
+
+type preflightResult struct {
+	databaseID string
+	endpoint   string
+	check      dbtesterpb.PreflightCheck
+}
+
+// preflight validates the configurations, and checks that the client
+// and agent machines are ready to run the databases. It prints the
+// results, and returns an error if any check fails. It does not start
+// anything.
+func preflight(ctx context.Context, cfgs []*dbtester.Config) error {
+	cfg := cfgs[0]
+	databaseIDs := []string{databaseID}
+	if allDatabaseIDs {
+		databaseIDs = cfg.AllDatabaseIDList
+	}
+
+	var results []preflightResult
+	add := func(databaseID, endpoint, name string, err error, detail string) {
+		ck := dbtesterpb.PreflightCheck{Name: name, Pass: err == nil, Detail: detail}
+		if err != nil {
+			ck.Detail = err.Error()
+		}
+		results = append(results, preflightResult{databaseID: databaseID, endpoint: endpoint, check: ck})
+	}
+
+	for _, c := range cfgs {
+		for _, id := range databaseIDs {
+			name := "configuration"
+			if c.RunName() != "" {
+				name += " " + c.RunName()
+			}
+			add(id, "-", name, validateConfig(c, id), "valid")
+		}
+	}
+	add("-", "client", "disk device", syscheck.CheckDiskDevice(diskDevice), diskDevice)
+	_, err := net.InterfaceByName(networkInterface)
+	add("-", "client", "network interface", err, networkInterface)
+
+	for _, id := range databaseIDs {
+		gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[id]
+		if !ok {
+			continue
+		}
+
+		// agents check the reachability of each other's database ports,
+		// so they are requested at the same time
+		type agentResult struct {
+			checks []*dbtesterpb.PreflightCheck
+			offset time.Duration
+			err    error
+		}
+		ars := make([]agentResult, len(gcfg.AgentEndpoints))
+		var wg sync.WaitGroup
+		for i, ep := range gcfg.AgentEndpoints {
+			req, err := cfg.ToRequest(id, dbtesterpb.Operation_Heartbeat, i)
+			if err != nil {
+				ars[i].err = err
+				continue
+			}
+			wg.Add(1)
+			go func(i int, ep string, req *dbtesterpb.Request) {
+				defer wg.Done()
+				plog.Infof("sending preflight request [index: %d | database: %q | endpoint: %q]", i, id, ep)
+				ars[i].checks, ars[i].offset, ars[i].err = preflightAgent(ctx, ep, req)
+			}(i, ep, req)
+		}
+		wg.Wait()
+
+		for i, ep := range gcfg.AgentEndpoints {
+			if ars[i].err != nil {
+				add(id, ep, "agent reachable", ars[i].err, "")
+				continue
+			}
+			add(id, ep, "agent reachable", nil, "")
+			for _, ck := range ars[i].checks {
+				results = append(results, preflightResult{databaseID: id, endpoint: ep, check: *ck})
+			}
+			offset := ars[i].offset
+			if offset < 0 {
+				offset = -offset
+			}
+			var err error
+			if offset > preflightMaxClockOffset {
+				err = fmt.Errorf("clock offset %v > %v", offset, preflightMaxClockOffset)
+			}
+			add(id, ep, "clock offset", err, offset.String())
+		}
+
+		for _, ep := range gcfg.ConfigClientMachineBenchmarkOptions.LoadGeneratorEndpoints {
+			add(id, ep, "load generator reachable", preflightLoadGenerator(ctx, ep), "")
+		}
+	}
+
+	failed := 0
+	tw := tablewriter.NewWriter(os.Stdout)
+	tw.SetHeader([]string{"DATABASE-ID", "ENDPOINT", "CHECK", "STATUS", "DETAIL"})
+	for _, rs := range results {
+		status := "PASS"
+		if !rs.check.Pass {
+			status = "FAIL"
+			failed++
+		}
+		tw.Append([]string{rs.databaseID, rs.endpoint, rs.check.Name, status, rs.check.Detail})
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_LEFT)
+	tw.Render()
+
+	if failed > 0 {
+		return fmt.Errorf("%d out of %d preflight checks failed", failed, len(results))
+	}
+	plog.Infof("all %d preflight checks passed", len(results))
+	return nil
+}
+
+// validateConfig checks the configuration of the database
+// beyond what is required to parse it.
+func validateConfig(cfg *dbtester.Config, databaseID string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q is not found", databaseID)
+	}
+	if _, ok = cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]; !ok {
+		return fmt.Errorf("%q has no analyze configuration", databaseID)
+	}
+	if !benchmarkTypes[gcfg.ConfigClientMachineBenchmarkOptions.Type] {
+		return fmt.Errorf("%q got unknown benchmark type %q", databaseID, gcfg.ConfigClientMachineBenchmarkOptions.Type)
+	}
+	if len(gcfg.PeerIPs) == 0 {
+		return fmt.Errorf("%q has no peer IPs", databaseID)
+	}
+	if len(gcfg.AgentEndpoints) != len(gcfg.PeerIPs) {
+		return fmt.Errorf("%q got agent endpoints %d != peer IPs %d", databaseID, len(gcfg.AgentEndpoints), len(gcfg.PeerIPs))
+	}
+	for i := range gcfg.AgentEndpoints {
+		if _, err := cfg.ToRequest(databaseID, dbtesterpb.Operation_Start, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// preflightAgent requests the preflight checks to the agent, and returns
+// the checks and the clock offset of the agent machine.
+func preflightAgent(ctx context.Context, ep string, req *dbtesterpb.Request) ([]*dbtesterpb.PreflightCheck, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, ep, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer conn.Close()
+
+	cli := dbtesterpb.NewTransporterClient(conn)
+	sent := time.Now()
+	resp, err := cli.Preflight(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	recv := time.Now()
+
+	// assume the network delays of request and response are the same;
+	// the time agent takes to check is not counted
+	offset := (time.Unix(0, resp.ReceivedUnixNano).Sub(sent) + time.Unix(0, resp.UnixNano).Sub(recv)) / 2
+	return resp.Checks, offset, nil
+}
+
+// preflightLoadGenerator checks that the load generator accepts connections.
+func preflightLoadGenerator(ctx context.Context, ep string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, ep, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
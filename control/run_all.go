@@ -0,0 +1,178 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"golang.org/x/net/context"
+)
+
+// StatusSummaryColumns defines per-database status summary columns.
+var StatusSummaryColumns = []string{
+	"DATABASE-ID",
+	"DATABASE-TAG",
+	"STATUS",
+	"STARTED-AT",
+	"ELAPSED",
+	"ERROR",
+}
+
+type databaseStatus struct {
+	databaseID  string
+	databaseTag string
+	startedAt   time.Time
+	took        time.Duration
+	err         error
+}
+
+// runAll runs all databases in 'all_database_id_list' sequentially.
+// It continues to the next database, even if one database fails.
+func runAll(ctx context.Context, cfg *dbtester.Config) error {
+	fpath := statusSummaryPath
+	if cfg.RunName() != "" {
+		fpath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, filepath.Base(statusSummaryPath))
+	}
+
+	var statuses []databaseStatus
+	for i, id := range cfg.AllDatabaseIDList {
+		if i > 0 {
+			println()
+			plog.Infof("cooling down %v before %q", coolDown, id)
+			sleepContext(ctx, coolDown)
+			println()
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		plog.Infof("running %q (%d out of %d)", id, i+1, len(cfg.AllDatabaseIDList))
+		st := databaseStatus{
+			databaseID:  id,
+			databaseTag: cfg.DatabaseIDToConfigClientMachineAgentControl[id].DatabaseTag,
+			startedAt:   time.Now(),
+		}
+		st.err = run(ctx, cfg, id)
+		st.took = time.Since(st.startedAt)
+		if st.err != nil && ctx.Err() == nil {
+			plog.Warningf("%q failed (%v)", id, st.err)
+			cleanup(cfg, id)
+		}
+		archive(cfg, id)
+		statuses = append(statuses, st)
+
+		if err := saveStatusSummary(fpath, statuses); err != nil {
+			plog.Warningf("failed to save status summary %q (%v)", fpath, err)
+		}
+	}
+
+	var failed []string
+	for _, st := range statuses {
+		if st.err != nil {
+			failed = append(failed, st.databaseID)
+		}
+	}
+	plog.Infof("status summary saved at %q", fpath)
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d out of %d databases failed (%s)", len(failed), len(statuses), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// cleanup stops the database of a failed run, so that
+// the next database starts with no running database.
+func cleanup(cfg *dbtester.Config, databaseID string) {
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase {
+		return
+	}
+	plog.Infof("cleaning up %q", databaseID)
+	if _, err := cfg.BroadcaseRequest(context.Background(), databaseID, dbtesterpb.Operation_Stop); err != nil {
+		plog.Warningf("STOP failed while cleaning up %q (%v)", databaseID, err)
+	}
+}
+
+// archive renames client-side results with database tag prefix,
+// so that the next database does not overwrite them.
+func archive(cfg *dbtester.Config, databaseID string) {
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	fpaths := []string{
+		cfg.ConfigClientMachineInitial.ClientSystemMetricsPath,
+		cfg.ConfigClientMachineInitial.ClientSystemMetricsInterpolatedPath,
+		cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath,
+		cfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath,
+		cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath,
+		cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath,
+		cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath,
+		cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath,
+		cfg.ClientBinarySummaryPath(),
+		cfg.ClientEndpointSummaryPath(),
+		cfg.ClientEndpointTimeseriesPath(),
+		cfg.ClientResultsPath(),
+	}
+	if cfg.ConfigSLOSearch.Enabled() {
+		fpaths = append(fpaths, cfg.ClientSLOSearchPath())
+	}
+	for _, fpath := range fpaths {
+		if _, err := os.Stat(fpath); err != nil {
+			continue
+		}
+		dst := filepath.Join(filepath.Dir(fpath), fmt.Sprintf("%s-%s", gcfg.DatabaseTag, filepath.Base(fpath)))
+		if err := os.Rename(fpath, dst); err != nil {
+			plog.Warningf("failed to rename %q to %q (%v)", fpath, dst, err)
+			continue
+		}
+		plog.Infof("renamed %q to %q", fpath, dst)
+	}
+}
+
+func saveStatusSummary(fpath string, statuses []databaseStatus) error {
+	c1 := dataframe.NewColumn(StatusSummaryColumns[0])
+	c2 := dataframe.NewColumn(StatusSummaryColumns[1])
+	c3 := dataframe.NewColumn(StatusSummaryColumns[2])
+	c4 := dataframe.NewColumn(StatusSummaryColumns[3])
+	c5 := dataframe.NewColumn(StatusSummaryColumns[4])
+	c6 := dataframe.NewColumn(StatusSummaryColumns[5])
+	for _, st := range statuses {
+		c1.PushBack(dataframe.NewStringValue(st.databaseID))
+		c2.PushBack(dataframe.NewStringValue(st.databaseTag))
+		if st.err != nil {
+			c3.PushBack(dataframe.NewStringValue("FAILED"))
+			c6.PushBack(dataframe.NewStringValue(st.err.Error()))
+		} else {
+			c3.PushBack(dataframe.NewStringValue("OK"))
+			c6.PushBack(dataframe.NewStringValue(""))
+		}
+		c4.PushBack(dataframe.NewStringValue(st.startedAt.Format(time.RFC3339)))
+		c5.PushBack(dataframe.NewStringValue(st.took.String()))
+	}
+
+	fr := dataframe.New()
+	for _, col := range []dataframe.Column{c1, c2, c3, c4, c5, c6} {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(fpath)
+}
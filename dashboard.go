@@ -0,0 +1,314 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/errclass"
+
+	"github.com/dustin/go-humanize"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// dashboardSeconds is the number of seconds in the sparklines.
+const dashboardSeconds = 60
+
+// sparkRunes are the bars of sparklines, from the lowest to the highest.
+var sparkRunes = []rune("▁▂▃▄▅▆▇█")
+
+// Dashboard draws the live throughput, latencies, errors and client number
+// of benchmarks, and the health of agent machines, in place of the progress
+// bar. It redraws the terminal every second.
+type Dashboard struct {
+	cfg        *Config
+	databaseID string
+	w          io.Writer
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	conns  []*grpc.ClientConn
+
+	mu      sync.Mutex
+	started time.Time
+	total   int64
+	done    int64
+	clientN int64
+	step    int64
+
+	// latencies are of the requests finished since the last roll
+	latencies []float64
+	errs      int64
+	classes   map[string]int64
+
+	throughputs []float64
+	p50s        []float64
+	p99s        []float64
+
+	health map[string]agentHealth
+}
+
+// agentHealth is the latest health of an agent machine,
+// or the error of the last status request.
+type agentHealth struct {
+	health dbtesterpb.Health
+	err    error
+}
+
+// NewDashboard returns a new Dashboard of the database, that draws to 'w'.
+func (cfg *Config) NewDashboard(databaseID string, w io.Writer) *Dashboard {
+	return &Dashboard{
+		cfg:        cfg,
+		databaseID: databaseID,
+		w:          w,
+		classes:    make(map[string]int64),
+		health:     make(map[string]agentHealth),
+	}
+}
+
+// Start starts drawing, and replaces the progress bar of benchmarks.
+func (d *Dashboard) Start(ctx context.Context) {
+	d.ctx, d.cancel = context.WithCancel(ctx)
+	d.started = time.Now()
+	for _, ep := range d.cfg.DatabaseIDToConfigClientMachineAgentControl[d.databaseID].AgentEndpoints {
+		conn, err := grpc.Dial(ep, grpc.WithInsecure())
+		if err != nil {
+			d.health[ep] = agentHealth{err: err}
+			d.conns = append(d.conns, nil)
+			continue
+		}
+		d.conns = append(d.conns, conn)
+	}
+	d.cfg.dashboard = d
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-d.ctx.Done():
+				return
+			case now := <-ticker.C:
+				d.roll()
+				d.pollAgents()
+				d.draw(now)
+			}
+		}
+	}()
+}
+
+// Stop stops drawing, and draws once more with the last values.
+func (d *Dashboard) Stop() {
+	d.cfg.dashboard = nil
+	d.cancel()
+	d.wg.Wait()
+	for _, conn := range d.conns {
+		if conn != nil {
+			conn.Close()
+		}
+	}
+	d.draw(time.Now())
+}
+
+// begin records the start of a benchmark of 'totalN' requests with 'clientN'
+// clients, where 'step' is the 1-based index of client number step, zero if fixed.
+func (d *Dashboard) begin(totalN, clientN, step int64) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	d.total += totalN
+	d.clientN, d.step = clientN, step
+	d.mu.Unlock()
+}
+
+// observe records the latency and error class of a finished request,
+// where 'class' is empty on success.
+func (d *Dashboard) observe(start, end time.Time, class string) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.done++
+	d.latencies = append(d.latencies, float64(end.Sub(start))/float64(time.Millisecond))
+	if class != "" {
+		d.errs++
+		d.classes[class]++
+	}
+}
+
+// roll appends the throughput and latencies of the last second to the
+// sparklines, so that seconds without requests are drawn as zero.
+func (d *Dashboard) roll() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sort.Float64s(d.latencies)
+	push := func(vs []float64, v float64) []float64 {
+		vs = append(vs, v)
+		if len(vs) > dashboardSeconds {
+			vs = vs[len(vs)-dashboardSeconds:]
+		}
+		return vs
+	}
+	d.throughputs = push(d.throughputs, float64(len(d.latencies)))
+	d.p50s = push(d.p50s, percentileOf(d.latencies, 50))
+	d.p99s = push(d.p99s, percentileOf(d.latencies, 99))
+	d.latencies = d.latencies[:0]
+}
+
+// percentileOf returns the percentile of sorted values, zero if empty.
+func percentileOf(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// pollAgents requests the health of database process to all agents.
+func (d *Dashboard) pollAgents() {
+	eps := d.cfg.DatabaseIDToConfigClientMachineAgentControl[d.databaseID].AgentEndpoints
+	var wg sync.WaitGroup
+	for i, conn := range d.conns {
+		if conn == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(ep string, conn *grpc.ClientConn) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(d.ctx, 2*time.Second)
+			resp, err := dbtesterpb.NewTransporterClient(conn).Transfer(ctx, &dbtesterpb.Request{Operation: dbtesterpb.Operation_Status})
+			cancel()
+
+			ah := agentHealth{err: err}
+			if err == nil && resp.Health != nil {
+				ah.health = *resp.Health
+			}
+			d.mu.Lock()
+			d.health[ep] = ah
+			d.mu.Unlock()
+		}(eps[i], conn)
+	}
+	wg.Wait()
+}
+
+// draw clears the terminal and draws the dashboard.
+func (d *Dashboard) draw(now time.Time) {
+	var buf bytes.Buffer
+	buf.WriteString("\x1b[H\x1b[2J")
+	d.render(&buf, now)
+	buf.WriteTo(d.w)
+}
+
+// render writes the dashboard as text.
+func (d *Dashboard) render(w io.Writer, now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	gcfg := d.cfg.DatabaseIDToConfigClientMachineAgentControl[d.databaseID]
+	fmt.Fprintf(w, "%s | %s (%s) | elapsed %v\n\n", d.cfg.TestTitle, d.databaseID, gcfg.DatabaseTag, time.Duration(int64(now.Sub(d.started))/int64(time.Second))*time.Second)
+
+	total := d.total
+	if n := gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; n > total {
+		total = n
+	}
+	progress := humanize.Comma(d.done)
+	if total > 0 {
+		progress = fmt.Sprintf("%s / %s (%.1f%%)", humanize.Comma(d.done), humanize.Comma(total), 100*float64(d.done)/float64(total))
+	}
+	fmt.Fprintf(w, "%-12s %s\n", "REQUESTS", progress)
+	clients := humanize.Comma(d.clientN)
+	if steps := len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers); d.step > 0 && steps > 0 {
+		clients += fmt.Sprintf(" (step %d out of %d)", d.step, steps)
+	}
+	fmt.Fprintf(w, "%-12s %s\n\n", "CLIENTS", clients)
+
+	last := func(vs []float64) float64 {
+		if len(vs) == 0 {
+			return 0
+		}
+		return vs[len(vs)-1]
+	}
+	fmt.Fprintf(w, "%-12s %12s  %s\n", "THROUGHPUT", fmt.Sprintf("%.0f req/s", last(d.throughputs)), sparkline(d.throughputs))
+	fmt.Fprintf(w, "%-12s %12s  %s\n", "P50-LATENCY", fmt.Sprintf("%.2f ms", last(d.p50s)), sparkline(d.p50s))
+	fmt.Fprintf(w, "%-12s %12s  %s\n\n", "P99-LATENCY", fmt.Sprintf("%.2f ms", last(d.p99s)), sparkline(d.p99s))
+
+	var classes []string
+	for _, class := range errclass.Classes {
+		if n := d.classes[class]; n > 0 {
+			classes = append(classes, fmt.Sprintf("%s %s", class, humanize.Comma(n)))
+		}
+	}
+	errs := humanize.Comma(d.errs)
+	if len(classes) > 0 {
+		errs += " (" + strings.Join(classes, ", ") + ")"
+	}
+	fmt.Fprintf(w, "%-12s %s\n\n", "ERRORS", errs)
+
+	fmt.Fprintf(w, "%-24s %10s %10s %14s %14s\n", "AGENT", "CPU", "RSS", "DISK-READ", "DISK-WRITE")
+	for _, ep := range gcfg.AgentEndpoints {
+		ah, ok := d.health[ep]
+		switch {
+		case !ok:
+			fmt.Fprintf(w, "%-24s %s\n", ep, "waiting")
+		case ah.err != nil:
+			fmt.Fprintf(w, "%-24s %s (%v)\n", ep, "unreachable", ah.err)
+		case ah.health.UnixNano == 0:
+			fmt.Fprintf(w, "%-24s %s\n", ep, "database not started")
+		default:
+			fmt.Fprintf(w, "%-24s %9.1f%% %10s %14s %14s\n", ep,
+				ah.health.CPUPercent,
+				humanize.Bytes(ah.health.RSSBytes),
+				humanize.Bytes(ah.health.DiskReadBytesPerSecond)+"/s",
+				humanize.Bytes(ah.health.DiskWriteBytesPerSecond)+"/s",
+			)
+		}
+	}
+}
+
+// sparkline returns the values as bars scaled to the maximum value.
+func sparkline(vs []float64) string {
+	max := 0.0
+	for _, v := range vs {
+		if v > max {
+			max = v
+		}
+	}
+	rs := make([]rune, len(vs))
+	for i, v := range vs {
+		idx := 0
+		if max > 0 {
+			idx = int(v / max * float64(len(sparkRunes)-1))
+		}
+		rs[i] = sparkRunes[idx]
+	}
+	return string(rs)
+}
@@ -0,0 +1,83 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/errclass"
+)
+
+func Test_sparkline(t *testing.T) {
+	if s := sparkline([]float64{0, 1, 2, 4, 8}); s != "▁▁▂▄█" {
+		t.Fatalf("unexpected sparkline %q", s)
+	}
+	if s := sparkline([]float64{0, 0}); s != "▁▁" {
+		t.Fatalf("unexpected sparkline %q", s)
+	}
+}
+
+func TestDashboardRender(t *testing.T) {
+	cfg := &Config{
+		TestTitle: "test",
+		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+			"etcd__v3_2": {
+				DatabaseTag:    "etcd-v3.2-go1.8.3",
+				AgentEndpoints: []string{"10.0.0.1:3500", "10.0.0.2:3500", "10.0.0.3:3500"},
+				ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+					RequestNumber:           1000,
+					ConnectionClientNumbers: []int64{1, 10, 100},
+				},
+			},
+		},
+	}
+	d := cfg.NewDashboard("etcd__v3_2", nil)
+	d.started = time.Unix(100, 0)
+	d.begin(500, 10, 2)
+
+	start := time.Unix(101, 0)
+	for i := 1; i <= 100; i++ {
+		d.observe(start, start.Add(time.Duration(i)*time.Millisecond), "")
+	}
+	d.observe(start, start, errclass.LeaderChanged)
+	d.roll()
+	d.health["10.0.0.1:3500"] = agentHealth{health: dbtesterpb.Health{UnixNano: 1, CPUPercent: 150.5, RSSBytes: 1000000, DiskWriteBytesPerSecond: 2000000}}
+	d.health["10.0.0.2:3500"] = agentHealth{err: errors.New("connection refused")}
+
+	var buf bytes.Buffer
+	d.render(&buf, time.Unix(130, 0))
+	for _, s := range []string{
+		"test | etcd__v3_2 (etcd-v3.2-go1.8.3) | elapsed 30s",
+		"REQUESTS     101 / 1,000 (10.1%)",
+		"CLIENTS      10 (step 2 out of 3)",
+		"101 req/s",
+		"50.00 ms",
+		"99.00 ms",
+		"ERRORS       1 (LEADER-CHANGED 1)",
+		"150.5%",
+		"2.0 MB/s",
+		"10.0.0.2:3500            unreachable (connection refused)",
+		"10.0.0.3:3500            waiting",
+	} {
+		if !strings.Contains(buf.String(), s) {
+			t.Fatalf("expected %q in\n%s", s, buf.String())
+		}
+	}
+}
@@ -24,6 +24,10 @@
 		ConfigClientMachineInitial
 		ConfigClientMachineBenchmarkOptions
 		ConfigClientMachineBenchmarkSteps
+		ConfigDatabaseBinary
+		ConfigDatabaseContainer
+		ConfigDatabaseCgroup
+		ConfigProfile
 		ConfigClientMachineAgentControl
 		Flag_Cetcd_Beta
 		Flag_Consul_V0_7_5
@@ -39,6 +43,11 @@
 		Flag_Zookeeper_R3_5_3Beta
 		Request
 		Response
+		PreflightCheck
+		PreflightResponse
+		GenerateRequest
+		TimeSeriesDataPoint
+		GenerateResponse
 */
 package dbtesterpb
 
@@ -78,6 +87,9 @@ type ConfigAnalyzeMachineInitial struct {
 	ServerWriteBytesDeltaByKeyNumberPath    string   `protobuf:"bytes,14,opt,name=ServerWriteBytesDeltaByKeyNumberPath,proto3" json:"ServerWriteBytesDeltaByKeyNumberPath,omitempty" yaml:"server_write_bytes_delta_by_key_number_path"`
 	ServerSystemMetricsInterpolatedPathList []string `protobuf:"bytes,15,rep,name=ServerSystemMetricsInterpolatedPathList" json:"ServerSystemMetricsInterpolatedPathList,omitempty" yaml:"server_system_metrics_interpolated_path_list"`
 	AllAggregatedOutputPath                 string   `protobuf:"bytes,16,opt,name=AllAggregatedOutputPath,proto3" json:"AllAggregatedOutputPath,omitempty" yaml:"all_aggregated_output_path"`
+	ServerDatabaseMetricsPathList           []string `protobuf:"bytes,17,rep,name=ServerDatabaseMetricsPathList" json:"ServerDatabaseMetricsPathList,omitempty" yaml:"server_database_metrics_path_list"`
+	ServerGCPausesPathList                  []string `protobuf:"bytes,18,rep,name=ServerGCPausesPathList" json:"ServerGCPausesPathList,omitempty" yaml:"server_gc_pauses_path_list"`
+	ServerDatabaseLogPathList               []string `protobuf:"bytes,19,rep,name=ServerDatabaseLogPathList" json:"ServerDatabaseLogPathList,omitempty" yaml:"server_database_log_path_list"`
 }
 
 func (m *ConfigAnalyzeMachineInitial) Reset()         { *m = ConfigAnalyzeMachineInitial{} }
@@ -273,6 +285,57 @@ func (m *ConfigAnalyzeMachineInitial) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.AllAggregatedOutputPath)))
 		i += copy(dAtA[i:], m.AllAggregatedOutputPath)
 	}
+	if len(m.ServerDatabaseMetricsPathList) > 0 {
+		for _, s := range m.ServerDatabaseMetricsPathList {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ServerGCPausesPathList) > 0 {
+		for _, s := range m.ServerGCPausesPathList {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ServerDatabaseLogPathList) > 0 {
+		for _, s := range m.ServerDatabaseLogPathList {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -531,6 +594,24 @@ func (m *ConfigAnalyzeMachineInitial) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
 	}
+	if len(m.ServerDatabaseMetricsPathList) > 0 {
+		for _, s := range m.ServerDatabaseMetricsPathList {
+			l = len(s)
+			n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
+	if len(m.ServerGCPausesPathList) > 0 {
+		for _, s := range m.ServerGCPausesPathList {
+			l = len(s)
+			n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
+	if len(m.ServerDatabaseLogPathList) > 0 {
+		for _, s := range m.ServerDatabaseLogPathList {
+			l = len(s)
+			n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1116,6 +1197,93 @@ func (m *ConfigAnalyzeMachineInitial) Unmarshal(dAtA []byte) error {
 			}
 			m.AllAggregatedOutputPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDatabaseMetricsPathList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerDatabaseMetricsPathList = append(m.ServerDatabaseMetricsPathList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerGCPausesPathList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerGCPausesPathList = append(m.ServerGCPausesPathList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDatabaseLogPathList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerDatabaseLogPathList = append(m.ServerDatabaseLogPathList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigAnalyzeMachine(dAtA[iNdEx:])
@@ -1797,68 +1965,73 @@ func init() {
 }
 
 var fileDescriptorConfigAnalyzeMachine = []byte{
-	// 999 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6e, 0xdc, 0x44,
-	0x18, 0xaf, 0x9b, 0x26, 0x90, 0x49, 0xd3, 0x96, 0x01, 0xb5, 0x4b, 0x82, 0xd6, 0xc1, 0x69, 0x48,
-	0xaa, 0x42, 0x52, 0x12, 0x28, 0x12, 0x27, 0x76, 0xb3, 0x3d, 0x44, 0x34, 0x10, 0x39, 0x0b, 0x84,
-	0xd3, 0x68, 0xec, 0x9d, 0x78, 0x47, 0xf1, 0x3f, 0x79, 0xc6, 0x65, 0x0d, 0x57, 0x24, 0x24, 0x24,
-	0x24, 0xb8, 0x71, 0xe2, 0xc8, 0xb3, 0xf4, 0xc8, 0x13, 0x18, 0x08, 0x6f, 0xe0, 0x17, 0xa0, 0x9a,
-	0x6f, 0x9c, 0xc4, 0xbb, 0xf1, 0xfe, 0xb9, 0xad, 0xe7, 0xfb, 0xfd, 0xfb, 0x3e, 0xcf, 0x8e, 0x07,
-	0x6d, 0xf6, 0x1c, 0xc9, 0x84, 0x64, 0x49, 0xec, 0xec, 0xb8, 0x51, 0x78, 0xca, 0x3d, 0x42, 0x43,
-	0xea, 0x67, 0xdf, 0x33, 0x12, 0x50, 0xb7, 0xcf, 0x43, 0xb6, 0x1d, 0x27, 0x91, 0x8c, 0x30, 0xba,
-	0x02, 0xae, 0x7c, 0xe0, 0x71, 0xd9, 0x4f, 0x9d, 0x6d, 0x37, 0x0a, 0x76, 0xbc, 0xc8, 0x8b, 0x76,
-	0x00, 0xe2, 0xa4, 0xa7, 0xf0, 0x04, 0x0f, 0xf0, 0x4b, 0x53, 0xad, 0xbf, 0x97, 0xd1, 0xea, 0x3e,
-	0x68, 0xb7, 0xb4, 0xf4, 0xa1, 0x56, 0x3e, 0x08, 0xb9, 0xe4, 0xd4, 0xc7, 0x4d, 0x84, 0x3a, 0x54,
-	0x52, 0x87, 0x0a, 0x76, 0xd0, 0x69, 0x18, 0x6b, 0xc6, 0xd6, 0xa2, 0x5d, 0x59, 0xc1, 0x6b, 0x68,
-	0xe9, 0xe2, 0xa9, 0x4b, 0xbd, 0xc6, 0x4d, 0x00, 0x54, 0x97, 0xf0, 0x13, 0xf4, 0xe6, 0xc5, 0x63,
-	0x87, 0x09, 0x37, 0xe1, 0xb1, 0xe4, 0x51, 0xd8, 0x98, 0x03, 0x64, 0x5d, 0x09, 0x3f, 0x45, 0xe8,
-	0x88, 0xca, 0xfe, 0x51, 0xc2, 0x4e, 0xf9, 0xa0, 0x71, 0x4b, 0x01, 0xdb, 0xf7, 0x8b, 0xdc, 0xc4,
-	0x19, 0x0d, 0xfc, 0x4f, 0xad, 0x98, 0xca, 0x3e, 0x89, 0xa1, 0x68, 0xd9, 0x15, 0x24, 0xfe, 0xd1,
-	0x40, 0xeb, 0xfb, 0x3e, 0x67, 0xa1, 0x3c, 0xce, 0x84, 0x64, 0xc1, 0x21, 0x93, 0x09, 0x77, 0xc5,
-	0x41, 0xa8, 0x26, 0x13, 0xf9, 0x54, 0xb2, 0x9e, 0x42, 0x37, 0xe6, 0x41, 0x71, 0xb7, 0xc8, 0xcd,
-	0x6d, 0xad, 0xe8, 0x02, 0x89, 0x08, 0x60, 0x91, 0x40, 0xd3, 0x08, 0xaf, 0xf0, 0x88, 0x32, 0xb5,
-	0xec, 0x59, 0xe4, 0xf1, 0xcf, 0x06, 0xda, 0xd0, 0xb8, 0xe7, 0x54, 0xb2, 0xd0, 0xcd, 0xba, 0xfd,
-	0x24, 0x4a, 0xbd, 0x7e, 0x9c, 0xca, 0x2e, 0x0f, 0x98, 0x60, 0x09, 0x67, 0x02, 0x82, 0x2c, 0x40,
-	0x90, 0x8f, 0x8a, 0xdc, 0x7c, 0x32, 0x14, 0xc4, 0xd7, 0x3c, 0x22, 0x2f, 0x89, 0x44, 0x5e, 0x32,
-	0xcb, 0x28, 0xb3, 0x59, 0xe0, 0x1f, 0xd0, 0xda, 0x10, 0xb0, 0xc3, 0x85, 0x4c, 0xb8, 0x93, 0xaa,
-	0x41, 0xb7, 0x7c, 0x1f, 0x62, 0xbc, 0x06, 0x31, 0x76, 0x8a, 0xdc, 0x7c, 0x5c, 0x1b, 0xa3, 0x57,
-	0xe1, 0x10, 0xea, 0xfb, 0x65, 0x82, 0xa9, 0xc2, 0xf8, 0x57, 0x03, 0x6d, 0x8e, 0x05, 0x1d, 0xb1,
-	0xc4, 0x65, 0xa1, 0xe4, 0x3e, 0x83, 0x10, 0xaf, 0x43, 0x88, 0xa7, 0x45, 0x6e, 0xee, 0x4e, 0x0f,
-	0x11, 0x5f, 0x72, 0xcb, 0x2c, 0xb3, 0xda, 0xe0, 0x9f, 0x0c, 0xf4, 0x70, 0x2c, 0xf6, 0x38, 0x0d,
-	0x02, 0x9a, 0x64, 0x90, 0x67, 0x11, 0xf2, 0xec, 0x15, 0xb9, 0xb9, 0x33, 0x3d, 0x8f, 0xd0, 0xc4,
-	0x32, 0xcc, 0x4c, 0x06, 0x38, 0x46, 0xef, 0x0c, 0xe1, 0xda, 0xd9, 0xe7, 0x2c, 0xfb, 0x22, 0x0d,
-	0x1c, 0x96, 0x40, 0x00, 0x04, 0x01, 0xde, 0x2f, 0x72, 0x73, 0xab, 0x36, 0x80, 0x93, 0x91, 0x33,
-	0x96, 0x91, 0x10, 0x18, 0xa5, 0xf3, 0x44, 0x45, 0x9c, 0x21, 0xf3, 0x98, 0x25, 0x2f, 0x58, 0xd2,
-	0xe1, 0xe2, 0xec, 0x38, 0xa6, 0x2e, 0xfb, 0x4a, 0x50, 0x8f, 0x55, 0xbb, 0x5e, 0x1a, 0xdd, 0x0a,
-	0x02, 0x08, 0xaa, 0xdb, 0x33, 0x22, 0x14, 0x85, 0xa4, 0x8a, 0x33, 0xd2, 0xf1, 0x34, 0x5d, 0x1c,
-	0xa0, 0x55, 0x0d, 0x39, 0x64, 0x41, 0x94, 0x5c, 0xeb, 0xf5, 0x36, 0xd8, 0x3e, 0x2e, 0x72, 0x73,
-	0x73, 0xc8, 0x36, 0x00, 0x74, 0x6d, 0xab, 0x93, 0xf4, 0xd4, 0x5b, 0x5e, 0xd7, 0x75, 0x9b, 0xd1,
-	0x5e, 0x3b, 0x93, 0x4c, 0x74, 0x98, 0x2f, 0xe9, 0xa8, 0xef, 0x32, 0xf8, 0x7e, 0x5c, 0xe4, 0xe6,
-	0x87, 0x43, 0xbe, 0x09, 0xa3, 0x3d, 0xe2, 0x28, 0x1a, 0xe9, 0x29, 0x5e, 0x6d, 0x82, 0x59, 0x1c,
-	0xd4, 0x61, 0xf0, 0x50, 0xe3, 0xbe, 0x49, 0xb8, 0x64, 0xe3, 0xa3, 0xdc, 0x19, 0xdd, 0xff, 0x65,
-	0x94, 0xef, 0x14, 0x6d, 0x6a, 0x96, 0x99, 0x3c, 0xf0, 0x6f, 0x06, 0xda, 0xd4, 0xc0, 0x89, 0x27,
-	0xd8, 0x73, 0x2e, 0x64, 0xe3, 0xee, 0xda, 0xdc, 0xd6, 0x62, 0xfb, 0x93, 0x22, 0x37, 0xf7, 0x86,
-	0xf2, 0x4c, 0x3b, 0x24, 0x89, 0xcf, 0x85, 0xb4, 0xec, 0x59, 0x7d, 0x30, 0x41, 0x0f, 0x5a, 0xbe,
-	0xdf, 0xf2, 0xbc, 0x84, 0x79, 0xaa, 0xf0, 0x65, 0x2a, 0xe3, 0x54, 0xc2, 0x48, 0xee, 0xc1, 0x48,
-	0x36, 0x8a, 0xdc, 0x7c, 0x57, 0x47, 0x50, 0x67, 0x0f, 0xbd, 0x44, 0x92, 0x08, 0xa0, 0xe5, 0x04,
-	0xc6, 0xa9, 0x58, 0xff, 0xab, 0x43, 0xa8, 0xe6, 0x0b, 0x57, 0x83, 0xc7, 0x1c, 0xad, 0x8c, 0x91,
-	0xd9, 0x3f, 0xfe, 0x5a, 0x7f, 0xfd, 0xda, 0x8f, 0x8a, 0xdc, 0xdc, 0x98, 0x96, 0x87, 0xb8, 0xe2,
-	0x85, 0x65, 0x4f, 0x10, 0x9b, 0x60, 0xd5, 0x3d, 0xe9, 0xea, 0xef, 0xe8, 0x8c, 0x56, 0x72, 0x20,
-	0xc7, 0x5b, 0x75, 0x4f, 0xba, 0xd6, 0x1f, 0x37, 0x51, 0xa3, 0x6e, 0x02, 0x47, 0x7e, 0x24, 0xf1,
-	0x23, 0xb4, 0xb0, 0x1f, 0xf9, 0x69, 0x10, 0x96, 0xed, 0xbd, 0x51, 0xe4, 0xe6, 0x72, 0x79, 0xe0,
-	0xc0, 0xba, 0x65, 0x97, 0x00, 0xbc, 0x89, 0xe6, 0x4f, 0x5a, 0x03, 0x2e, 0xca, 0x74, 0x15, 0xe4,
-	0x80, 0xd0, 0x01, 0x17, 0x96, 0xad, 0xeb, 0x0a, 0xf8, 0x2d, 0x00, 0xe7, 0x46, 0x81, 0xd9, 0x05,
-	0x10, 0xea, 0xf8, 0x33, 0xb4, 0x3c, 0x3c, 0x62, 0xfd, 0xb1, 0x5f, 0x29, 0x72, 0xf3, 0xbe, 0x26,
-	0x5c, 0x9b, 0xe9, 0x30, 0x01, 0xef, 0xa3, 0x3b, 0x57, 0x0b, 0xb0, 0x71, 0xe7, 0x61, 0xe3, 0xae,
-	0x16, 0xb9, 0xf9, 0xe0, 0xba, 0x84, 0xde, 0x9c, 0x23, 0x14, 0xeb, 0x17, 0x03, 0xbd, 0x5d, 0x7b,
-	0x09, 0x0a, 0xa8, 0xc7, 0xf0, 0x7b, 0x68, 0xbe, 0xcb, 0xa5, 0xcf, 0xca, 0x01, 0xdd, 0x2b, 0x72,
-	0xf3, 0xb6, 0x56, 0x96, 0x6a, 0xd9, 0xb2, 0x75, 0x19, 0xaf, 0xa3, 0x5b, 0xb0, 0x6d, 0xf5, 0x74,
-	0xee, 0x16, 0xb9, 0xb9, 0x74, 0x75, 0x61, 0xb1, 0x6c, 0x28, 0x2a, 0x50, 0x37, 0x8b, 0x59, 0x39,
-	0x99, 0x0a, 0x48, 0x66, 0x31, 0xb3, 0x6c, 0x28, 0x5a, 0x7f, 0x1a, 0x68, 0xa5, 0x2e, 0x8f, 0xfd,
-	0xac, 0xd5, 0x39, 0x7c, 0xa6, 0xee, 0x47, 0x95, 0x7f, 0x89, 0x31, 0x7a, 0x3f, 0x1a, 0xfa, 0x5b,
-	0x54, 0x90, 0xf8, 0x08, 0x2d, 0x40, 0x47, 0xea, 0x05, 0xce, 0x6d, 0x2d, 0xed, 0x6e, 0x6c, 0x5f,
-	0xdd, 0x1b, 0xb7, 0xc7, 0xf6, 0x5f, 0x7d, 0x7d, 0x1c, 0xe8, 0x96, 0x5d, 0xea, 0xb4, 0xdf, 0x7a,
-	0xf9, 0x6f, 0xf3, 0xc6, 0xcb, 0xf3, 0xa6, 0xf1, 0xd7, 0x79, 0xd3, 0xf8, 0xe7, 0xbc, 0x69, 0xfc,
-	0xfe, 0x5f, 0xf3, 0x86, 0xb3, 0x00, 0x57, 0xcb, 0xbd, 0x57, 0x01, 0x00, 0x00, 0xff, 0xff, 0x47,
-	0x8f, 0xbe, 0x24, 0xc0, 0x0a, 0x00, 0x00,
+	// 1081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xae, 0xb3, 0x4d, 0x20, 0x93, 0xa6, 0x4d, 0xa7, 0x28, 0xdd, 0x26, 0xb0, 0x5e, 0x9c, 0x84,
+	0xa4, 0x2a, 0x24, 0x25, 0x81, 0x22, 0x71, 0x62, 0x3f, 0x2a, 0x14, 0x91, 0xc0, 0xca, 0x59, 0x20,
+	0x1c, 0xd0, 0x68, 0xd6, 0x3b, 0xf1, 0x8e, 0xe2, 0x2f, 0xd9, 0xe3, 0x12, 0xc3, 0x15, 0x09, 0x09,
+	0x09, 0x09, 0x6e, 0x88, 0x03, 0x47, 0x7e, 0x4b, 0x8f, 0xfc, 0x02, 0x0b, 0xc2, 0x3f, 0xf0, 0x1f,
+	0x00, 0xf9, 0x1d, 0x67, 0x63, 0x6f, 0xbc, 0x1f, 0xdc, 0xe2, 0x99, 0xe7, 0xeb, 0x7d, 0x67, 0x76,
+	0xf2, 0xa2, 0xed, 0x7e, 0x4f, 0xb0, 0x40, 0x30, 0xdf, 0xeb, 0xed, 0x19, 0xae, 0x73, 0xc6, 0x4d,
+	0x42, 0x1d, 0x6a, 0x45, 0xdf, 0x32, 0x62, 0x53, 0x63, 0xc0, 0x1d, 0xb6, 0xeb, 0xf9, 0xae, 0x70,
+	0x31, 0xba, 0x06, 0xae, 0xbd, 0x63, 0x72, 0x31, 0x08, 0x7b, 0xbb, 0x86, 0x6b, 0xef, 0x99, 0xae,
+	0xe9, 0xee, 0x01, 0xa4, 0x17, 0x9e, 0xc1, 0x17, 0x7c, 0xc0, 0x5f, 0x92, 0xaa, 0xfd, 0xb6, 0x82,
+	0xd6, 0x5b, 0xa0, 0xdd, 0x90, 0xd2, 0xc7, 0x52, 0xf9, 0xd0, 0xe1, 0x82, 0x53, 0x0b, 0xd7, 0x10,
+	0x6a, 0x53, 0x41, 0x7b, 0x34, 0x60, 0x87, 0xed, 0xaa, 0x52, 0x57, 0x76, 0x16, 0xf5, 0xdc, 0x0a,
+	0xae, 0xa3, 0xa5, 0xab, 0xaf, 0x2e, 0x35, 0xab, 0x73, 0x00, 0xc8, 0x2f, 0xe1, 0xa7, 0xe8, 0xc1,
+	0xd5, 0x67, 0x9b, 0x05, 0x86, 0xcf, 0x3d, 0xc1, 0x5d, 0xa7, 0x5a, 0x01, 0x64, 0xd9, 0x16, 0x7e,
+	0x86, 0x50, 0x87, 0x8a, 0x41, 0xc7, 0x67, 0x67, 0xfc, 0xa2, 0x7a, 0x3b, 0x05, 0x36, 0x57, 0x93,
+	0x58, 0xc5, 0x11, 0xb5, 0xad, 0x0f, 0x35, 0x8f, 0x8a, 0x01, 0xf1, 0x60, 0x53, 0xd3, 0x73, 0x48,
+	0xfc, 0xbd, 0x82, 0x36, 0x5a, 0x16, 0x67, 0x8e, 0x38, 0x89, 0x02, 0xc1, 0xec, 0x63, 0x26, 0x7c,
+	0x6e, 0x04, 0x87, 0x4e, 0xda, 0x19, 0xd7, 0xa2, 0x82, 0xf5, 0x53, 0x74, 0x75, 0x1e, 0x14, 0xf7,
+	0x93, 0x58, 0xdd, 0x95, 0x8a, 0x06, 0x90, 0x48, 0x00, 0x2c, 0x62, 0x4b, 0x1a, 0xe1, 0x39, 0x1e,
+	0x49, 0x4d, 0x35, 0x7d, 0x16, 0x79, 0xfc, 0xa3, 0x82, 0xb6, 0x24, 0xee, 0x88, 0x0a, 0xe6, 0x18,
+	0x51, 0x77, 0xe0, 0xbb, 0xa1, 0x39, 0xf0, 0x42, 0xd1, 0xe5, 0x36, 0x0b, 0x98, 0xcf, 0x59, 0x00,
+	0x41, 0x16, 0x20, 0xc8, 0x7b, 0x49, 0xac, 0x3e, 0x2d, 0x04, 0xb1, 0x24, 0x8f, 0x88, 0x21, 0x91,
+	0x88, 0x21, 0x33, 0x8b, 0x32, 0x9b, 0x05, 0xfe, 0x0e, 0xd5, 0x0b, 0xc0, 0x36, 0x0f, 0x84, 0xcf,
+	0x7b, 0x61, 0xda, 0xe8, 0x86, 0x65, 0x41, 0x8c, 0x57, 0x20, 0xc6, 0x5e, 0x12, 0xab, 0x4f, 0x4a,
+	0x63, 0xf4, 0x73, 0x1c, 0x42, 0x2d, 0x2b, 0x4b, 0x30, 0x55, 0x18, 0xff, 0xac, 0xa0, 0xed, 0xb1,
+	0xa0, 0x0e, 0xf3, 0x0d, 0xe6, 0x08, 0x6e, 0x31, 0x08, 0xf1, 0x2a, 0x84, 0x78, 0x96, 0xc4, 0xea,
+	0xfe, 0xf4, 0x10, 0xde, 0x90, 0x9b, 0x65, 0x99, 0xd5, 0x06, 0xff, 0xa0, 0xa0, 0xcd, 0xb1, 0xd8,
+	0x93, 0xd0, 0xb6, 0xa9, 0x1f, 0x41, 0x9e, 0x45, 0xc8, 0x73, 0x90, 0xc4, 0xea, 0xde, 0xf4, 0x3c,
+	0x81, 0x24, 0x66, 0x61, 0x66, 0x32, 0xc0, 0x1e, 0x7a, 0xbd, 0x80, 0x6b, 0x46, 0x9f, 0xb0, 0xe8,
+	0xd3, 0xd0, 0xee, 0x31, 0x1f, 0x02, 0x20, 0x08, 0xf0, 0x76, 0x12, 0xab, 0x3b, 0xa5, 0x01, 0x7a,
+	0x11, 0x39, 0x67, 0x11, 0x71, 0x80, 0x91, 0x39, 0x4f, 0x54, 0xc4, 0x11, 0x52, 0x4f, 0x98, 0xff,
+	0x82, 0xf9, 0x6d, 0x1e, 0x9c, 0x9f, 0x78, 0xd4, 0x60, 0x9f, 0x07, 0xd4, 0x64, 0xf9, 0xaa, 0x97,
+	0x46, 0xaf, 0x42, 0x00, 0x84, 0xb4, 0xda, 0x73, 0x12, 0xa4, 0x14, 0x12, 0xa6, 0x9c, 0x91, 0x8a,
+	0xa7, 0xe9, 0x62, 0x1b, 0xad, 0x4b, 0xc8, 0x31, 0xb3, 0x5d, 0xff, 0x46, 0xad, 0x77, 0xc0, 0xf6,
+	0x49, 0x12, 0xab, 0xdb, 0x05, 0x5b, 0x1b, 0xd0, 0xa5, 0xa5, 0x4e, 0xd2, 0x4b, 0x4f, 0x79, 0x43,
+	0xee, 0xeb, 0x8c, 0xf6, 0x9b, 0x91, 0x60, 0x41, 0x9b, 0x59, 0x82, 0x8e, 0xfa, 0x2e, 0x83, 0xef,
+	0xfb, 0x49, 0xac, 0xbe, 0x5b, 0xf0, 0xf5, 0x19, 0xed, 0x93, 0x5e, 0x4a, 0x23, 0xfd, 0x94, 0x57,
+	0x9a, 0x60, 0x16, 0x87, 0xf4, 0x31, 0xd8, 0x94, 0xb8, 0x2f, 0x7d, 0x2e, 0xd8, 0xf8, 0x28, 0x77,
+	0x47, 0xef, 0x7f, 0x16, 0xe5, 0x9b, 0x94, 0x36, 0x35, 0xcb, 0x4c, 0x1e, 0xf8, 0x17, 0x05, 0x6d,
+	0x4b, 0xe0, 0xc4, 0x17, 0xec, 0x88, 0x07, 0xa2, 0x7a, 0xaf, 0x5e, 0xd9, 0x59, 0x6c, 0x7e, 0x90,
+	0xc4, 0xea, 0x41, 0x21, 0xcf, 0xb4, 0x47, 0x92, 0x58, 0x3c, 0x10, 0x9a, 0x3e, 0xab, 0x0f, 0x26,
+	0xe8, 0x61, 0xc3, 0xb2, 0x1a, 0xa6, 0xe9, 0x33, 0x33, 0xdd, 0xf8, 0x2c, 0x14, 0x5e, 0x28, 0xa0,
+	0x25, 0x2b, 0xd0, 0x92, 0xad, 0x24, 0x56, 0xdf, 0x94, 0x11, 0xd2, 0xb7, 0x87, 0x0e, 0x91, 0xc4,
+	0x05, 0x68, 0xd6, 0x81, 0x71, 0x2a, 0xd8, 0x47, 0x6f, 0x64, 0xb7, 0x33, 0xfb, 0x57, 0x93, 0xa5,
+	0x19, 0x56, 0x7a, 0xbf, 0x5e, 0x29, 0xfe, 0xd0, 0xae, 0xee, 0x7c, 0x86, 0x1f, 0xd6, 0x9a, 0x2b,
+	0x6f, 0xb2, 0x24, 0xfe, 0x1a, 0xad, 0x4a, 0xc0, 0xc7, 0xad, 0x0e, 0x0d, 0x03, 0x76, 0x6d, 0x86,
+	0xeb, 0x95, 0x62, 0x4d, 0x99, 0x99, 0x69, 0x10, 0x0f, 0x90, 0x79, 0x97, 0x31, 0x22, 0xf8, 0x0c,
+	0x3d, 0x2a, 0xfa, 0x1f, 0xb9, 0xe6, 0xd0, 0xe1, 0x01, 0x38, 0xec, 0x24, 0xb1, 0xba, 0x59, 0x5e,
+	0x8e, 0xe5, 0x9a, 0x79, 0x93, 0xf1, 0x52, 0xda, 0xbf, 0xe9, 0xfb, 0x5d, 0x32, 0x1c, 0x94, 0xb4,
+	0x1a, 0x73, 0xb4, 0x36, 0xe6, 0x04, 0x5a, 0x27, 0x5f, 0xc8, 0xc1, 0xa1, 0xf9, 0x38, 0x89, 0xd5,
+	0xad, 0x69, 0x47, 0x49, 0x8c, 0xe0, 0x85, 0xa6, 0x4f, 0x10, 0x9b, 0x60, 0xd5, 0x3d, 0xed, 0x56,
+	0xe7, 0xfe, 0x87, 0x95, 0xb8, 0x10, 0xe3, 0xad, 0xba, 0xa7, 0x5d, 0xed, 0xf7, 0x39, 0x54, 0x2d,
+	0xeb, 0x40, 0xc7, 0x72, 0x05, 0x7e, 0x8c, 0x16, 0x5a, 0xae, 0x15, 0xda, 0x4e, 0x56, 0xde, 0xfd,
+	0x24, 0x56, 0x97, 0xb3, 0xb7, 0x1a, 0xd6, 0x35, 0x3d, 0x03, 0xe0, 0x6d, 0x34, 0x7f, 0xda, 0xb8,
+	0xe0, 0x41, 0x75, 0x6e, 0x14, 0x79, 0x41, 0xe8, 0x05, 0x0f, 0x34, 0x5d, 0xee, 0xa7, 0xc0, 0xaf,
+	0x00, 0x58, 0x19, 0x05, 0x46, 0x57, 0x40, 0xd8, 0xc7, 0x1f, 0xa1, 0xe5, 0x62, 0x8b, 0xe5, 0x9c,
+	0xb4, 0x96, 0xc4, 0xea, 0xaa, 0x24, 0xdc, 0xe8, 0x69, 0x91, 0x80, 0x5b, 0xe8, 0xee, 0xf5, 0x02,
+	0x5c, 0x9d, 0x79, 0xb8, 0x3a, 0xeb, 0x49, 0xac, 0x3e, 0xbc, 0x29, 0x21, 0x6f, 0xcb, 0x08, 0x45,
+	0xfb, 0x49, 0x41, 0x8f, 0x4a, 0xe7, 0x47, 0x9b, 0x9a, 0x0c, 0xbf, 0x85, 0xe6, 0xbb, 0x5c, 0x58,
+	0x2c, 0x6b, 0xd0, 0x4a, 0x12, 0xab, 0x77, 0xa4, 0xb2, 0x48, 0x97, 0x35, 0x5d, 0x6e, 0xe3, 0x0d,
+	0x74, 0x1b, 0x7e, 0xf1, 0xb2, 0x3b, 0xf7, 0x92, 0x58, 0x5d, 0xba, 0x9e, 0xf5, 0x34, 0x1d, 0x36,
+	0x53, 0x50, 0x37, 0xf2, 0x58, 0xb5, 0x32, 0x0a, 0x12, 0x91, 0xc7, 0x34, 0x1d, 0x36, 0xb5, 0x3f,
+	0x14, 0xb4, 0x56, 0x96, 0x47, 0x7f, 0xde, 0x68, 0x1f, 0x3f, 0x4f, 0x47, 0xcb, 0xdc, 0x03, 0xa3,
+	0x8c, 0x8e, 0x96, 0x85, 0x17, 0x25, 0x87, 0xc4, 0x1d, 0xb4, 0x00, 0x15, 0xa5, 0x07, 0x58, 0xd9,
+	0x59, 0xda, 0xdf, 0xda, 0xbd, 0x1e, 0xb9, 0x77, 0xc7, 0xd6, 0x9f, 0x3f, 0x3e, 0x0e, 0x74, 0x4d,
+	0xcf, 0x74, 0x9a, 0xaf, 0xbd, 0xfc, 0xbb, 0x76, 0xeb, 0xe5, 0x65, 0x4d, 0xf9, 0xf3, 0xb2, 0xa6,
+	0xfc, 0x75, 0x59, 0x53, 0x7e, 0xfd, 0xa7, 0x76, 0xab, 0xb7, 0x00, 0x53, 0xf9, 0xc1, 0x7f, 0x03,
+	0x00, 0xd7, 0x45, 0x59, 0x2e, 0xfb, 0x0b, 0x00, 0x00,
 }
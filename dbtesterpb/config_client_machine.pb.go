@@ -43,16 +43,20 @@ func (*ConfigClientMachineInitial) Descriptor() ([]byte, []int) {
 
 // ConfigClientMachineBenchmarkOptions represents benchmark options.
 type ConfigClientMachineBenchmarkOptions struct {
-	Type                       string  `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty" yaml:"type"`
-	RequestNumber              int64   `protobuf:"varint,2,opt,name=RequestNumber,proto3" json:"RequestNumber,omitempty" yaml:"request_number"`
-	ConnectionNumber           int64   `protobuf:"varint,3,opt,name=ConnectionNumber,proto3" json:"ConnectionNumber,omitempty" yaml:"connection_number"`
-	ClientNumber               int64   `protobuf:"varint,4,opt,name=ClientNumber,proto3" json:"ClientNumber,omitempty" yaml:"client_number"`
-	ConnectionClientNumbers    []int64 `protobuf:"varint,5,rep,packed,name=ConnectionClientNumbers" json:"ConnectionClientNumbers,omitempty" yaml:"connection_client_numbers"`
-	RateLimitRequestsPerSecond int64   `protobuf:"varint,6,opt,name=RateLimitRequestsPerSecond,proto3" json:"RateLimitRequestsPerSecond,omitempty" yaml:"rate_limit_requests_per_second"`
-	SameKey                    bool    `protobuf:"varint,7,opt,name=SameKey,proto3" json:"SameKey,omitempty" yaml:"same_key"`
-	KeySizeBytes               int64   `protobuf:"varint,8,opt,name=KeySizeBytes,proto3" json:"KeySizeBytes,omitempty" yaml:"key_size_bytes"`
-	ValueSizeBytes             int64   `protobuf:"varint,9,opt,name=ValueSizeBytes,proto3" json:"ValueSizeBytes,omitempty" yaml:"value_size_bytes"`
-	StaleRead                  bool    `protobuf:"varint,10,opt,name=StaleRead,proto3" json:"StaleRead,omitempty" yaml:"stale_read"`
+	Type                       string   `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty" yaml:"type"`
+	RequestNumber              int64    `protobuf:"varint,2,opt,name=RequestNumber,proto3" json:"RequestNumber,omitempty" yaml:"request_number"`
+	ConnectionNumber           int64    `protobuf:"varint,3,opt,name=ConnectionNumber,proto3" json:"ConnectionNumber,omitempty" yaml:"connection_number"`
+	ClientNumber               int64    `protobuf:"varint,4,opt,name=ClientNumber,proto3" json:"ClientNumber,omitempty" yaml:"client_number"`
+	ConnectionClientNumbers    []int64  `protobuf:"varint,5,rep,packed,name=ConnectionClientNumbers" json:"ConnectionClientNumbers,omitempty" yaml:"connection_client_numbers"`
+	RateLimitRequestsPerSecond int64    `protobuf:"varint,6,opt,name=RateLimitRequestsPerSecond,proto3" json:"RateLimitRequestsPerSecond,omitempty" yaml:"rate_limit_requests_per_second"`
+	SameKey                    bool     `protobuf:"varint,7,opt,name=SameKey,proto3" json:"SameKey,omitempty" yaml:"same_key"`
+	KeySizeBytes               int64    `protobuf:"varint,8,opt,name=KeySizeBytes,proto3" json:"KeySizeBytes,omitempty" yaml:"key_size_bytes"`
+	ValueSizeBytes             int64    `protobuf:"varint,9,opt,name=ValueSizeBytes,proto3" json:"ValueSizeBytes,omitempty" yaml:"value_size_bytes"`
+	StaleRead                  bool     `protobuf:"varint,10,opt,name=StaleRead,proto3" json:"StaleRead,omitempty" yaml:"stale_read"`
+	LoadGeneratorEndpoints     []string `protobuf:"bytes,11,rep,name=LoadGeneratorEndpoints" json:"LoadGeneratorEndpoints,omitempty" yaml:"load_generator_endpoints"`
+	// SampleIntervalMillisecond is the interval of client latency and throughput
+	// timeseries, which must divide 1000. Defaults to 1000 (one second).
+	SampleIntervalMillisecond int64 `protobuf:"varint,12,opt,name=SampleIntervalMillisecond,proto3" json:"SampleIntervalMillisecond,omitempty" yaml:"sample_interval_millisecond"`
 }
 
 func (m *ConfigClientMachineBenchmarkOptions) Reset()         { *m = ConfigClientMachineBenchmarkOptions{} }
@@ -77,17 +81,124 @@ func (*ConfigClientMachineBenchmarkSteps) Descriptor() ([]byte, []int) {
 	return fileDescriptorConfigClientMachine, []int{2}
 }
 
+// ConfigDatabaseBinary represents the database binary to provision in agent machines.
+type ConfigDatabaseBinary struct {
+	// Version is the expected version, which must be in the output of '--version'.
+	Version string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty" yaml:"version"`
+	// Source is the tarball (or zip) path in agent machines,
+	// or the HTTP URL of artifact cache to download from.
+	Source string `protobuf:"bytes,2,opt,name=Source,proto3" json:"Source,omitempty" yaml:"source"`
+	// SHA256 is the hex-encoded SHA-256 checksum of the source.
+	SHA256 string `protobuf:"bytes,3,opt,name=SHA256,proto3" json:"SHA256,omitempty" yaml:"sha256"`
+	// Path is the binary path (or Zookeeper directory) in the unpacked source.
+	// If empty, agents search for the binary by name.
+	Path string `protobuf:"bytes,4,opt,name=Path,proto3" json:"Path,omitempty" yaml:"path"`
+}
+
+func (m *ConfigDatabaseBinary) Reset()         { *m = ConfigDatabaseBinary{} }
+func (m *ConfigDatabaseBinary) String() string { return proto.CompactTextString(m) }
+func (*ConfigDatabaseBinary) ProtoMessage()    {}
+func (*ConfigDatabaseBinary) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{3}
+}
+
+// ConfigDatabaseContainer represents the container to run the database in agent machines.
+// The database binary and data directory are mounted at the same paths as in the host.
+type ConfigDatabaseContainer struct {
+	// Runtime is the container runtime CLI (e.g. 'docker', 'podman').
+	Runtime string `protobuf:"bytes,1,opt,name=Runtime,proto3" json:"Runtime,omitempty" yaml:"runtime"`
+	// Image is the container image to run the database binary in
+	// (e.g. 'debian:stretch', or 'openjdk:8-jre' for Zookeeper).
+	Image string `protobuf:"bytes,2,opt,name=Image,proto3" json:"Image,omitempty" yaml:"image"`
+	// CPUs is the number of CPUs the container can use (e.g. 2.5).
+	CPUs float64 `protobuf:"fixed64,3,opt,name=CPUs,proto3" json:"CPUs,omitempty" yaml:"cpus"`
+	// MemoryBytes is the memory limit of the container.
+	MemoryBytes int64 `protobuf:"varint,4,opt,name=MemoryBytes,proto3" json:"MemoryBytes,omitempty" yaml:"memory_bytes"`
+}
+
+func (m *ConfigDatabaseContainer) Reset()         { *m = ConfigDatabaseContainer{} }
+func (m *ConfigDatabaseContainer) String() string { return proto.CompactTextString(m) }
+func (*ConfigDatabaseContainer) ProtoMessage()    {}
+func (*ConfigDatabaseContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{4}
+}
+
+// ConfigDatabaseCgroup represents the cgroup v2 limits of the database processes.
+// The database and its proxy (zetcd, cetcd) share the limits.
+type ConfigDatabaseCgroup struct {
+	// CPUs is the number of CPUs, written to 'cpu.max' (e.g. 2.5).
+	CPUs float64 `protobuf:"fixed64,1,opt,name=CPUs,proto3" json:"CPUs,omitempty" yaml:"cpus"`
+	// MemoryBytes is written to 'memory.max'.
+	MemoryBytes int64 `protobuf:"varint,2,opt,name=MemoryBytes,proto3" json:"MemoryBytes,omitempty" yaml:"memory_bytes"`
+	// IOMax are the lines to write to 'io.max' (e.g. '8:0 wbps=104857600').
+	IOMax []string `protobuf:"bytes,3,rep,name=IOMax" json:"IOMax,omitempty" yaml:"io_max"`
+}
+
+func (m *ConfigDatabaseCgroup) Reset()         { *m = ConfigDatabaseCgroup{} }
+func (m *ConfigDatabaseCgroup) String() string { return proto.CompactTextString(m) }
+func (*ConfigDatabaseCgroup) ProtoMessage()    {}
+func (*ConfigDatabaseCgroup) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{5}
+}
+
+// ConfigProfile represents when to capture Go runtime profiles of the
+// database and the control process. Captures include CPU profile, heap
+// profile and goroutine dump.
+type ConfigProfile struct {
+	// IntervalSecond captures profiles periodically, if positive.
+	IntervalSecond int64 `protobuf:"varint,1,opt,name=IntervalSecond,proto3" json:"IntervalSecond,omitempty" yaml:"interval_second"`
+	// P99LatencyMs captures profiles when the p99 latency of
+	// the last second exceeds it, if positive.
+	P99LatencyMs float64 `protobuf:"fixed64,2,opt,name=P99LatencyMs,proto3" json:"P99LatencyMs,omitempty" yaml:"p99_latency_ms"`
+	// CooldownSecond is the minimum interval between captures
+	// triggered by latency. Defaults to 60.
+	CooldownSecond int64 `protobuf:"varint,3,opt,name=CooldownSecond,proto3" json:"CooldownSecond,omitempty" yaml:"cooldown_second"`
+	// CPUSecond is the duration of CPU profile. Defaults to 10.
+	CPUSecond int64 `protobuf:"varint,4,opt,name=CPUSecond,proto3" json:"CPUSecond,omitempty" yaml:"cpu_second"`
+}
+
+func (m *ConfigProfile) Reset()         { *m = ConfigProfile{} }
+func (m *ConfigProfile) String() string { return proto.CompactTextString(m) }
+func (*ConfigProfile) ProtoMessage()    {}
+func (*ConfigProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{6}
+}
+
 // ConfigClientMachineAgentControl represents control options on client machine.
 type ConfigClientMachineAgentControl struct {
-	DatabaseID                          string                               `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty" yaml:"database_id"`
-	DatabaseDescription                 string                               `protobuf:"bytes,2,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty" yaml:"database_description"`
-	DatabaseTag                         string                               `protobuf:"bytes,3,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty" yaml:"database_tag"`
-	PeerIPs                             []string                             `protobuf:"bytes,4,rep,name=PeerIPs" json:"PeerIPs,omitempty" yaml:"peer_ips"`
-	PeerIPsString                       string                               `protobuf:"bytes,5,opt,name=PeerIPsString,proto3" json:"PeerIPsString,omitempty" yaml:"peer_ips_string"`
-	AgentPortToConnect                  int64                                `protobuf:"varint,6,opt,name=AgentPortToConnect,proto3" json:"AgentPortToConnect,omitempty" yaml:"agent_port_to_connect"`
-	AgentEndpoints                      []string                             `protobuf:"bytes,7,rep,name=AgentEndpoints" json:"AgentEndpoints,omitempty" yaml:"agent_endpoints"`
-	DatabasePortToConnect               int64                                `protobuf:"varint,8,opt,name=DatabasePortToConnect,proto3" json:"DatabasePortToConnect,omitempty" yaml:"database_port_to_connect"`
-	DatabaseEndpoints                   []string                             `protobuf:"bytes,9,rep,name=DatabaseEndpoints" json:"DatabaseEndpoints,omitempty" yaml:"database_endpoints"`
+	DatabaseID            string   `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty" yaml:"database_id"`
+	DatabaseDescription   string   `protobuf:"bytes,2,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty" yaml:"database_description"`
+	DatabaseTag           string   `protobuf:"bytes,3,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty" yaml:"database_tag"`
+	PeerIPs               []string `protobuf:"bytes,4,rep,name=PeerIPs" json:"PeerIPs,omitempty" yaml:"peer_ips"`
+	PeerIPsString         string   `protobuf:"bytes,5,opt,name=PeerIPsString,proto3" json:"PeerIPsString,omitempty" yaml:"peer_ips_string"`
+	AgentPortToConnect    int64    `protobuf:"varint,6,opt,name=AgentPortToConnect,proto3" json:"AgentPortToConnect,omitempty" yaml:"agent_port_to_connect"`
+	AgentEndpoints        []string `protobuf:"bytes,7,rep,name=AgentEndpoints" json:"AgentEndpoints,omitempty" yaml:"agent_endpoints"`
+	DatabasePortToConnect int64    `protobuf:"varint,8,opt,name=DatabasePortToConnect,proto3" json:"DatabasePortToConnect,omitempty" yaml:"database_port_to_connect"`
+	DatabaseEndpoints     []string `protobuf:"bytes,9,rep,name=DatabaseEndpoints" json:"DatabaseEndpoints,omitempty" yaml:"database_endpoints"`
+	// ExtraFlags are appended to the database command line flags
+	// (e.g. '--heartbeat-interval=100'). For zetcd and cetcd, they are
+	// appended to the proxy flags. Flags managed by the agent are not allowed.
+	ExtraFlags []string `protobuf:"bytes,10,rep,name=ExtraFlags" json:"ExtraFlags,omitempty" yaml:"extra_flags"`
+	// ExtraJVMFlags are appended to the Java flags of Zookeeper (e.g. '-XX:+UseG1GC').
+	ExtraJVMFlags []string `protobuf:"bytes,11,rep,name=ExtraJVMFlags" json:"ExtraJVMFlags,omitempty" yaml:"extra_jvm_flags"`
+	// ExtraZooCfg are appended to the Zookeeper configuration file (e.g. 'preAllocSize=65536').
+	ExtraZooCfg []string `protobuf:"bytes,12,rep,name=ExtraZooCfg" json:"ExtraZooCfg,omitempty" yaml:"extra_zoo_cfg"`
+	// ConfigDatabaseBinary pins the database binary that agents run.
+	// If empty, agents run the binaries at their flag paths. For zetcd
+	// and cetcd, it pins the proxy, and the version and checksum of
+	// the etcd binary behind it are recorded along.
+	ConfigDatabaseBinary *ConfigDatabaseBinary `protobuf:"bytes,13,opt,name=ConfigDatabaseBinary" json:"ConfigDatabaseBinary,omitempty" yaml:"binary"`
+	// ConfigDatabaseContainer runs the database in a container.
+	// If empty, agents run the database directly on the host.
+	ConfigDatabaseContainer *ConfigDatabaseContainer `protobuf:"bytes,14,opt,name=ConfigDatabaseContainer" json:"ConfigDatabaseContainer,omitempty" yaml:"container"`
+	// ConfigDatabaseCgroup limits the resources of the database processes
+	// running on the host, with cgroup v2. It cannot be used with container.
+	ConfigDatabaseCgroup *ConfigDatabaseCgroup `protobuf:"bytes,15,opt,name=ConfigDatabaseCgroup" json:"ConfigDatabaseCgroup,omitempty" yaml:"cgroup"`
+	// GCLog enables the garbage collection logging of Zookeeper JVM.
+	// Agents upload the log and its pauses with the other logs.
+	GCLog bool `protobuf:"varint,16,opt,name=GCLog,proto3" json:"GCLog,omitempty" yaml:"gc_log"`
+	// ConfigProfile captures Go runtime profiles of etcd, Consul, zetcd and cetcd.
+	ConfigProfile                       *ConfigProfile                       `protobuf:"bytes,17,opt,name=ConfigProfile" json:"ConfigProfile,omitempty" yaml:"profile"`
 	Flag_Etcd_V2_3                      *Flag_Etcd_V2_3                      `protobuf:"bytes,100,opt,name=flag__etcd__v2_3,json=flagEtcdV23" json:"flag__etcd__v2_3,omitempty" yaml:"etcd__v2_3"`
 	Flag_Etcd_V3_1                      *Flag_Etcd_V3_1                      `protobuf:"bytes,101,opt,name=flag__etcd__v3_1,json=flagEtcdV31" json:"flag__etcd__v3_1,omitempty" yaml:"etcd__v3_1"`
 	Flag_Etcd_V3_2                      *Flag_Etcd_V3_2                      `protobuf:"bytes,102,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty" yaml:"etcd__v3_2"`
@@ -108,13 +219,17 @@ func (m *ConfigClientMachineAgentControl) Reset()         { *m = ConfigClientMac
 func (m *ConfigClientMachineAgentControl) String() string { return proto.CompactTextString(m) }
 func (*ConfigClientMachineAgentControl) ProtoMessage()    {}
 func (*ConfigClientMachineAgentControl) Descriptor() ([]byte, []int) {
-	return fileDescriptorConfigClientMachine, []int{3}
+	return fileDescriptorConfigClientMachine, []int{7}
 }
 
 func init() {
 	proto.RegisterType((*ConfigClientMachineInitial)(nil), "dbtesterpb.ConfigClientMachineInitial")
 	proto.RegisterType((*ConfigClientMachineBenchmarkOptions)(nil), "dbtesterpb.ConfigClientMachineBenchmarkOptions")
 	proto.RegisterType((*ConfigClientMachineBenchmarkSteps)(nil), "dbtesterpb.ConfigClientMachineBenchmarkSteps")
+	proto.RegisterType((*ConfigDatabaseBinary)(nil), "dbtesterpb.ConfigDatabaseBinary")
+	proto.RegisterType((*ConfigDatabaseContainer)(nil), "dbtesterpb.ConfigDatabaseContainer")
+	proto.RegisterType((*ConfigDatabaseCgroup)(nil), "dbtesterpb.ConfigDatabaseCgroup")
+	proto.RegisterType((*ConfigProfile)(nil), "dbtesterpb.ConfigProfile")
 	proto.RegisterType((*ConfigClientMachineAgentControl)(nil), "dbtesterpb.ConfigClientMachineAgentControl")
 }
 func (m *ConfigClientMachineInitial) Marshal() (dAtA []byte, err error) {
@@ -324,6 +439,26 @@ func (m *ConfigClientMachineBenchmarkOptions) MarshalTo(dAtA []byte) (int, error
 		}
 		i++
 	}
+	if len(m.LoadGeneratorEndpoints) > 0 {
+		for _, s := range m.LoadGeneratorEndpoints {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.SampleIntervalMillisecond != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.SampleIntervalMillisecond))
+	}
 	return i, nil
 }
 
@@ -385,6 +520,169 @@ func (m *ConfigClientMachineBenchmarkSteps) MarshalTo(dAtA []byte) (int, error)
 	return i, nil
 }
 
+func (m *ConfigDatabaseBinary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigDatabaseBinary) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.SHA256) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.SHA256)))
+		i += copy(dAtA[i:], m.SHA256)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	return i, nil
+}
+
+func (m *ConfigDatabaseContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigDatabaseContainer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Runtime) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Runtime)))
+		i += copy(dAtA[i:], m.Runtime)
+	}
+	if len(m.Image) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Image)))
+		i += copy(dAtA[i:], m.Image)
+	}
+	if m.CPUs != 0 {
+		dAtA[i] = 0x19
+		i++
+		i = encodeFixed64ConfigClientMachine(dAtA, i, uint64(math.Float64bits(float64(m.CPUs))))
+	}
+	if m.MemoryBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MemoryBytes))
+	}
+	return i, nil
+}
+
+func (m *ConfigDatabaseCgroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigDatabaseCgroup) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CPUs != 0 {
+		dAtA[i] = 0x9
+		i++
+		i = encodeFixed64ConfigClientMachine(dAtA, i, uint64(math.Float64bits(float64(m.CPUs))))
+	}
+	if m.MemoryBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MemoryBytes))
+	}
+	if len(m.IOMax) > 0 {
+		for _, s := range m.IOMax {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ConfigProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigProfile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.IntervalSecond != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.IntervalSecond))
+	}
+	if m.P99LatencyMs != 0 {
+		dAtA[i] = 0x11
+		i++
+		i = encodeFixed64ConfigClientMachine(dAtA, i, uint64(math.Float64bits(float64(m.P99LatencyMs))))
+	}
+	if m.CooldownSecond != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.CooldownSecond))
+	}
+	if m.CPUSecond != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.CPUSecond))
+	}
+	return i, nil
+}
+
 func (m *ConfigClientMachineAgentControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -479,17 +777,116 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.ExtraFlags) > 0 {
+		for _, s := range m.ExtraFlags {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ExtraJVMFlags) > 0 {
+		for _, s := range m.ExtraJVMFlags {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ExtraZooCfg) > 0 {
+		for _, s := range m.ExtraZooCfg {
+			dAtA[i] = 0x62
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ConfigDatabaseBinary != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigDatabaseBinary.Size()))
+		n3, err := m.ConfigDatabaseBinary.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.ConfigDatabaseContainer != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigDatabaseContainer.Size()))
+		n4, err := m.ConfigDatabaseContainer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.ConfigDatabaseCgroup != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigDatabaseCgroup.Size()))
+		n5, err := m.ConfigDatabaseCgroup.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.GCLog {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.GCLog {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ConfigProfile != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigProfile.Size()))
+		n6, err := m.ConfigProfile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
 	if m.Flag_Etcd_V2_3 != nil {
 		dAtA[i] = 0xa2
 		i++
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V2_3.Size()))
-		n3, err := m.Flag_Etcd_V2_3.MarshalTo(dAtA[i:])
+		n7, err := m.Flag_Etcd_V2_3.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n7
 	}
 	if m.Flag_Etcd_V3_1 != nil {
 		dAtA[i] = 0xaa
@@ -497,11 +894,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_1.Size()))
-		n4, err := m.Flag_Etcd_V3_1.MarshalTo(dAtA[i:])
+		n8, err := m.Flag_Etcd_V3_1.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n8
 	}
 	if m.Flag_Etcd_V3_2 != nil {
 		dAtA[i] = 0xb2
@@ -509,11 +906,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
-		n5, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
+		n9, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n9
 	}
 	if m.Flag_Etcd_Tip != nil {
 		dAtA[i] = 0xba
@@ -521,11 +918,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
-		n6, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
+		n10, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n10
 	}
 	if m.Flag_Zookeeper_R3_4_9 != nil {
 		dAtA[i] = 0xc2
@@ -533,11 +930,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zookeeper_R3_4_9.Size()))
-		n7, err := m.Flag_Zookeeper_R3_4_9.MarshalTo(dAtA[i:])
+		n11, err := m.Flag_Zookeeper_R3_4_9.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n11
 	}
 	if m.Flag_Zookeeper_R3_5_2Alpha != nil {
 		dAtA[i] = 0xca
@@ -545,11 +942,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_2Alpha.Size()))
-		n8, err := m.Flag_Zookeeper_R3_5_2Alpha.MarshalTo(dAtA[i:])
+		n12, err := m.Flag_Zookeeper_R3_5_2Alpha.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n12
 	}
 	if m.Flag_Zookeeper_R3_5_3Beta != nil {
 		dAtA[i] = 0xd2
@@ -557,11 +954,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_3Beta.Size()))
-		n9, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
+		n13, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n13
 	}
 	if m.Flag_Consul_V0_7_5 != nil {
 		dAtA[i] = 0xe2
@@ -569,11 +966,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Consul_V0_7_5.Size()))
-		n10, err := m.Flag_Consul_V0_7_5.MarshalTo(dAtA[i:])
+		n14, err := m.Flag_Consul_V0_7_5.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n14
 	}
 	if m.Flag_Consul_V0_8_0 != nil {
 		dAtA[i] = 0xea
@@ -581,11 +978,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Consul_V0_8_0.Size()))
-		n11, err := m.Flag_Consul_V0_8_0.MarshalTo(dAtA[i:])
+		n15, err := m.Flag_Consul_V0_8_0.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n15
 	}
 	if m.Flag_Consul_V0_8_4 != nil {
 		dAtA[i] = 0xf2
@@ -593,11 +990,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Consul_V0_8_4.Size()))
-		n12, err := m.Flag_Consul_V0_8_4.MarshalTo(dAtA[i:])
+		n16, err := m.Flag_Consul_V0_8_4.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n16
 	}
 	if m.Flag_Cetcd_Beta != nil {
 		dAtA[i] = 0x82
@@ -605,11 +1002,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x19
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Cetcd_Beta.Size()))
-		n13, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
+		n17, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n17
 	}
 	if m.Flag_Zetcd_Beta != nil {
 		dAtA[i] = 0xa2
@@ -617,11 +1014,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1f
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zetcd_Beta.Size()))
-		n14, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
+		n18, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n18
 	}
 	if m.ConfigClientMachineBenchmarkOptions != nil {
 		dAtA[i] = 0xc2
@@ -629,11 +1026,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3e
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkOptions.Size()))
-		n15, err := m.ConfigClientMachineBenchmarkOptions.MarshalTo(dAtA[i:])
+		n19, err := m.ConfigClientMachineBenchmarkOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n19
 	}
 	if m.ConfigClientMachineBenchmarkSteps != nil {
 		dAtA[i] = 0xca
@@ -641,11 +1038,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3e
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkSteps.Size()))
-		n16, err := m.ConfigClientMachineBenchmarkSteps.MarshalTo(dAtA[i:])
+		n20, err := m.ConfigClientMachineBenchmarkSteps.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n20
 	}
 	return i, nil
 }
@@ -781,6 +1178,15 @@ func (m *ConfigClientMachineBenchmarkOptions) Size() (n int) {
 	if m.StaleRead {
 		n += 2
 	}
+	if len(m.LoadGeneratorEndpoints) > 0 {
+		for _, s := range m.LoadGeneratorEndpoints {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if m.SampleIntervalMillisecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.SampleIntervalMillisecond))
+	}
 	return n
 }
 
@@ -802,27 +1208,105 @@ func (m *ConfigClientMachineBenchmarkSteps) Size() (n int) {
 	return n
 }
 
-func (m *ConfigClientMachineAgentControl) Size() (n int) {
+func (m *ConfigDatabaseBinary) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.DatabaseID)
+	l = len(m.Version)
 	if l > 0 {
 		n += 1 + l + sovConfigClientMachine(uint64(l))
 	}
-	l = len(m.DatabaseDescription)
+	l = len(m.Source)
 	if l > 0 {
 		n += 1 + l + sovConfigClientMachine(uint64(l))
 	}
-	l = len(m.DatabaseTag)
+	l = len(m.SHA256)
 	if l > 0 {
 		n += 1 + l + sovConfigClientMachine(uint64(l))
 	}
-	if len(m.PeerIPs) > 0 {
-		for _, s := range m.PeerIPs {
-			l = len(s)
-			n += 1 + l + sovConfigClientMachine(uint64(l))
-		}
-	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigDatabaseContainer) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.CPUs != 0 {
+		n += 9
+	}
+	if m.MemoryBytes != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MemoryBytes))
+	}
+	return n
+}
+
+func (m *ConfigDatabaseCgroup) Size() (n int) {
+	var l int
+	_ = l
+	if m.CPUs != 0 {
+		n += 9
+	}
+	if m.MemoryBytes != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MemoryBytes))
+	}
+	if len(m.IOMax) > 0 {
+		for _, s := range m.IOMax {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigProfile) Size() (n int) {
+	var l int
+	_ = l
+	if m.IntervalSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.IntervalSecond))
+	}
+	if m.P99LatencyMs != 0 {
+		n += 9
+	}
+	if m.CooldownSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.CooldownSecond))
+	}
+	if m.CPUSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.CPUSecond))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineAgentControl) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DatabaseID)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.DatabaseDescription)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.PeerIPs) > 0 {
+		for _, s := range m.PeerIPs {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
 	l = len(m.PeerIPsString)
 	if l > 0 {
 		n += 1 + l + sovConfigClientMachine(uint64(l))
@@ -845,6 +1329,43 @@ func (m *ConfigClientMachineAgentControl) Size() (n int) {
 			n += 1 + l + sovConfigClientMachine(uint64(l))
 		}
 	}
+	if len(m.ExtraFlags) > 0 {
+		for _, s := range m.ExtraFlags {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if len(m.ExtraJVMFlags) > 0 {
+		for _, s := range m.ExtraJVMFlags {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if len(m.ExtraZooCfg) > 0 {
+		for _, s := range m.ExtraZooCfg {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if m.ConfigDatabaseBinary != nil {
+		l = m.ConfigDatabaseBinary.Size()
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigDatabaseContainer != nil {
+		l = m.ConfigDatabaseContainer.Size()
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigDatabaseCgroup != nil {
+		l = m.ConfigDatabaseCgroup.Size()
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.GCLog {
+		n += 3
+	}
+	if m.ConfigProfile != nil {
+		l = m.ConfigProfile.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
 	if m.Flag_Etcd_V2_3 != nil {
 		l = m.Flag_Etcd_V2_3.Size()
 		n += 2 + l + sovConfigClientMachine(uint64(l))
@@ -1676,6 +2197,54 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.StaleRead = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadGeneratorEndpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LoadGeneratorEndpoints = append(m.LoadGeneratorEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleIntervalMillisecond", wireType)
+			}
+			m.SampleIntervalMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleIntervalMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
@@ -1827,7 +2396,7 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
+func (m *ConfigDatabaseBinary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1850,15 +2419,15 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineAgentControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigDatabaseBinary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineAgentControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigDatabaseBinary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1883,11 +2452,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseID = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseDescription", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1912,11 +2481,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseDescription = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SHA256", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1941,11 +2510,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			m.SHA256 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1970,11 +2539,61 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerIPs = append(m.PeerIPs, string(dAtA[iNdEx:postIndex]))
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigDatabaseContainer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigDatabaseContainer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigDatabaseContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1999,30 +2618,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
+			m.Runtime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentPortToConnect", wireType)
-			}
-			m.AgentPortToConnect = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConfigClientMachine
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AgentPortToConnect |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentEndpoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2047,32 +2647,31 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentEndpoints = append(m.AgentEndpoints, string(dAtA[iNdEx:postIndex]))
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabasePortToConnect", wireType)
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUs", wireType)
 			}
-			m.DatabasePortToConnect = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConfigClientMachine
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DatabasePortToConnect |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseEndpoints", wireType)
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.CPUs = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryBytes", wireType)
 			}
-			var stringLen uint64
+			m.MemoryBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2082,20 +2681,781 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MemoryBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseEndpoints = append(m.DatabaseEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigDatabaseCgroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigDatabaseCgroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigDatabaseCgroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.CPUs = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryBytes", wireType)
+			}
+			m.MemoryBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IOMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IOMax = append(m.IOMax, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSecond", wireType)
+			}
+			m.IntervalSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99LatencyMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.P99LatencyMs = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownSecond", wireType)
+			}
+			m.CooldownSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CooldownSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUSecond", wireType)
+			}
+			m.CPUSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineAgentControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineAgentControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerIPs = append(m.PeerIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPortToConnect", wireType)
+			}
+			m.AgentPortToConnect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentPortToConnect |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentEndpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentEndpoints = append(m.AgentEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabasePortToConnect", wireType)
+			}
+			m.DatabasePortToConnect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabasePortToConnect |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseEndpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseEndpoints = append(m.DatabaseEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraFlags = append(m.ExtraFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraJVMFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraJVMFlags = append(m.ExtraJVMFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraZooCfg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraZooCfg = append(m.ExtraZooCfg, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDatabaseBinary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigDatabaseBinary == nil {
+				m.ConfigDatabaseBinary = &ConfigDatabaseBinary{}
+			}
+			if err := m.ConfigDatabaseBinary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDatabaseContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigDatabaseContainer == nil {
+				m.ConfigDatabaseContainer = &ConfigDatabaseContainer{}
+			}
+			if err := m.ConfigDatabaseContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDatabaseCgroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigDatabaseCgroup == nil {
+				m.ConfigDatabaseCgroup = &ConfigDatabaseCgroup{}
+			}
+			if err := m.ConfigDatabaseCgroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCLog", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GCLog = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigProfile == nil {
+				m.ConfigProfile = &ConfigProfile{}
+			}
+			if err := m.ConfigProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
@@ -2690,119 +4050,154 @@ func init() {
 }
 
 var fileDescriptorConfigClientMachine = []byte{
-	// 1811 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0x6d, 0x6f, 0x1b, 0x49,
-	0x1d, 0x3f, 0x37, 0xed, 0x35, 0x99, 0xf4, 0x29, 0xd3, 0xa6, 0x71, 0xd3, 0x34, 0x93, 0x6e, 0x5a,
-	0x2e, 0xd5, 0xd1, 0x3c, 0xd8, 0xee, 0x5d, 0x8b, 0x40, 0x50, 0x27, 0x07, 0x54, 0xcd, 0xdd, 0x99,
-	0x75, 0xae, 0x88, 0x0a, 0x31, 0x8c, 0xd7, 0x93, 0xf5, 0x5e, 0xd6, 0x3b, 0xcb, 0xce, 0x38, 0xc2,
-	0xe1, 0x2d, 0x12, 0x02, 0x09, 0xe9, 0x5e, 0xde, 0x4b, 0x3e, 0x00, 0xf0, 0x31, 0x50, 0xe1, 0x15,
-	0x5f, 0x80, 0x15, 0xb4, 0x6f, 0xe0, 0xed, 0x8a, 0x0f, 0x70, 0x9a, 0xff, 0xac, 0xed, 0xb5, 0xbd,
-	0x76, 0xfc, 0xce, 0x3b, 0xff, 0xdf, 0xd3, 0xcc, 0xce, 0xd3, 0x1a, 0x7d, 0xab, 0xd9, 0x50, 0x5c,
-	0x2a, 0x1e, 0x85, 0x8d, 0x1d, 0x47, 0x04, 0xc7, 0x9e, 0x4b, 0x1d, 0xdf, 0xe3, 0x81, 0xa2, 0x6d,
-	0xe6, 0xb4, 0xbc, 0x80, 0x6f, 0x87, 0x91, 0x50, 0x02, 0xa3, 0x01, 0x6e, 0xf5, 0xb1, 0xeb, 0xa9,
-	0x56, 0xa7, 0xb1, 0xed, 0x88, 0xf6, 0x8e, 0x2b, 0x5c, 0xb1, 0x03, 0x90, 0x46, 0xe7, 0x18, 0x9e,
-	0xe0, 0x01, 0x7e, 0x19, 0xea, 0xea, 0x6a, 0xc6, 0xe2, 0xd8, 0x67, 0x2e, 0xe5, 0xca, 0x69, 0xa6,
-	0x35, 0x32, 0x5a, 0x3b, 0x13, 0xe2, 0x84, 0xf3, 0x90, 0x47, 0x29, 0x60, 0x6d, 0x14, 0xe0, 0x88,
-	0x40, 0x76, 0xfc, 0xb4, 0x7a, 0x77, 0x8c, 0x9e, 0xd1, 0x1e, 0x2b, 0x3a, 0x83, 0xa2, 0xf5, 0xee,
-	0x0a, 0x5a, 0xdd, 0x87, 0xfe, 0xee, 0x43, 0x77, 0x3f, 0x35, 0xbd, 0x7d, 0x11, 0x78, 0xca, 0x63,
-	0x3e, 0xfe, 0x08, 0xa1, 0x1a, 0x53, 0xad, 0x5a, 0xc4, 0x8f, 0xbd, 0x5f, 0x17, 0x0b, 0x1b, 0x85,
-	0xad, 0x85, 0xea, 0xed, 0x24, 0x26, 0xb8, 0xcb, 0xda, 0xfe, 0x77, 0xac, 0x90, 0xa9, 0x16, 0x0d,
-	0xa1, 0x68, 0xd9, 0x19, 0x24, 0x7e, 0x8c, 0x2e, 0x1f, 0x0a, 0x57, 0x37, 0x14, 0x2f, 0x00, 0xe9,
-	0x66, 0x12, 0x93, 0xeb, 0x86, 0xe4, 0x0b, 0x97, 0x6a, 0xa2, 0x65, 0xf7, 0x30, 0x98, 0xa2, 0x15,
-	0x63, 0x5f, 0xef, 0x4a, 0xc5, 0xdb, 0x9f, 0x72, 0x15, 0x79, 0x8e, 0x04, 0xfa, 0x1c, 0xd0, 0x1f,
-	0x26, 0x31, 0xb9, 0x6f, 0xe8, 0xe9, 0x6b, 0x91, 0x80, 0xa4, 0x6d, 0x03, 0x4d, 0x05, 0x27, 0xa9,
-	0xe0, 0xdf, 0x16, 0xd0, 0x66, 0x4e, 0xed, 0x45, 0xa0, 0x87, 0x45, 0xf8, 0x4c, 0xf1, 0x26, 0xb8,
-	0x5d, 0x04, 0xb7, 0x52, 0x12, 0x93, 0xed, 0x69, 0x6e, 0x5e, 0x86, 0x97, 0x5a, 0xcf, 0x22, 0x8f,
-	0xff, 0x50, 0x40, 0x0f, 0x0d, 0xee, 0x90, 0x29, 0x1e, 0x38, 0xdd, 0xa3, 0x56, 0x24, 0x3a, 0x6e,
-	0x2b, 0xec, 0xa8, 0x23, 0xaf, 0xcd, 0x25, 0x8f, 0x3c, 0x6e, 0xba, 0x7d, 0x09, 0x82, 0x54, 0x92,
-	0x98, 0xec, 0x0e, 0x05, 0xf1, 0x0d, 0x8f, 0xaa, 0x3e, 0x91, 0xaa, 0x3e, 0x33, 0x8d, 0x32, 0x9b,
-	0x05, 0xfe, 0x0d, 0xda, 0x18, 0x02, 0x1e, 0x78, 0x52, 0x45, 0x5e, 0xa3, 0xa3, 0x3c, 0x11, 0x3c,
-	0xf7, 0x7d, 0x88, 0xf1, 0x3e, 0xc4, 0xd8, 0x49, 0x62, 0xf2, 0x61, 0x6e, 0x8c, 0x66, 0x86, 0x43,
-	0x99, 0xef, 0xa7, 0x09, 0xce, 0x15, 0xc6, 0x5f, 0x15, 0xd0, 0x07, 0x13, 0x41, 0x35, 0x1e, 0x39,
-	0x3c, 0x50, 0x9e, 0xcf, 0x21, 0xc4, 0x65, 0x08, 0xf1, 0x51, 0x12, 0x93, 0xd2, 0xf9, 0x21, 0xc2,
-	0x3e, 0x37, 0xcd, 0x32, 0xab, 0x0d, 0xfe, 0x5d, 0x01, 0x3d, 0x98, 0x88, 0xad, 0x77, 0xda, 0x6d,
-	0x16, 0x75, 0x21, 0xcf, 0x3c, 0xe4, 0x29, 0x27, 0x31, 0xd9, 0x39, 0x3f, 0x8f, 0x34, 0xc4, 0x34,
-	0xcc, 0x4c, 0x06, 0x38, 0x44, 0x6b, 0x43, 0xb8, 0x6a, 0xf7, 0x25, 0xef, 0x7e, 0xd6, 0x69, 0x37,
-	0x78, 0x04, 0x01, 0x16, 0x20, 0xc0, 0xb7, 0x93, 0x98, 0x6c, 0xe5, 0x06, 0x68, 0x74, 0xe9, 0x09,
-	0xef, 0xd2, 0x00, 0x18, 0xa9, 0xf3, 0x54, 0x45, 0xdc, 0x45, 0xa4, 0xce, 0xa3, 0x53, 0x1e, 0x1d,
-	0x78, 0xf2, 0xa4, 0x1e, 0x32, 0x87, 0x7f, 0x21, 0x99, 0xcb, 0xb3, 0xbd, 0x46, 0xa3, 0x53, 0x41,
-	0x02, 0x41, 0xf7, 0xf6, 0x84, 0x4a, 0x4d, 0xa1, 0x1d, 0xcd, 0x19, 0xe9, 0xf1, 0x79, 0xba, 0xf8,
-	0xe7, 0xe8, 0xf6, 0x8f, 0x84, 0x70, 0x7d, 0xbe, 0xef, 0x8b, 0x4e, 0xb3, 0x16, 0x89, 0x2f, 0xb9,
-	0xa3, 0x3e, 0x63, 0x6d, 0x5e, 0x6c, 0x82, 0xe3, 0x83, 0x24, 0x26, 0x1b, 0xc6, 0xd1, 0x05, 0x1c,
-	0x75, 0x34, 0x90, 0x86, 0x06, 0x49, 0x03, 0xd6, 0xe6, 0x96, 0x3d, 0x41, 0x03, 0x1f, 0xa3, 0x3b,
-	0x99, 0x4a, 0x5d, 0x89, 0x88, 0xb9, 0xfc, 0x25, 0x37, 0x5d, 0xe2, 0x60, 0xb0, 0x95, 0xc4, 0xe4,
-	0x41, 0x8e, 0x81, 0x34, 0x60, 0x18, 0x4a, 0xd3, 0x97, 0xc9, 0x52, 0xb8, 0x82, 0x96, 0x73, 0x8b,
-	0xc5, 0x63, 0xed, 0x61, 0xe7, 0x17, 0xb1, 0x40, 0x6b, 0xe3, 0x85, 0x6a, 0xc7, 0x39, 0xe1, 0x66,
-	0x04, 0x5c, 0x08, 0xf8, 0x61, 0x12, 0x93, 0x0f, 0xa6, 0x04, 0x6c, 0x00, 0x21, 0x1d, 0x88, 0xa9,
-	0x82, 0xb8, 0x83, 0xd6, 0xc7, 0xeb, 0xf5, 0x4e, 0xe3, 0xc0, 0x8b, 0xb8, 0xa3, 0x44, 0xd4, 0x2d,
-	0xb6, 0xc0, 0xf2, 0x71, 0x12, 0x93, 0x47, 0x53, 0x2c, 0x65, 0xa7, 0x41, 0x9b, 0x3d, 0x8e, 0x65,
-	0x9f, 0x23, 0x6a, 0xfd, 0xed, 0x12, 0xda, 0xcc, 0x39, 0x65, 0xaa, 0x3c, 0x70, 0x5a, 0x6d, 0x16,
-	0x9d, 0x7c, 0x1e, 0xea, 0x25, 0x20, 0xf1, 0x26, 0xba, 0x78, 0xd4, 0x0d, 0x79, 0x7a, 0xd0, 0x5c,
-	0x4f, 0x62, 0xb2, 0x68, 0x42, 0xa8, 0x6e, 0xc8, 0x2d, 0x1b, 0x8a, 0xf8, 0xfb, 0xe8, 0xaa, 0xcd,
-	0x7f, 0xd5, 0xe1, 0x52, 0x99, 0x09, 0x0c, 0x27, 0xcc, 0x5c, 0xf5, 0x4e, 0x12, 0x93, 0x65, 0x83,
-	0x8e, 0x4c, 0x39, 0x5d, 0x00, 0x96, 0x3d, 0x8c, 0xc7, 0x3f, 0x46, 0x37, 0xf6, 0x45, 0x10, 0x70,
-	0x47, 0x9b, 0xa6, 0x1a, 0x73, 0xa0, 0xb1, 0x96, 0xc4, 0xa4, 0x98, 0x2e, 0xa9, 0x3e, 0xa2, 0x2f,
-	0x33, 0xc6, 0xc2, 0xdf, 0x45, 0x57, 0x4c, 0x87, 0x52, 0x95, 0x8b, 0xa0, 0x52, 0x4c, 0x62, 0x72,
-	0x6b, 0x68, 0x61, 0xf6, 0x14, 0x86, 0xd0, 0xf8, 0x17, 0x68, 0x65, 0xa0, 0x98, 0xad, 0xc8, 0xe2,
-	0xa5, 0x8d, 0xb9, 0xad, 0xb9, 0xec, 0xd4, 0xcf, 0xc4, 0x19, 0xd2, 0x94, 0xfa, 0xd0, 0xcb, 0x17,
-	0xc1, 0x1e, 0x5a, 0xb5, 0x99, 0xe2, 0x87, 0x5e, 0xdb, 0x53, 0xe9, 0x08, 0xc8, 0x1a, 0x8f, 0xea,
-	0xdc, 0x11, 0x41, 0x13, 0xb6, 0xf6, 0xb9, 0xea, 0xa3, 0x24, 0x26, 0x0f, 0xd3, 0x51, 0x63, 0x8a,
-	0x53, 0x5f, 0x83, 0x69, 0x3a, 0x80, 0x52, 0xef, 0xa6, 0x54, 0x02, 0xde, 0xb2, 0xa7, 0x88, 0xe9,
-	0xf3, 0xbe, 0xce, 0xda, 0x30, 0xe1, 0xf5, 0x6e, 0x3d, 0x9f, 0x3d, 0xef, 0x25, 0x6b, 0xc3, 0x22,
-	0xb2, 0xec, 0x1e, 0x06, 0x7f, 0x0f, 0x5d, 0x79, 0xc9, 0xbb, 0x75, 0xef, 0x8c, 0x57, 0xbb, 0x8a,
-	0x4b, 0xd8, 0x51, 0x87, 0xde, 0xa0, 0x5e, 0x73, 0xd2, 0x3b, 0xe3, 0xb4, 0xa1, 0xeb, 0x96, 0x3d,
-	0x04, 0xc7, 0xfb, 0xe8, 0xda, 0x2b, 0xe6, 0x77, 0xf8, 0x40, 0x60, 0x01, 0x04, 0xee, 0x26, 0x31,
-	0x59, 0x31, 0x02, 0xa7, 0xba, 0x3e, 0x24, 0x31, 0x42, 0xc1, 0x65, 0xb4, 0x50, 0x57, 0xcc, 0xe7,
-	0x36, 0x67, 0x4d, 0xd8, 0xdc, 0xe6, 0xab, 0xcb, 0x49, 0x4c, 0x96, 0xd2, 0xd0, 0xba, 0x44, 0x23,
-	0xce, 0x9a, 0x96, 0x3d, 0xc0, 0x59, 0xf1, 0x05, 0x74, 0x7f, 0xda, 0x44, 0xae, 0x2b, 0x1e, 0x4a,
-	0xfc, 0x39, 0xc2, 0xfa, 0xc7, 0x5e, 0x5d, 0xb1, 0x48, 0x1d, 0x30, 0xc5, 0x1a, 0x4c, 0x9a, 0x49,
-	0x3d, 0x5f, 0x25, 0x49, 0x4c, 0xee, 0xf6, 0x3c, 0x78, 0xb8, 0x47, 0xa5, 0x06, 0xd1, 0x66, 0x8a,
-	0xb2, 0xec, 0x1c, 0x2a, 0xb6, 0xd1, 0x4d, 0xdd, 0x5a, 0xaa, 0xab, 0x88, 0x4b, 0xd9, 0x57, 0xbc,
-	0x00, 0x8a, 0x1b, 0x49, 0x4c, 0xd6, 0x06, 0x8a, 0x25, 0x2a, 0x01, 0x95, 0x91, 0xcc, 0x23, 0xe3,
-	0x43, 0xb4, 0xa4, 0x9b, 0xcb, 0x75, 0x25, 0xc2, 0xbe, 0xe2, 0x1c, 0x28, 0xae, 0x27, 0x31, 0x59,
-	0x1d, 0x28, 0x96, 0xf5, 0xb2, 0x0f, 0x33, 0x7a, 0xe3, 0x44, 0xfc, 0x43, 0x74, 0x5d, 0x37, 0x56,
-	0xbe, 0x08, 0x7d, 0xc1, 0x9a, 0x87, 0xc2, 0x95, 0xb0, 0x18, 0xe6, 0xb3, 0x4b, 0x4a, 0x6b, 0x55,
-	0x68, 0x07, 0x10, 0xd4, 0x17, 0xae, 0xb4, 0xec, 0x51, 0x92, 0xf5, 0xaf, 0x25, 0x44, 0x72, 0x06,
-	0xf8, 0xb9, 0xcb, 0x03, 0xb5, 0x2f, 0x02, 0x15, 0x09, 0xb8, 0x94, 0xf6, 0x7c, 0x5f, 0x1c, 0x8c,
-	0x5f, 0x4a, 0x7b, 0x39, 0xa9, 0xd7, 0xb4, 0xec, 0x0c, 0x12, 0xff, 0x04, 0xdd, 0xec, 0x3d, 0x1d,
-	0x70, 0xe9, 0x44, 0x1e, 0xec, 0x3a, 0xe9, 0x05, 0x35, 0xf3, 0x5e, 0xfa, 0x02, 0xcd, 0x01, 0xca,
-	0xb2, 0xf3, 0xb8, 0xf8, 0x19, 0x5a, 0xec, 0x35, 0x1f, 0x31, 0x37, 0xbd, 0xac, 0xae, 0x24, 0x31,
-	0xb9, 0x39, 0x22, 0xa5, 0x98, 0x6b, 0xd9, 0x59, 0xac, 0x5e, 0x32, 0x35, 0xce, 0xa3, 0x17, 0x35,
-	0x3d, 0x52, 0x73, 0xc3, 0x57, 0xe4, 0x90, 0xf3, 0x88, 0x7a, 0xa1, 0xb4, 0xec, 0x1e, 0x06, 0xff,
-	0x00, 0x5d, 0x4d, 0x7f, 0xd6, 0x55, 0xe4, 0x05, 0x6e, 0x7a, 0x43, 0x5c, 0x4d, 0x62, 0x72, 0x7b,
-	0x98, 0xa4, 0xdf, 0xbf, 0x17, 0xb8, 0x96, 0x3d, 0x4c, 0xc0, 0x35, 0x84, 0x61, 0x18, 0x6b, 0x22,
-	0x52, 0x47, 0x22, 0xdd, 0x34, 0xd2, 0x6d, 0x20, 0x33, 0x87, 0x98, 0xc6, 0xd0, 0x50, 0x44, 0x8a,
-	0x2a, 0x41, 0xd3, 0x7d, 0xc7, 0xb2, 0x73, 0xb8, 0xb8, 0x8a, 0xae, 0x41, 0xeb, 0x27, 0x41, 0x33,
-	0x14, 0x5e, 0xa0, 0x64, 0xf1, 0x32, 0xf4, 0x24, 0x13, 0xca, 0xa8, 0xf1, 0x1e, 0xc0, 0xb2, 0x47,
-	0x18, 0xf8, 0x67, 0x68, 0xb9, 0x37, 0x2a, 0xc3, 0xc1, 0xcc, 0x9e, 0xb0, 0x99, 0xc4, 0x84, 0x8c,
-	0x8c, 0xe5, 0x58, 0xb6, 0x7c, 0x05, 0xfc, 0x12, 0x2d, 0xf5, 0x0a, 0x83, 0x84, 0x0b, 0x90, 0xf0,
-	0x5e, 0x12, 0x93, 0x3b, 0x23, 0xb2, 0x99, 0x90, 0xe3, 0x3c, 0xfc, 0x1a, 0xdd, 0x80, 0x8f, 0x27,
-	0xf8, 0x6a, 0xa3, 0xf4, 0xb4, 0x44, 0xcb, 0x70, 0x41, 0x59, 0x2c, 0xad, 0x6d, 0x0f, 0x3e, 0xb0,
-	0xb6, 0x47, 0x31, 0xd9, 0x3d, 0x65, 0xd0, 0x6a, 0xd9, 0x8b, 0x1a, 0xf8, 0x89, 0x72, 0x9a, 0xaf,
-	0x4a, 0xe5, 0x31, 0xed, 0x32, 0xdd, 0x83, 0xbb, 0xc9, 0x34, 0xed, 0x32, 0xdd, 0xcb, 0xd1, 0x2e,
-	0xd3, 0xbd, 0xac, 0x76, 0x79, 0x2f, 0x47, 0xbb, 0x04, 0x77, 0x92, 0xe9, 0xda, 0xa5, 0x5c, 0xed,
-	0xd2, 0x90, 0x76, 0x09, 0xff, 0x14, 0x5d, 0xcf, 0xf2, 0x94, 0x17, 0xc2, 0x8d, 0x65, 0xb1, 0x74,
-	0x77, 0x92, 0xb4, 0xf2, 0xc2, 0xea, 0xad, 0x24, 0x26, 0x37, 0xb2, 0xca, 0xca, 0x0b, 0x33, 0xc2,
-	0x47, 0x5e, 0x88, 0x4f, 0xd1, 0x8a, 0x61, 0xf5, 0x3f, 0x83, 0x29, 0x8d, 0xca, 0xb4, 0x42, 0x9f,
-	0x15, 0xdf, 0x14, 0xc0, 0x61, 0x73, 0xdc, 0x61, 0x0c, 0x9b, 0xdd, 0x7b, 0xc6, 0x8a, 0x96, 0xbd,
-	0xa4, 0x69, 0xaf, 0x7b, 0xed, 0x76, 0xb9, 0xf2, 0x0c, 0xff, 0xb1, 0x80, 0xee, 0xe5, 0x89, 0x3d,
-	0xa1, 0x25, 0xca, 0xfc, 0xb0, 0xc5, 0x8a, 0x7f, 0x37, 0xf6, 0x8f, 0xce, 0xb3, 0xef, 0x33, 0xaa,
-	0x56, 0x12, 0x93, 0xf5, 0xbc, 0x10, 0x7d, 0x88, 0x65, 0xdf, 0x1e, 0x89, 0xf2, 0xa4, 0xf4, 0x5c,
-	0x17, 0xf0, 0xef, 0x0b, 0x68, 0x2d, 0x5f, 0xbd, 0x4c, 0x1b, 0x5c, 0xb1, 0xe2, 0x3f, 0x4c, 0x9c,
-	0xad, 0xf3, 0xe3, 0x18, 0x42, 0xf5, 0x7e, 0x12, 0x93, 0x7b, 0xf9, 0x69, 0x0c, 0xc2, 0xb2, 0x97,
-	0x47, 0xc3, 0x94, 0xab, 0x5c, 0x31, 0xfc, 0x25, 0xba, 0x65, 0x94, 0xcd, 0x3f, 0x0f, 0x94, 0x9e,
-	0xee, 0xd2, 0x8f, 0xe9, 0x93, 0xe2, 0x9f, 0x2f, 0x40, 0x84, 0x8d, 0xf1, 0x08, 0xc3, 0xc0, 0xec,
-	0xf1, 0x3e, 0x5c, 0xb1, 0xec, 0x6b, 0x9a, 0xb0, 0x0f, 0x8d, 0xaf, 0x76, 0x3f, 0x7e, 0x92, 0xeb,
-	0xf5, 0x94, 0xee, 0x16, 0xff, 0x32, 0x8b, 0xd7, 0x53, 0xba, 0x3b, 0xc1, 0xeb, 0x29, 0xdd, 0x1d,
-	0xf1, 0x7a, 0xba, 0x3b, 0xc1, 0xab, 0x52, 0xfc, 0xeb, 0x6c, 0x5e, 0x95, 0x89, 0x5e, 0x95, 0x51,
-	0xaf, 0x0a, 0xfe, 0x25, 0x5a, 0x4a, 0x25, 0xcc, 0xcc, 0x87, 0x77, 0xf8, 0xd5, 0x1c, 0x18, 0xdd,
-	0xcb, 0x31, 0x1a, 0xa0, 0xb2, 0x07, 0x5c, 0xa6, 0xd9, 0xb2, 0xaf, 0x82, 0x85, 0x6e, 0x81, 0xb7,
-	0xd4, 0x77, 0x38, 0xcb, 0x38, 0xfc, 0x7f, 0xa2, 0xc3, 0x59, 0xbe, 0xc3, 0xd9, 0x98, 0xc3, 0xeb,
-	0xbe, 0xc3, 0x9f, 0x0a, 0x33, 0xdd, 0xe5, 0x8b, 0xff, 0xbd, 0x0c, 0xa6, 0x3b, 0x59, 0xd3, 0x19,
-	0x78, 0xd9, 0x45, 0xdb, 0xe8, 0xd5, 0xa8, 0x30, 0x45, 0xcb, 0x9e, 0xe9, 0x33, 0xe2, 0xeb, 0xc2,
-	0x0c, 0xb7, 0xb4, 0xe2, 0xff, 0x4c, 0xc0, 0xc7, 0xb3, 0x06, 0x04, 0x56, 0xf6, 0x6c, 0x1b, 0xc4,
-	0xd3, 0x37, 0x1b, 0x69, 0xd9, 0xe7, 0x9b, 0x56, 0x6f, 0xbd, 0xf9, 0xcf, 0xfa, 0x7b, 0x6f, 0xde,
-	0xae, 0x17, 0xfe, 0xf9, 0x76, 0xbd, 0xf0, 0xef, 0xb7, 0xeb, 0x85, 0xaf, 0xdf, 0xad, 0xbf, 0xd7,
-	0x78, 0x1f, 0xfe, 0x8c, 0x2b, 0x7f, 0x13, 0x00, 0x00, 0xff, 0xff, 0x5e, 0xbd, 0x27, 0x38, 0x86,
-	0x14, 0x00, 0x00,
+	// 2381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0xcf, 0x73, 0x1b, 0x49,
+	0x15, 0x5e, 0x59, 0x9b, 0xb5, 0xdd, 0xb6, 0x93, 0xb8, 0x93, 0xd8, 0x13, 0xc7, 0xf1, 0x78, 0x3b,
+	0xbb, 0x1b, 0xa7, 0x76, 0x13, 0xdb, 0x92, 0x9d, 0x8d, 0xb7, 0xa0, 0x20, 0x92, 0xf7, 0x87, 0x89,
+	0xbd, 0x2b, 0x46, 0x4e, 0x28, 0x02, 0x45, 0xd3, 0x1a, 0xb5, 0xc7, 0x13, 0x8f, 0xa6, 0x87, 0x99,
+	0x96, 0x89, 0xcc, 0x95, 0x2a, 0x6a, 0xa1, 0xa8, 0xda, 0xe3, 0xde, 0xe0, 0x0f, 0x00, 0xfe, 0x05,
+	0xae, 0x81, 0x0b, 0x1c, 0x39, 0x4d, 0x41, 0xf6, 0x02, 0xd7, 0x29, 0xfe, 0x00, 0xaa, 0xbb, 0x67,
+	0xa4, 0x96, 0x34, 0xb2, 0xcc, 0xcd, 0xea, 0xf7, 0x7d, 0xdf, 0xfb, 0xba, 0xa7, 0xfb, 0xf5, 0x9b,
+	0x31, 0x78, 0xaf, 0xd9, 0xe0, 0x34, 0xe2, 0x34, 0x0c, 0x1a, 0xeb, 0x36, 0xf3, 0x8f, 0x5c, 0x07,
+	0xdb, 0x9e, 0x4b, 0x7d, 0x8e, 0x5b, 0xc4, 0x3e, 0x76, 0x7d, 0xfa, 0x20, 0x08, 0x19, 0x67, 0x10,
+	0xf4, 0x70, 0x4b, 0xf7, 0x1d, 0x97, 0x1f, 0xb7, 0x1b, 0x0f, 0x6c, 0xd6, 0x5a, 0x77, 0x98, 0xc3,
+	0xd6, 0x25, 0xa4, 0xd1, 0x3e, 0x92, 0xbf, 0xe4, 0x0f, 0xf9, 0x97, 0xa2, 0x2e, 0x2d, 0x69, 0x29,
+	0x8e, 0x3c, 0xe2, 0x60, 0xca, 0xed, 0x66, 0x1a, 0x33, 0x07, 0x63, 0x67, 0x8c, 0x9d, 0x50, 0x1a,
+	0xd0, 0x30, 0x05, 0x2c, 0x0f, 0x02, 0x6c, 0xe6, 0x47, 0x6d, 0x2f, 0x8d, 0xde, 0x1a, 0xa2, 0x6b,
+	0xda, 0x43, 0x41, 0xbb, 0x17, 0x44, 0xdf, 0xcc, 0x82, 0xa5, 0xaa, 0x9c, 0x6f, 0x55, 0x4e, 0xf7,
+	0x40, 0xcd, 0x76, 0xcf, 0x77, 0xb9, 0x4b, 0x3c, 0xf8, 0x10, 0x80, 0x1a, 0xe1, 0xc7, 0xb5, 0x90,
+	0x1e, 0xb9, 0x2f, 0x8d, 0xc2, 0x6a, 0x61, 0x6d, 0xba, 0xb2, 0x90, 0xc4, 0x26, 0xec, 0x90, 0x96,
+	0xf7, 0x11, 0x0a, 0x08, 0x3f, 0xc6, 0x81, 0x0c, 0x22, 0x4b, 0x43, 0xc2, 0xfb, 0x60, 0x72, 0x9f,
+	0x39, 0x62, 0xc0, 0x98, 0x90, 0xa4, 0x6b, 0x49, 0x6c, 0x5e, 0x51, 0x24, 0x8f, 0x39, 0x58, 0x10,
+	0x91, 0x95, 0x61, 0x20, 0x06, 0x8b, 0x2a, 0x7d, 0xbd, 0x13, 0x71, 0xda, 0x3a, 0xa0, 0x3c, 0x74,
+	0xed, 0x48, 0xd2, 0x8b, 0x92, 0xfe, 0x6e, 0x12, 0x9b, 0x6f, 0x2b, 0x7a, 0xfa, 0x58, 0x22, 0x89,
+	0xc4, 0x2d, 0x05, 0x4d, 0x05, 0x47, 0xa9, 0xc0, 0x5f, 0x16, 0xc0, 0x9d, 0x9c, 0xd8, 0x9e, 0x2f,
+	0x96, 0x85, 0x79, 0x84, 0xd3, 0xa6, 0xcc, 0xf6, 0xa6, 0xcc, 0x56, 0x4a, 0x62, 0xf3, 0xc1, 0x79,
+	0xd9, 0x5c, 0x8d, 0x97, 0xa6, 0xbe, 0x88, 0x3c, 0xfc, 0x75, 0x01, 0xbc, 0xab, 0x70, 0xfb, 0x84,
+	0x53, 0xdf, 0xee, 0x1c, 0x1e, 0x87, 0xac, 0xed, 0x1c, 0x07, 0x6d, 0x7e, 0xe8, 0xb6, 0x68, 0x44,
+	0x43, 0x97, 0xaa, 0x69, 0x5f, 0x92, 0x46, 0xb6, 0x92, 0xd8, 0xdc, 0xe8, 0x33, 0xe2, 0x29, 0x1e,
+	0xe6, 0x5d, 0x22, 0xe6, 0x5d, 0x66, 0x6a, 0xe5, 0x62, 0x29, 0xe0, 0x2f, 0xc0, 0x6a, 0x1f, 0x70,
+	0xd7, 0x8d, 0x78, 0xe8, 0x36, 0xda, 0xdc, 0x65, 0xfe, 0x63, 0xcf, 0x93, 0x36, 0xde, 0x92, 0x36,
+	0xd6, 0x93, 0xd8, 0x7c, 0x3f, 0xd7, 0x46, 0x53, 0xe3, 0x60, 0xe2, 0x79, 0xa9, 0x83, 0xb1, 0xc2,
+	0xf0, 0xab, 0x02, 0xb8, 0x3b, 0x12, 0x54, 0xa3, 0xa1, 0x4d, 0x7d, 0xee, 0x7a, 0x54, 0x9a, 0x98,
+	0x94, 0x26, 0x1e, 0x26, 0xb1, 0x59, 0x1a, 0x6f, 0x22, 0xe8, 0x72, 0x53, 0x2f, 0x17, 0x4d, 0x03,
+	0x7f, 0x55, 0x00, 0xef, 0x8c, 0xc4, 0xd6, 0xdb, 0xad, 0x16, 0x09, 0x3b, 0xd2, 0xcf, 0x94, 0xf4,
+	0x53, 0x4e, 0x62, 0x73, 0x7d, 0xbc, 0x9f, 0x48, 0x11, 0x53, 0x33, 0x17, 0x4a, 0x00, 0x03, 0xb0,
+	0xdc, 0x87, 0xab, 0x74, 0x9e, 0xd0, 0xce, 0xe7, 0xed, 0x56, 0x83, 0x86, 0xd2, 0xc0, 0xb4, 0x34,
+	0xf0, 0x41, 0x12, 0x9b, 0x6b, 0xb9, 0x06, 0x1a, 0x1d, 0x7c, 0x42, 0x3b, 0xd8, 0x97, 0x8c, 0x34,
+	0xf3, 0xb9, 0x8a, 0xb0, 0x03, 0xcc, 0x3a, 0x0d, 0x4f, 0x69, 0xb8, 0xeb, 0x46, 0x27, 0xf5, 0x80,
+	0xd8, 0xf4, 0x69, 0x44, 0x1c, 0xaa, 0xcf, 0x1a, 0x0c, 0x6e, 0x85, 0x48, 0x12, 0xc4, 0x6c, 0x4f,
+	0x70, 0x24, 0x28, 0xb8, 0x2d, 0x38, 0x03, 0x33, 0x1e, 0xa7, 0x0b, 0x7f, 0x0c, 0x16, 0x3e, 0x65,
+	0xcc, 0xf1, 0x68, 0xd5, 0x63, 0xed, 0x66, 0x2d, 0x64, 0x2f, 0xa8, 0xcd, 0x3f, 0x27, 0x2d, 0x6a,
+	0x34, 0x65, 0xc6, 0x77, 0x92, 0xd8, 0x5c, 0x55, 0x19, 0x1d, 0x89, 0xc3, 0xb6, 0x00, 0xe2, 0x40,
+	0x21, 0xb1, 0x4f, 0x5a, 0x14, 0x59, 0x23, 0x34, 0xe0, 0x11, 0xb8, 0xa9, 0x45, 0xea, 0x9c, 0x85,
+	0xc4, 0xa1, 0x4f, 0xa8, 0x9a, 0x12, 0x95, 0x09, 0xd6, 0x92, 0xd8, 0x7c, 0x27, 0x27, 0x41, 0xa4,
+	0xc0, 0x72, 0x29, 0xd5, 0x5c, 0x46, 0x4b, 0xc1, 0x2d, 0x70, 0x23, 0x37, 0x68, 0x1c, 0x89, 0x1c,
+	0x56, 0x7e, 0x10, 0x32, 0xb0, 0x3c, 0x1c, 0xa8, 0xb4, 0xed, 0x13, 0xaa, 0x56, 0xc0, 0x91, 0x06,
+	0xdf, 0x4f, 0x62, 0xf3, 0xee, 0x39, 0x06, 0x1b, 0x92, 0x90, 0x2e, 0xc4, 0xb9, 0x82, 0xb0, 0x0d,
+	0x56, 0x86, 0xe3, 0xf5, 0x76, 0x63, 0xd7, 0x0d, 0xa9, 0xcd, 0x59, 0xd8, 0x31, 0x8e, 0x65, 0xca,
+	0xfb, 0x49, 0x6c, 0xde, 0x3b, 0x27, 0x65, 0xd4, 0x6e, 0xe0, 0x66, 0xc6, 0x41, 0xd6, 0x18, 0x51,
+	0xf4, 0xe5, 0x24, 0xb8, 0x93, 0x73, 0xcb, 0x54, 0xa8, 0x6f, 0x1f, 0xb7, 0x48, 0x78, 0xf2, 0x45,
+	0x20, 0x8e, 0x40, 0x04, 0xef, 0x80, 0x37, 0x0f, 0x3b, 0x01, 0x4d, 0x2f, 0x9a, 0x2b, 0x49, 0x6c,
+	0xce, 0x28, 0x13, 0xbc, 0x13, 0x50, 0x64, 0xc9, 0x20, 0xfc, 0x0e, 0x98, 0xb3, 0xe8, 0xcf, 0xda,
+	0x34, 0xe2, 0x6a, 0x03, 0xcb, 0x1b, 0xa6, 0x58, 0xb9, 0x99, 0xc4, 0xe6, 0x0d, 0x85, 0x0e, 0x55,
+	0x38, 0x3d, 0x00, 0xc8, 0xea, 0xc7, 0xc3, 0xcf, 0xc0, 0xd5, 0x2a, 0xf3, 0x7d, 0x6a, 0x8b, 0xa4,
+	0xa9, 0x46, 0x51, 0x6a, 0x2c, 0x27, 0xb1, 0x69, 0xa4, 0x47, 0xaa, 0x8b, 0xe8, 0xca, 0x0c, 0xb1,
+	0xe0, 0xb7, 0xc0, 0xac, 0x9a, 0x50, 0xaa, 0xf2, 0xa6, 0x54, 0x31, 0x92, 0xd8, 0xbc, 0xde, 0x77,
+	0x30, 0x33, 0x85, 0x3e, 0x34, 0xfc, 0x09, 0x58, 0xec, 0x29, 0xea, 0x91, 0xc8, 0xb8, 0xb4, 0x5a,
+	0x5c, 0x2b, 0xea, 0x5b, 0x5f, 0xb3, 0xd3, 0xa7, 0x19, 0x89, 0x4b, 0x2f, 0x5f, 0x04, 0xba, 0x60,
+	0xc9, 0x22, 0x9c, 0xee, 0xbb, 0x2d, 0x97, 0xa7, 0x2b, 0x10, 0xd5, 0x68, 0x58, 0xa7, 0x36, 0xf3,
+	0x9b, 0xb2, 0xb4, 0x17, 0x2b, 0xf7, 0x92, 0xd8, 0x7c, 0x37, 0x5d, 0x35, 0xc2, 0x29, 0xf6, 0x04,
+	0x18, 0xa7, 0x0b, 0x18, 0x89, 0x6a, 0x8a, 0x23, 0x89, 0x47, 0xd6, 0x39, 0x62, 0xe2, 0xbe, 0xaf,
+	0x93, 0x96, 0xdc, 0xf0, 0xa2, 0x5a, 0x4f, 0xe9, 0xf7, 0x7d, 0x44, 0x5a, 0xf2, 0x10, 0x21, 0x2b,
+	0xc3, 0xc0, 0x6f, 0x83, 0xd9, 0x27, 0xb4, 0x53, 0x77, 0xcf, 0x68, 0xa5, 0xc3, 0x69, 0x64, 0x4c,
+	0x0d, 0x3e, 0x41, 0x71, 0xe6, 0x22, 0xf7, 0x8c, 0xe2, 0x86, 0x88, 0x23, 0xab, 0x0f, 0x0e, 0xab,
+	0xe0, 0xf2, 0x33, 0xe2, 0xb5, 0x69, 0x4f, 0x60, 0x5a, 0x0a, 0xdc, 0x4a, 0x62, 0x73, 0x51, 0x09,
+	0x9c, 0x8a, 0x78, 0x9f, 0xc4, 0x00, 0x05, 0x96, 0xc1, 0x74, 0x9d, 0x13, 0x8f, 0x5a, 0x94, 0x34,
+	0x65, 0x71, 0x9b, 0xaa, 0xdc, 0x48, 0x62, 0x73, 0x3e, 0x35, 0x2d, 0x42, 0x38, 0xa4, 0xa4, 0x89,
+	0xac, 0x1e, 0x0e, 0xfe, 0x08, 0x2c, 0xec, 0x33, 0xd2, 0xfc, 0x94, 0xfa, 0x34, 0x24, 0x9c, 0x85,
+	0x1f, 0xfb, 0xcd, 0x80, 0xb9, 0x3e, 0x8f, 0x8c, 0x99, 0xd5, 0xe2, 0xda, 0x74, 0xe5, 0x4e, 0x12,
+	0x9b, 0x66, 0xd6, 0xe6, 0x90, 0x26, 0x76, 0x32, 0x20, 0xa6, 0x19, 0x12, 0x59, 0x23, 0x24, 0x60,
+	0x13, 0xdc, 0xac, 0x93, 0x56, 0xe0, 0x51, 0xd9, 0x37, 0x9c, 0x12, 0xef, 0xc0, 0xf5, 0x3c, 0x57,
+	0x2d, 0xbf, 0x31, 0x2b, 0x67, 0xf8, 0x5e, 0x12, 0x9b, 0xa8, 0xbb, 0xac, 0x81, 0x47, 0x55, 0x2b,
+	0x72, 0x4a, 0x3c, 0xdc, 0xea, 0x81, 0x91, 0x35, 0x5a, 0x08, 0xc5, 0x13, 0xe0, 0xed, 0xf3, 0xce,
+	0x62, 0x9d, 0xd3, 0x20, 0x82, 0x5f, 0x00, 0x28, 0xfe, 0xd8, 0xac, 0x73, 0x12, 0xf2, 0x5d, 0xc2,
+	0x49, 0x83, 0x44, 0xea, 0x5c, 0x4e, 0x55, 0xcc, 0x24, 0x36, 0x6f, 0x65, 0xcb, 0x44, 0x83, 0x4d,
+	0x1c, 0x09, 0x10, 0x6e, 0xa6, 0x28, 0x64, 0xe5, 0x50, 0xa1, 0x05, 0xae, 0x89, 0xd1, 0x52, 0x9d,
+	0x87, 0x34, 0x8a, 0xba, 0x8a, 0x13, 0x52, 0x71, 0x35, 0x89, 0xcd, 0xe5, 0x9e, 0x62, 0x09, 0x47,
+	0x12, 0xa5, 0x49, 0xe6, 0x91, 0xe1, 0x3e, 0x98, 0x17, 0xc3, 0xe5, 0x3a, 0x67, 0x41, 0x57, 0xb1,
+	0x28, 0x15, 0x57, 0x92, 0xd8, 0x5c, 0xea, 0x29, 0x96, 0x45, 0xe5, 0x0a, 0x34, 0xbd, 0x61, 0x22,
+	0xfc, 0x04, 0x5c, 0x11, 0x83, 0x5b, 0x4f, 0x03, 0xf1, 0xe8, 0xf6, 0x99, 0x13, 0xc9, 0xf3, 0x3c,
+	0xa5, 0x57, 0x05, 0xa1, 0xb5, 0x85, 0xdb, 0x12, 0x81, 0x3d, 0xe6, 0x44, 0xc8, 0x1a, 0x24, 0xa1,
+	0x3f, 0x17, 0xc0, 0x75, 0xb5, 0xc0, 0x99, 0x74, 0xc5, 0xf5, 0x49, 0xd8, 0x81, 0x1f, 0x80, 0xc9,
+	0x67, 0x34, 0x8c, 0x5c, 0xe6, 0xa7, 0x05, 0x0e, 0x26, 0xb1, 0x79, 0x39, 0xdd, 0xaf, 0x2a, 0x80,
+	0xac, 0x0c, 0x02, 0xef, 0x81, 0xb7, 0xea, 0xac, 0x1d, 0xda, 0x34, 0xed, 0xa0, 0xe7, 0x93, 0xd8,
+	0x9c, 0x4b, 0x5d, 0xc8, 0x71, 0x64, 0xa5, 0x00, 0x09, 0xfd, 0xec, 0x71, 0x69, 0xfb, 0xa1, 0x51,
+	0x1c, 0x82, 0x1e, 0x93, 0xd2, 0xf6, 0x43, 0x64, 0xa5, 0x00, 0x51, 0x61, 0xb5, 0x46, 0x57, 0xab,
+	0xb0, 0xea, 0x86, 0x93, 0x41, 0xf4, 0xb7, 0x02, 0x58, 0xec, 0x9f, 0x41, 0x95, 0xf9, 0x9c, 0xb8,
+	0x3e, 0x0d, 0xc5, 0x24, 0xac, 0xb6, 0x2f, 0x7a, 0xce, 0xe1, 0x49, 0x84, 0x2a, 0x80, 0xac, 0x0c,
+	0x02, 0xdf, 0x03, 0x97, 0xf6, 0x5a, 0xc4, 0xc9, 0xe6, 0x70, 0x35, 0x89, 0xcd, 0x59, 0x85, 0x75,
+	0xc5, 0x30, 0xb2, 0x54, 0x58, 0xd8, 0xaa, 0xd6, 0x9e, 0x46, 0xd2, 0x7f, 0x41, 0xb7, 0x65, 0x07,
+	0xed, 0x08, 0x59, 0x32, 0x08, 0x77, 0xc0, 0xcc, 0x01, 0x6d, 0xb1, 0xb0, 0xa3, 0xce, 0xbc, 0x2a,
+	0xb6, 0x8b, 0x49, 0x6c, 0x5e, 0x53, 0xd8, 0x96, 0x0c, 0x66, 0xe7, 0x5d, 0xc7, 0xa2, 0xdf, 0x0d,
+	0x3d, 0x93, 0xaa, 0x13, 0xb2, 0x76, 0xd0, 0x4d, 0x5c, 0xf8, 0x3f, 0x12, 0x4f, 0x5c, 0x3c, 0x31,
+	0xbc, 0x0b, 0x2e, 0xed, 0x7d, 0x71, 0x40, 0x5e, 0x1a, 0xc5, 0xd5, 0x62, 0xff, 0x93, 0x71, 0x19,
+	0x6e, 0x91, 0x97, 0xc8, 0x52, 0x71, 0xf4, 0x9b, 0x09, 0x30, 0xa7, 0x1c, 0xd6, 0x42, 0x76, 0xe4,
+	0x7a, 0x14, 0x56, 0xc0, 0xe5, 0xec, 0xfc, 0xa6, 0x25, 0xbb, 0x20, 0x13, 0x2f, 0x25, 0xb1, 0xb9,
+	0x90, 0x6a, 0x64, 0x87, 0x3f, 0x3b, 0xf7, 0x03, 0x0c, 0x51, 0x68, 0x6b, 0x3b, 0x3b, 0x69, 0xd3,
+	0x77, 0xa0, 0xac, 0x17, 0xf4, 0x42, 0x1b, 0xec, 0xec, 0x74, 0xdb, 0xc6, 0x96, 0x28, 0xb4, 0x3a,
+	0x5c, 0x58, 0xa8, 0x32, 0xe6, 0x35, 0xd9, 0xcf, 0xfd, 0xd4, 0x42, 0x71, 0xd0, 0x82, 0x9d, 0xc6,
+	0x7b, 0x16, 0xfa, 0x19, 0xa2, 0xce, 0x56, 0x6b, 0x4f, 0x53, 0xba, 0x7a, 0x66, 0x5a, 0x9d, 0xb5,
+	0x83, 0x76, 0x97, 0xd9, 0xc3, 0xa1, 0x7f, 0x2c, 0x00, 0x33, 0xa7, 0x48, 0x3d, 0x76, 0xa8, 0xcf,
+	0xc5, 0x5e, 0x0c, 0x99, 0x7c, 0x37, 0xcd, 0x1e, 0xe6, 0xde, 0xee, 0xf0, 0xbb, 0x69, 0x76, 0xd6,
+	0xb1, 0xdb, 0x44, 0x96, 0x86, 0x84, 0xdf, 0x07, 0xd7, 0xb2, 0x5f, 0xbb, 0x34, 0xb2, 0x43, 0x57,
+	0x36, 0x1f, 0xe9, 0x0e, 0xd5, 0x6a, 0x5b, 0x57, 0xa0, 0xd9, 0x43, 0x21, 0x2b, 0x8f, 0x2b, 0x36,
+	0x48, 0x36, 0x7c, 0x48, 0x9c, 0xf4, 0x14, 0x6a, 0x1b, 0xa4, 0x2b, 0xc5, 0x89, 0x83, 0x2c, 0x1d,
+	0x2b, 0x6e, 0xce, 0x1a, 0xa5, 0xe1, 0x5e, 0x4d, 0x6c, 0xe8, 0x62, 0xff, 0x9b, 0x72, 0x40, 0x69,
+	0x88, 0xdd, 0x20, 0x42, 0x56, 0x86, 0x81, 0xdf, 0x05, 0x73, 0xe9, 0x9f, 0x75, 0x1e, 0xba, 0xbe,
+	0x93, 0xbe, 0x28, 0x6a, 0x0f, 0x24, 0x23, 0x89, 0x1a, 0xea, 0xfa, 0x0e, 0xb2, 0xfa, 0x09, 0xb0,
+	0x06, 0xa0, 0x5c, 0xc6, 0x1a, 0x0b, 0xf9, 0x21, 0x4b, 0x7b, 0x87, 0xb4, 0x1b, 0xd0, 0xea, 0x30,
+	0x11, 0x18, 0x1c, 0xb0, 0x90, 0x63, 0xce, 0x70, 0xda, 0x7e, 0x20, 0x2b, 0x87, 0x2b, 0x76, 0x89,
+	0x1c, 0xed, 0x5d, 0x86, 0x93, 0xab, 0xc5, 0x7e, 0x53, 0x4a, 0x4d, 0xbb, 0x03, 0x07, 0x18, 0xf0,
+	0x87, 0xe0, 0x46, 0xb6, 0x2a, 0xfd, 0xc6, 0x54, 0x6b, 0xa0, 0xdd, 0xab, 0xdd, 0xb5, 0x1c, 0xf2,
+	0x96, 0xaf, 0x00, 0x9f, 0x80, 0xf9, 0x2c, 0xd0, 0x73, 0x38, 0x2d, 0x1d, 0xde, 0x4e, 0x62, 0xf3,
+	0xe6, 0x80, 0xac, 0x66, 0x72, 0x98, 0x27, 0x36, 0xdd, 0xc7, 0x2f, 0x79, 0x48, 0x3e, 0xf1, 0x88,
+	0x13, 0x19, 0x60, 0xb5, 0xd8, 0xbf, 0xe9, 0xa8, 0x88, 0x61, 0xf1, 0x95, 0x25, 0x42, 0x96, 0x86,
+	0x14, 0xcf, 0x4d, 0xfe, 0xfa, 0xde, 0xb3, 0x03, 0x45, 0x9d, 0x19, 0x5c, 0x22, 0x45, 0x7d, 0x71,
+	0xda, 0xca, 0xe8, 0xfd, 0x04, 0xf8, 0x11, 0x98, 0x91, 0x03, 0xcf, 0x19, 0xab, 0x1e, 0x39, 0xc6,
+	0xac, 0xe4, 0x6b, 0xad, 0xa6, 0xe2, 0x9f, 0x31, 0x86, 0xed, 0x23, 0xb1, 0xc9, 0x34, 0x30, 0x74,
+	0xf2, 0x6f, 0x24, 0x63, 0x6e, 0xb5, 0xb0, 0x36, 0x53, 0x5a, 0x7d, 0xd0, 0xfb, 0x42, 0xf4, 0x20,
+	0x0f, 0xa7, 0x97, 0xad, 0x86, 0x1c, 0x41, 0x56, 0xfe, 0x15, 0x17, 0x8d, 0xbc, 0x38, 0x8c, 0xcb,
+	0x32, 0xd7, 0x9d, 0xd1, 0xb9, 0xba, 0xd0, 0xca, 0xf5, 0x24, 0x36, 0xaf, 0x76, 0xfb, 0x5e, 0x35,
+	0xa8, 0xfa, 0xdc, 0xdc, 0x2b, 0xc9, 0xc9, 0xaf, 0xed, 0xc6, 0x95, 0x71, 0xb3, 0x53, 0x38, 0x7d,
+	0x76, 0xb6, 0x1c, 0x19, 0x9a, 0x5d, 0x7a, 0x59, 0xdc, 0x05, 0x97, 0x3e, 0xad, 0xee, 0x33, 0xc7,
+	0xb8, 0x2a, 0xfb, 0x02, 0x8d, 0xe7, 0xd8, 0xa2, 0x1b, 0x40, 0x96, 0x8a, 0xc3, 0xfa, 0x40, 0x2d,
+	0x37, 0xe6, 0xa5, 0x95, 0x9b, 0xc3, 0x56, 0x52, 0x80, 0x7e, 0x8b, 0x06, 0x6a, 0x08, 0x59, 0x03,
+	0xf7, 0xc1, 0x73, 0x70, 0x55, 0x7e, 0xbe, 0x93, 0xdf, 0x0d, 0x31, 0x3e, 0x2d, 0xe1, 0xb2, 0x7c,
+	0x45, 0x9e, 0x29, 0x2d, 0xeb, 0xba, 0x83, 0x18, 0xbd, 0xda, 0xf6, 0x46, 0x91, 0x35, 0x23, 0x80,
+	0x1f, 0x73, 0xbb, 0xf9, 0xac, 0x54, 0x1e, 0xd2, 0x2e, 0xe3, 0x4d, 0x83, 0x8e, 0xd1, 0x2e, 0xe3,
+	0xcd, 0x1c, 0xed, 0x32, 0xde, 0xd4, 0xb5, 0xcb, 0x9b, 0x39, 0xda, 0x25, 0xe3, 0x68, 0xac, 0x76,
+	0x29, 0x57, 0xbb, 0xd4, 0xa7, 0x5d, 0x82, 0x3f, 0x00, 0x57, 0x74, 0x1e, 0x77, 0x03, 0xf9, 0xce,
+	0x3c, 0x53, 0xba, 0x35, 0x4a, 0x9a, 0xbb, 0x81, 0xbe, 0xbf, 0xba, 0x83, 0x9a, 0xf0, 0xa1, 0x1b,
+	0xc0, 0x53, 0xb0, 0xa8, 0x58, 0xdd, 0x0f, 0xb1, 0x18, 0x87, 0x65, 0xbc, 0x85, 0x77, 0x8c, 0x57,
+	0x85, 0xe1, 0x9d, 0x3c, 0x02, 0xab, 0xb7, 0x8e, 0x43, 0x41, 0x64, 0xcd, 0x0b, 0xda, 0xf3, 0x6c,
+	0xdc, 0x2a, 0x6f, 0xed, 0xc0, 0xdf, 0x16, 0xc0, 0xed, 0x3c, 0xb1, 0x6d, 0x5c, 0xc2, 0xc4, 0x0b,
+	0x8e, 0x89, 0xf1, 0x17, 0x95, 0xfe, 0xde, 0xb8, 0xf4, 0x5d, 0x46, 0x05, 0x25, 0xb1, 0xb9, 0x92,
+	0x67, 0xa2, 0x0b, 0x41, 0xd6, 0xc2, 0x80, 0x95, 0xed, 0xd2, 0x63, 0x11, 0x80, 0x5f, 0x16, 0xc0,
+	0x72, 0xbe, 0x7a, 0x19, 0x37, 0x28, 0x27, 0xc6, 0x5f, 0x95, 0x9d, 0xb5, 0xf1, 0x76, 0x14, 0xa1,
+	0xf2, 0x76, 0x12, 0x9b, 0xb7, 0xf3, 0xdd, 0x28, 0x04, 0xb2, 0x6e, 0x0c, 0x9a, 0x29, 0x57, 0x28,
+	0x27, 0xf0, 0x05, 0xb8, 0xae, 0x94, 0xd5, 0xb7, 0x6f, 0x8c, 0x4f, 0x37, 0xf0, 0x87, 0x78, 0xdb,
+	0xf8, 0xc3, 0xc4, 0xf0, 0x41, 0xcf, 0x03, 0xea, 0x7d, 0x4f, 0x7f, 0x04, 0x59, 0x97, 0x05, 0xa1,
+	0x2a, 0x07, 0x9f, 0x6d, 0x7c, 0xb8, 0x9d, 0x9b, 0xeb, 0x11, 0xde, 0x30, 0xfe, 0x78, 0x91, 0x5c,
+	0x8f, 0xf0, 0xc6, 0x88, 0x5c, 0x8f, 0xf0, 0xc6, 0x40, 0xae, 0x47, 0x1b, 0x23, 0x72, 0x6d, 0x19,
+	0x7f, 0xba, 0x58, 0xae, 0xad, 0x91, 0xb9, 0xb6, 0x06, 0x73, 0x6d, 0xc1, 0x9f, 0x82, 0xf9, 0x54,
+	0x42, 0xed, 0x7c, 0xf9, 0x0c, 0xbf, 0x2a, 0xca, 0x44, 0xb7, 0x73, 0x12, 0xf5, 0x50, 0xfa, 0x35,
+	0xa7, 0x0d, 0x23, 0x6b, 0x4e, 0xa6, 0x10, 0x23, 0xf2, 0x29, 0x75, 0x33, 0x9c, 0x69, 0x19, 0xfe,
+	0x3b, 0x32, 0xc3, 0x59, 0x7e, 0x86, 0xb3, 0xa1, 0x0c, 0xcf, 0xbb, 0x19, 0x7e, 0x5f, 0xb8, 0xd0,
+	0xd7, 0x24, 0xe3, 0xdf, 0x93, 0x32, 0xe9, 0xfa, 0x70, 0xd5, 0x3d, 0x97, 0xa7, 0x1f, 0xda, 0x46,
+	0x16, 0xc3, 0x4c, 0x05, 0xc5, 0x87, 0xfe, 0xf1, 0x12, 0xf0, 0xeb, 0xc2, 0x05, 0x5e, 0xb2, 0x8d,
+	0xff, 0x28, 0x83, 0xf7, 0x2f, 0x6a, 0x50, 0xb2, 0xf4, 0x9e, 0xa1, 0x67, 0x4f, 0xbc, 0x98, 0x46,
+	0xc8, 0x1a, 0x9f, 0xb4, 0x72, 0xfd, 0xd5, 0xbf, 0x56, 0xde, 0x78, 0xf5, 0x7a, 0xa5, 0xf0, 0xf7,
+	0xd7, 0x2b, 0x85, 0x7f, 0xbe, 0x5e, 0x29, 0x7c, 0xfd, 0xcd, 0xca, 0x1b, 0x8d, 0xb7, 0xe4, 0xbf,
+	0x83, 0xca, 0xff, 0x1b, 0x00, 0xf7, 0x34, 0x18, 0x28, 0x08, 0x1b, 0x00, 0x00,
 }
@@ -26,17 +26,25 @@ const (
 	Operation_Start     Operation = 0
 	Operation_Stop      Operation = 1
 	Operation_Heartbeat Operation = 2
+	Operation_Profile   Operation = 3
+	// Status returns the health of the database process,
+	// without changing anything.
+	Operation_Status Operation = 4
 )
 
 var Operation_name = map[int32]string{
 	0: "Start",
 	1: "Stop",
 	2: "Heartbeat",
+	3: "Profile",
+	4: "Status",
 }
 var Operation_value = map[string]int32{
 	"Start":     0,
 	"Stop":      1,
 	"Heartbeat": 2,
+	"Profile":   3,
+	"Status":    4,
 }
 
 func (x Operation) String() string {
@@ -56,6 +64,14 @@ type Request struct {
 	IPIndex                    uint32                      `protobuf:"varint,6,opt,name=IPIndex,proto3" json:"IPIndex,omitempty"`
 	CurrentClientNumber        int64                       `protobuf:"varint,7,opt,name=CurrentClientNumber,proto3" json:"CurrentClientNumber,omitempty"`
 	ConfigClientMachineInitial *ConfigClientMachineInitial `protobuf:"bytes,8,opt,name=ConfigClientMachineInitial" json:"ConfigClientMachineInitial,omitempty"`
+	ExtraFlags                 []string                    `protobuf:"bytes,9,rep,name=ExtraFlags" json:"ExtraFlags,omitempty"`
+	ExtraJVMFlags              []string                    `protobuf:"bytes,10,rep,name=ExtraJVMFlags" json:"ExtraJVMFlags,omitempty"`
+	ExtraZooCfg                []string                    `protobuf:"bytes,11,rep,name=ExtraZooCfg" json:"ExtraZooCfg,omitempty"`
+	ConfigDatabaseBinary       *ConfigDatabaseBinary       `protobuf:"bytes,12,opt,name=ConfigDatabaseBinary" json:"ConfigDatabaseBinary,omitempty"`
+	ConfigDatabaseContainer    *ConfigDatabaseContainer    `protobuf:"bytes,13,opt,name=ConfigDatabaseContainer" json:"ConfigDatabaseContainer,omitempty"`
+	ConfigDatabaseCgroup       *ConfigDatabaseCgroup       `protobuf:"bytes,14,opt,name=ConfigDatabaseCgroup" json:"ConfigDatabaseCgroup,omitempty"`
+	GCLog                      bool                        `protobuf:"varint,15,opt,name=GCLog,proto3" json:"GCLog,omitempty"`
+	ConfigProfile              *ConfigProfile              `protobuf:"bytes,16,opt,name=ConfigProfile" json:"ConfigProfile,omitempty"`
 	Flag_Etcd_V2_3             *Flag_Etcd_V2_3             `protobuf:"bytes,100,opt,name=flag__etcd__v2_3,json=flagEtcdV23" json:"flag__etcd__v2_3,omitempty"`
 	Flag_Etcd_V3_1             *Flag_Etcd_V3_1             `protobuf:"bytes,101,opt,name=flag__etcd__v3_1,json=flagEtcdV31" json:"flag__etcd__v3_1,omitempty"`
 	Flag_Etcd_V3_2             *Flag_Etcd_V3_2             `protobuf:"bytes,102,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty"`
@@ -80,6 +96,18 @@ type Response struct {
 	// DiskSpaceUsageBytes is the data size of the database on disk in bytes.
 	// It measures after database is requested to stop.
 	DiskSpaceUsageBytes int64 `protobuf:"varint,2,opt,name=DiskSpaceUsageBytes,proto3" json:"DiskSpaceUsageBytes,omitempty"`
+	// BinaryVersion is the version output of the database binary,
+	// and BinarySHA256 is its SHA-256 checksum. They are set in
+	// the response to start request.
+	BinaryVersion string `protobuf:"bytes,3,opt,name=BinaryVersion,proto3" json:"BinaryVersion,omitempty"`
+	BinarySHA256  string `protobuf:"bytes,4,opt,name=BinarySHA256,proto3" json:"BinarySHA256,omitempty"`
+	// Health is the latest resource usage of the database process.
+	// It is set in the response to status request.
+	Health *Health `protobuf:"bytes,5,opt,name=Health" json:"Health,omitempty"`
+	// MaxRSSBytes is the maximum resident set size of the database process
+	// while the system metrics are collected. It is set in the response to
+	// stop request.
+	MaxRSSBytes uint64 `protobuf:"varint,6,opt,name=MaxRSSBytes,proto3" json:"MaxRSSBytes,omitempty"`
 }
 
 func (m *Response) Reset()                    { *m = Response{} }
@@ -87,9 +115,151 @@ func (m *Response) String() string            { return proto.CompactTextString(m
 func (*Response) ProtoMessage()               {}
 func (*Response) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{1} }
 
+// Health is the resource usage of the database process, collected every second.
+type Health struct {
+	// UnixNano is the time of collection, zero if not collected yet.
+	UnixNano                int64   `protobuf:"varint,1,opt,name=UnixNano,proto3" json:"UnixNano,omitempty"`
+	CPUPercent              float64 `protobuf:"fixed64,2,opt,name=CPUPercent,proto3" json:"CPUPercent,omitempty"`
+	RSSBytes                uint64  `protobuf:"varint,3,opt,name=RSSBytes,proto3" json:"RSSBytes,omitempty"`
+	DiskReadBytesPerSecond  uint64  `protobuf:"varint,4,opt,name=DiskReadBytesPerSecond,proto3" json:"DiskReadBytesPerSecond,omitempty"`
+	DiskWriteBytesPerSecond uint64  `protobuf:"varint,5,opt,name=DiskWriteBytesPerSecond,proto3" json:"DiskWriteBytesPerSecond,omitempty"`
+}
+
+func (m *Health) Reset()                    { *m = Health{} }
+func (m *Health) String() string            { return proto.CompactTextString(m) }
+func (*Health) ProtoMessage()               {}
+func (*Health) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{2} }
+
+// PreflightCheck is the result of one preflight check in the agent machine.
+type PreflightCheck struct {
+	Name   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Pass   bool   `protobuf:"varint,2,opt,name=Pass,proto3" json:"Pass,omitempty"`
+	Detail string `protobuf:"bytes,3,opt,name=Detail,proto3" json:"Detail,omitempty"`
+}
+
+func (m *PreflightCheck) Reset()                    { *m = PreflightCheck{} }
+func (m *PreflightCheck) String() string            { return proto.CompactTextString(m) }
+func (*PreflightCheck) ProtoMessage()               {}
+func (*PreflightCheck) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{3} }
+
+type PreflightResponse struct {
+	Checks []*PreflightCheck `protobuf:"bytes,1,rep,name=Checks" json:"Checks,omitempty"`
+	// UnixNano is the agent clock when it responds, and ReceivedUnixNano
+	// when it receives the request, to check the clock offset.
+	UnixNano         int64 `protobuf:"varint,2,opt,name=UnixNano,proto3" json:"UnixNano,omitempty"`
+	ReceivedUnixNano int64 `protobuf:"varint,3,opt,name=ReceivedUnixNano,proto3" json:"ReceivedUnixNano,omitempty"`
+}
+
+func (m *PreflightResponse) Reset()                    { *m = PreflightResponse{} }
+func (m *PreflightResponse) String() string            { return proto.CompactTextString(m) }
+func (*PreflightResponse) ProtoMessage()               {}
+func (*PreflightResponse) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{4} }
+
+type GenerateRequest struct {
+	DatabaseID       string `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty"`
+	RequestNumber    int64  `protobuf:"varint,2,opt,name=RequestNumber,proto3" json:"RequestNumber,omitempty"`
+	ConnectionNumber int64  `protobuf:"varint,3,opt,name=ConnectionNumber,proto3" json:"ConnectionNumber,omitempty"`
+	ClientNumber     int64  `protobuf:"varint,4,opt,name=ClientNumber,proto3" json:"ClientNumber,omitempty"`
+	// KeyOffset is the index of the first sequential key to write,
+	// so that load generators do not write duplicate keys.
+	KeyOffset int64 `protobuf:"varint,5,opt,name=KeyOffset,proto3" json:"KeyOffset,omitempty"`
+	// StartUnixNano is the time to start sending requests, so that
+	// all load generators stress the database at the same time.
+	StartUnixNano int64 `protobuf:"varint,6,opt,name=StartUnixNano,proto3" json:"StartUnixNano,omitempty"`
+	// MatrixCombinationName is the name of matrix combination to run,
+	// empty if the configuration has no 'matrix' section.
+	MatrixCombinationName string `protobuf:"bytes,7,opt,name=MatrixCombinationName,proto3" json:"MatrixCombinationName,omitempty"`
+	// RateLimitRequestsPerSecond is the share of the rate limit
+	// of this load generator, 0 to not rate limit.
+	RateLimitRequestsPerSecond int64 `protobuf:"varint,8,opt,name=RateLimitRequestsPerSecond,proto3" json:"RateLimitRequestsPerSecond,omitempty"`
+}
+
+func (m *GenerateRequest) Reset()                    { *m = GenerateRequest{} }
+func (m *GenerateRequest) String() string            { return proto.CompactTextString(m) }
+func (*GenerateRequest) ProtoMessage()               {}
+func (*GenerateRequest) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{5} }
+
+type StopGenerateRequest struct {
+	DatabaseID            string `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty"`
+	MatrixCombinationName string `protobuf:"bytes,2,opt,name=MatrixCombinationName,proto3" json:"MatrixCombinationName,omitempty"`
+}
+
+func (m *StopGenerateRequest) Reset()                    { *m = StopGenerateRequest{} }
+func (m *StopGenerateRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopGenerateRequest) ProtoMessage()               {}
+func (*StopGenerateRequest) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{6} }
+
+type StopGenerateResponse struct {
+}
+
+func (m *StopGenerateResponse) Reset()                    { *m = StopGenerateResponse{} }
+func (m *StopGenerateResponse) String() string            { return proto.CompactTextString(m) }
+func (*StopGenerateResponse) ProtoMessage()               {}
+func (*StopGenerateResponse) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{7} }
+
+// Histogram is the HDR histogram of latencies in nanoseconds.
+type Histogram struct {
+	LowestTrackableValue  int64   `protobuf:"varint,1,opt,name=LowestTrackableValue,proto3" json:"LowestTrackableValue,omitempty"`
+	HighestTrackableValue int64   `protobuf:"varint,2,opt,name=HighestTrackableValue,proto3" json:"HighestTrackableValue,omitempty"`
+	SignificantFigures    int32   `protobuf:"varint,3,opt,name=SignificantFigures,proto3" json:"SignificantFigures,omitempty"`
+	Min                   int64   `protobuf:"varint,4,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max                   int64   `protobuf:"varint,5,opt,name=Max,proto3" json:"Max,omitempty"`
+	Sum                   int64   `protobuf:"varint,6,opt,name=Sum,proto3" json:"Sum,omitempty"`
+	Counts                []int64 `protobuf:"varint,7,rep,packed,name=Counts" json:"Counts,omitempty"`
+}
+
+func (m *Histogram) Reset()                    { *m = Histogram{} }
+func (m *Histogram) String() string            { return proto.CompactTextString(m) }
+func (*Histogram) ProtoMessage()               {}
+func (*Histogram) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{8} }
+
+// TimeSeriesDataPoint is the latency and throughput of one unix second.
+type TimeSeriesDataPoint struct {
+	Timestamp             int64      `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	MinLatencyNanoseconds int64      `protobuf:"varint,2,opt,name=MinLatencyNanoseconds,proto3" json:"MinLatencyNanoseconds,omitempty"`
+	AvgLatencyNanoseconds int64      `protobuf:"varint,3,opt,name=AvgLatencyNanoseconds,proto3" json:"AvgLatencyNanoseconds,omitempty"`
+	MaxLatencyNanoseconds int64      `protobuf:"varint,4,opt,name=MaxLatencyNanoseconds,proto3" json:"MaxLatencyNanoseconds,omitempty"`
+	ThroughPut            int64      `protobuf:"varint,5,opt,name=ThroughPut,proto3" json:"ThroughPut,omitempty"`
+	Histogram             *Histogram `protobuf:"bytes,6,opt,name=Histogram" json:"Histogram,omitempty"`
+	ErrorCount            int64      `protobuf:"varint,7,opt,name=ErrorCount,proto3" json:"ErrorCount,omitempty"`
+	// ErrorClassCounts is the number of errors by error class.
+	ErrorClassCounts map[string]int64 `protobuf:"bytes,8,rep,name=ErrorClassCounts" json:"ErrorClassCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *TimeSeriesDataPoint) Reset()                    { *m = TimeSeriesDataPoint{} }
+func (m *TimeSeriesDataPoint) String() string            { return proto.CompactTextString(m) }
+func (*TimeSeriesDataPoint) ProtoMessage()               {}
+func (*TimeSeriesDataPoint) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{9} }
+
+type GenerateResponse struct {
+	AvgTotal         float64                `protobuf:"fixed64,1,opt,name=AvgTotal,proto3" json:"AvgTotal,omitempty"`
+	TotalNanoseconds int64                  `protobuf:"varint,2,opt,name=TotalNanoseconds,proto3" json:"TotalNanoseconds,omitempty"`
+	ErrorDist        map[string]int64       `protobuf:"bytes,3,rep,name=ErrorDist" json:"ErrorDist,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TimeSeries       []*TimeSeriesDataPoint `protobuf:"bytes,5,rep,name=TimeSeries" json:"TimeSeries,omitempty"`
+	Histogram        *Histogram             `protobuf:"bytes,6,opt,name=Histogram" json:"Histogram,omitempty"`
+	// Role is the role of the endpoint at dial time, in the response of one endpoint.
+	Role string `protobuf:"bytes,7,opt,name=Role,proto3" json:"Role,omitempty"`
+	// Endpoints are the responses of the requests served by each database endpoint.
+	Endpoints map[string]*GenerateResponse `protobuf:"bytes,8,rep,name=Endpoints" json:"Endpoints,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *GenerateResponse) Reset()                    { *m = GenerateResponse{} }
+func (m *GenerateResponse) String() string            { return proto.CompactTextString(m) }
+func (*GenerateResponse) ProtoMessage()               {}
+func (*GenerateResponse) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{10} }
+
 func init() {
 	proto.RegisterType((*Request)(nil), "dbtesterpb.Request")
 	proto.RegisterType((*Response)(nil), "dbtesterpb.Response")
+	proto.RegisterType((*Health)(nil), "dbtesterpb.Health")
+	proto.RegisterType((*PreflightCheck)(nil), "dbtesterpb.PreflightCheck")
+	proto.RegisterType((*PreflightResponse)(nil), "dbtesterpb.PreflightResponse")
+	proto.RegisterType((*GenerateRequest)(nil), "dbtesterpb.GenerateRequest")
+	proto.RegisterType((*StopGenerateRequest)(nil), "dbtesterpb.StopGenerateRequest")
+	proto.RegisterType((*StopGenerateResponse)(nil), "dbtesterpb.StopGenerateResponse")
+	proto.RegisterType((*Histogram)(nil), "dbtesterpb.Histogram")
+	proto.RegisterType((*TimeSeriesDataPoint)(nil), "dbtesterpb.TimeSeriesDataPoint")
+	proto.RegisterType((*GenerateResponse)(nil), "dbtesterpb.GenerateResponse")
 	proto.RegisterEnum("dbtesterpb.Operation", Operation_name, Operation_value)
 }
 
@@ -105,6 +275,9 @@ const _ = grpc.SupportPackageIsVersion4
 
 type TransporterClient interface {
 	Transfer(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	// Preflight checks that the agent machine is ready to run
+	// the database, without starting anything.
+	Preflight(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PreflightResponse, error)
 }
 
 type transporterClient struct {
@@ -124,10 +297,22 @@ func (c *transporterClient) Transfer(ctx context.Context, in *Request, opts ...g
 	return out, nil
 }
 
+func (c *transporterClient) Preflight(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PreflightResponse, error) {
+	out := new(PreflightResponse)
+	err := grpc.Invoke(ctx, "/dbtesterpb.Transporter/Preflight", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Transporter service
 
 type TransporterServer interface {
 	Transfer(context.Context, *Request) (*Response, error)
+	// Preflight checks that the agent machine is ready to run
+	// the database, without starting anything.
+	Preflight(context.Context, *Request) (*PreflightResponse, error)
 }
 
 func RegisterTransporterServer(s *grpc.Server, srv TransporterServer) {
@@ -152,6 +337,24 @@ func _Transporter_Transfer_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transporter_Preflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransporterServer).Preflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dbtesterpb.Transporter/Preflight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransporterServer).Preflight(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Transporter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dbtesterpb.Transporter",
 	HandlerType: (*TransporterServer)(nil),
@@ -160,6 +363,111 @@ var _Transporter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Transfer",
 			Handler:    _Transporter_Transfer_Handler,
 		},
+		{
+			MethodName: "Preflight",
+			Handler:    _Transporter_Preflight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dbtesterpb/message.proto",
+}
+
+// Client API for LoadGenerator service
+
+type LoadGeneratorClient interface {
+	Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error)
+	// StopGenerate stops sending requests of the running Generate,
+	// which then returns the stats of the requests sent so far.
+	StopGenerate(ctx context.Context, in *StopGenerateRequest, opts ...grpc.CallOption) (*StopGenerateResponse, error)
+}
+
+type loadGeneratorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLoadGeneratorClient(cc *grpc.ClientConn) LoadGeneratorClient {
+	return &loadGeneratorClient{cc}
+}
+
+func (c *loadGeneratorClient) Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error) {
+	out := new(GenerateResponse)
+	err := grpc.Invoke(ctx, "/dbtesterpb.LoadGenerator/Generate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loadGeneratorClient) StopGenerate(ctx context.Context, in *StopGenerateRequest, opts ...grpc.CallOption) (*StopGenerateResponse, error) {
+	out := new(StopGenerateResponse)
+	err := grpc.Invoke(ctx, "/dbtesterpb.LoadGenerator/StopGenerate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for LoadGenerator service
+
+type LoadGeneratorServer interface {
+	Generate(context.Context, *GenerateRequest) (*GenerateResponse, error)
+	// StopGenerate stops sending requests of the running Generate,
+	// which then returns the stats of the requests sent so far.
+	StopGenerate(context.Context, *StopGenerateRequest) (*StopGenerateResponse, error)
+}
+
+func RegisterLoadGeneratorServer(s *grpc.Server, srv LoadGeneratorServer) {
+	s.RegisterService(&_LoadGenerator_serviceDesc, srv)
+}
+
+func _LoadGenerator_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorServer).Generate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dbtesterpb.LoadGenerator/Generate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorServer).Generate(ctx, req.(*GenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoadGenerator_StopGenerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopGenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoadGeneratorServer).StopGenerate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dbtesterpb.LoadGenerator/StopGenerate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoadGeneratorServer).StopGenerate(ctx, req.(*StopGenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LoadGenerator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dbtesterpb.LoadGenerator",
+	HandlerType: (*LoadGeneratorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Generate",
+			Handler:    _LoadGenerator_Generate_Handler,
+		},
+		{
+			MethodName: "StopGenerate",
+			Handler:    _LoadGenerator_StopGenerate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dbtesterpb/message.proto",
@@ -232,17 +540,114 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.ExtraFlags) > 0 {
+		for _, s := range m.ExtraFlags {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ExtraJVMFlags) > 0 {
+		for _, s := range m.ExtraJVMFlags {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ExtraZooCfg) > 0 {
+		for _, s := range m.ExtraZooCfg {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ConfigDatabaseBinary != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigDatabaseBinary.Size()))
+		n2, err := m.ConfigDatabaseBinary.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.ConfigDatabaseContainer != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigDatabaseContainer.Size()))
+		n3, err := m.ConfigDatabaseContainer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.ConfigDatabaseCgroup != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigDatabaseCgroup.Size()))
+		n4, err := m.ConfigDatabaseCgroup.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.GCLog {
+		dAtA[i] = 0x78
+		i++
+		if m.GCLog {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ConfigProfile != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigProfile.Size()))
+		n5, err := m.ConfigProfile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	if m.Flag_Etcd_V2_3 != nil {
 		dAtA[i] = 0xa2
 		i++
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V2_3.Size()))
-		n2, err := m.Flag_Etcd_V2_3.MarshalTo(dAtA[i:])
+		n6, err := m.Flag_Etcd_V2_3.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n6
 	}
 	if m.Flag_Etcd_V3_1 != nil {
 		dAtA[i] = 0xaa
@@ -250,11 +655,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V3_1.Size()))
-		n3, err := m.Flag_Etcd_V3_1.MarshalTo(dAtA[i:])
+		n7, err := m.Flag_Etcd_V3_1.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n7
 	}
 	if m.Flag_Etcd_V3_2 != nil {
 		dAtA[i] = 0xb2
@@ -262,11 +667,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
-		n4, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
+		n8, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n8
 	}
 	if m.Flag_Etcd_Tip != nil {
 		dAtA[i] = 0xba
@@ -274,11 +679,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
-		n5, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
+		n9, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n9
 	}
 	if m.Flag_Zookeeper_R3_4_9 != nil {
 		dAtA[i] = 0xc2
@@ -286,11 +691,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Zookeeper_R3_4_9.Size()))
-		n6, err := m.Flag_Zookeeper_R3_4_9.MarshalTo(dAtA[i:])
+		n10, err := m.Flag_Zookeeper_R3_4_9.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n10
 	}
 	if m.Flag_Zookeeper_R3_5_2Alpha != nil {
 		dAtA[i] = 0xca
@@ -298,11 +703,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_2Alpha.Size()))
-		n7, err := m.Flag_Zookeeper_R3_5_2Alpha.MarshalTo(dAtA[i:])
+		n11, err := m.Flag_Zookeeper_R3_5_2Alpha.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n11
 	}
 	if m.Flag_Zookeeper_R3_5_3Beta != nil {
 		dAtA[i] = 0xd2
@@ -310,11 +715,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_3Beta.Size()))
-		n8, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
+		n12, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n12
 	}
 	if m.Flag_Consul_V0_7_5 != nil {
 		dAtA[i] = 0xe2
@@ -322,11 +727,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Consul_V0_7_5.Size()))
-		n9, err := m.Flag_Consul_V0_7_5.MarshalTo(dAtA[i:])
+		n13, err := m.Flag_Consul_V0_7_5.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n13
 	}
 	if m.Flag_Consul_V0_8_0 != nil {
 		dAtA[i] = 0xea
@@ -334,11 +739,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Consul_V0_8_0.Size()))
-		n10, err := m.Flag_Consul_V0_8_0.MarshalTo(dAtA[i:])
+		n14, err := m.Flag_Consul_V0_8_0.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n14
 	}
 	if m.Flag_Consul_V0_8_4 != nil {
 		dAtA[i] = 0xf2
@@ -346,11 +751,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Consul_V0_8_4.Size()))
-		n11, err := m.Flag_Consul_V0_8_4.MarshalTo(dAtA[i:])
+		n15, err := m.Flag_Consul_V0_8_4.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n15
 	}
 	if m.Flag_Cetcd_Beta != nil {
 		dAtA[i] = 0x82
@@ -358,11 +763,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x19
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Cetcd_Beta.Size()))
-		n12, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
+		n16, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n16
 	}
 	if m.Flag_Zetcd_Beta != nil {
 		dAtA[i] = 0xa2
@@ -370,11 +775,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1f
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Zetcd_Beta.Size()))
-		n13, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
+		n17, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n17
 	}
 	return i, nil
 }
@@ -409,143 +814,2983 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.DiskSpaceUsageBytes))
 	}
+	if len(m.BinaryVersion) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.BinaryVersion)))
+		i += copy(dAtA[i:], m.BinaryVersion)
+	}
+	if len(m.BinarySHA256) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.BinarySHA256)))
+		i += copy(dAtA[i:], m.BinarySHA256)
+	}
+	if m.Health != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Health.Size()))
+		n18, err := m.Health.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.MaxRSSBytes != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.MaxRSSBytes))
+	}
 	return i, nil
 }
 
-func encodeFixed64Message(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Message(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Health) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Request) Size() (n int) {
+
+func (m *Health) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovMessage(uint64(m.Operation))
-	}
-	if m.TriggerLogUpload {
-		n += 2
+	if m.UnixNano != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.UnixNano))
 	}
-	if m.DatabaseID != 0 {
-		n += 1 + sovMessage(uint64(m.DatabaseID))
+	if m.CPUPercent != 0 {
+		dAtA[i] = 0x11
+		i++
+		i = encodeFixed64Message(dAtA, i, uint64(math.Float64bits(float64(m.CPUPercent))))
 	}
-	l = len(m.DatabaseTag)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.RSSBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.RSSBytes))
 	}
-	l = len(m.PeerIPsString)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.DiskReadBytesPerSecond != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.DiskReadBytesPerSecond))
 	}
-	if m.IPIndex != 0 {
-		n += 1 + sovMessage(uint64(m.IPIndex))
+	if m.DiskWriteBytesPerSecond != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.DiskWriteBytesPerSecond))
 	}
-	if m.CurrentClientNumber != 0 {
-		n += 1 + sovMessage(uint64(m.CurrentClientNumber))
+	return i, nil
+}
+
+func (m *PreflightCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.ConfigClientMachineInitial != nil {
-		l = m.ConfigClientMachineInitial.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PreflightCheck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Flag_Etcd_V2_3 != nil {
-		l = m.Flag_Etcd_V2_3.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.Pass {
+		dAtA[i] = 0x10
+		i++
+		if m.Pass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Flag_Etcd_V3_1 != nil {
-		l = m.Flag_Etcd_V3_1.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.Detail) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Detail)))
+		i += copy(dAtA[i:], m.Detail)
 	}
-	if m.Flag_Etcd_V3_2 != nil {
-		l = m.Flag_Etcd_V3_2.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	return i, nil
+}
+
+func (m *PreflightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Flag_Etcd_Tip != nil {
-		l = m.Flag_Etcd_Tip.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PreflightResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for _, msg := range m.Checks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.Flag_Zookeeper_R3_4_9 != nil {
-		l = m.Flag_Zookeeper_R3_4_9.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.UnixNano != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.UnixNano))
 	}
-	if m.Flag_Zookeeper_R3_5_2Alpha != nil {
-		l = m.Flag_Zookeeper_R3_5_2Alpha.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.ReceivedUnixNano != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ReceivedUnixNano))
 	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	return i, nil
+}
+
+func (m *GenerateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Flag_Consul_V0_7_5 != nil {
-		l = m.Flag_Consul_V0_7_5.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GenerateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DatabaseID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseID)))
+		i += copy(dAtA[i:], m.DatabaseID)
 	}
-	if m.Flag_Consul_V0_8_0 != nil {
-		l = m.Flag_Consul_V0_8_0.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.RequestNumber != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.RequestNumber))
 	}
-	if m.Flag_Consul_V0_8_4 != nil {
-		l = m.Flag_Consul_V0_8_4.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.ConnectionNumber != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConnectionNumber))
 	}
-	if m.Flag_Cetcd_Beta != nil {
-		l = m.Flag_Cetcd_Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.ClientNumber != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ClientNumber))
 	}
-	if m.Flag_Zetcd_Beta != nil {
-		l = m.Flag_Zetcd_Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.KeyOffset != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.KeyOffset))
 	}
-	return n
+	if m.StartUnixNano != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.StartUnixNano))
+	}
+	if len(m.MatrixCombinationName) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MatrixCombinationName)))
+		i += copy(dAtA[i:], m.MatrixCombinationName)
+	}
+	if m.RateLimitRequestsPerSecond != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.RateLimitRequestsPerSecond))
+	}
+	return i, nil
 }
 
-func (m *Response) Size() (n int) {
+func (m *StopGenerateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopGenerateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
+	if len(m.DatabaseID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseID)))
+		i += copy(dAtA[i:], m.DatabaseID)
 	}
-	if m.DiskSpaceUsageBytes != 0 {
-		n += 1 + sovMessage(uint64(m.DiskSpaceUsageBytes))
+	if len(m.MatrixCombinationName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MatrixCombinationName)))
+		i += copy(dAtA[i:], m.MatrixCombinationName)
+	}
+	return i, nil
+}
+
+func (m *StopGenerateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopGenerateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LowestTrackableValue != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.LowestTrackableValue))
+	}
+	if m.HighestTrackableValue != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.HighestTrackableValue))
+	}
+	if m.SignificantFigures != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.SignificantFigures))
+	}
+	if m.Min != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Min))
+	}
+	if m.Max != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Max))
+	}
+	if m.Sum != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Sum))
+	}
+	if len(m.Counts) > 0 {
+		dAtA20 := make([]byte, len(m.Counts)*10)
+		var j19 int
+		for _, num1 := range m.Counts {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(j19))
+		i += copy(dAtA[i:], dAtA20[:j19])
+	}
+	return i, nil
+}
+
+func (m *TimeSeriesDataPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeSeriesDataPoint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Timestamp))
+	}
+	if m.MinLatencyNanoseconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.MinLatencyNanoseconds))
+	}
+	if m.AvgLatencyNanoseconds != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.AvgLatencyNanoseconds))
+	}
+	if m.MaxLatencyNanoseconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.MaxLatencyNanoseconds))
+	}
+	if m.ThroughPut != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ThroughPut))
+	}
+	if m.Histogram != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Histogram.Size()))
+		n21, err := m.Histogram.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if m.ErrorCount != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ErrorCount))
+	}
+	if len(m.ErrorClassCounts) > 0 {
+		for k, _ := range m.ErrorClassCounts {
+			dAtA[i] = 0x42
+			i++
+			v := m.ErrorClassCounts[k]
+			mapSize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			i = encodeVarintMessage(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(v))
+		}
+	}
+	return i, nil
+}
+
+func (m *GenerateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.AvgTotal != 0 {
+		dAtA[i] = 0x9
+		i++
+		i = encodeFixed64Message(dAtA, i, uint64(math.Float64bits(float64(m.AvgTotal))))
+	}
+	if m.TotalNanoseconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.TotalNanoseconds))
+	}
+	if len(m.ErrorDist) > 0 {
+		for k, _ := range m.ErrorDist {
+			dAtA[i] = 0x1a
+			i++
+			v := m.ErrorDist[k]
+			mapSize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			i = encodeVarintMessage(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(v))
+		}
+	}
+	if len(m.TimeSeries) > 0 {
+		for _, msg := range m.TimeSeries {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Histogram != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Histogram.Size()))
+		n22, err := m.Histogram.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if len(m.Role) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Role)))
+		i += copy(dAtA[i:], m.Role)
+	}
+	if len(m.Endpoints) > 0 {
+		for k, _ := range m.Endpoints {
+			dAtA[i] = 0x42
+			i++
+			v := m.Endpoints[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovMessage(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovMessage(uint64(len(k))) + msgSize
+			i = encodeVarintMessage(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintMessage(dAtA, i, uint64(v.Size()))
+				n23, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n23
+			}
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Message(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Message(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovMessage(uint64(m.Operation))
+	}
+	if m.TriggerLogUpload {
+		n += 2
+	}
+	if m.DatabaseID != 0 {
+		n += 1 + sovMessage(uint64(m.DatabaseID))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.PeerIPsString)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.IPIndex != 0 {
+		n += 1 + sovMessage(uint64(m.IPIndex))
+	}
+	if m.CurrentClientNumber != 0 {
+		n += 1 + sovMessage(uint64(m.CurrentClientNumber))
+	}
+	if m.ConfigClientMachineInitial != nil {
+		l = m.ConfigClientMachineInitial.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.ExtraFlags) > 0 {
+		for _, s := range m.ExtraFlags {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.ExtraJVMFlags) > 0 {
+		for _, s := range m.ExtraJVMFlags {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.ExtraZooCfg) > 0 {
+		for _, s := range m.ExtraZooCfg {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.ConfigDatabaseBinary != nil {
+		l = m.ConfigDatabaseBinary.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ConfigDatabaseContainer != nil {
+		l = m.ConfigDatabaseContainer.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ConfigDatabaseCgroup != nil {
+		l = m.ConfigDatabaseCgroup.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.GCLog {
+		n += 2
+	}
+	if m.ConfigProfile != nil {
+		l = m.ConfigProfile.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Etcd_V2_3 != nil {
+		l = m.Flag_Etcd_V2_3.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Etcd_V3_1 != nil {
+		l = m.Flag_Etcd_V3_1.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Etcd_V3_2 != nil {
+		l = m.Flag_Etcd_V3_2.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Etcd_Tip != nil {
+		l = m.Flag_Etcd_Tip.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Zookeeper_R3_4_9 != nil {
+		l = m.Flag_Zookeeper_R3_4_9.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Zookeeper_R3_5_2Alpha != nil {
+		l = m.Flag_Zookeeper_R3_5_2Alpha.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Zookeeper_R3_5_3Beta != nil {
+		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Consul_V0_7_5 != nil {
+		l = m.Flag_Consul_V0_7_5.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Consul_V0_8_0 != nil {
+		l = m.Flag_Consul_V0_8_0.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Consul_V0_8_4 != nil {
+		l = m.Flag_Consul_V0_8_4.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Cetcd_Beta != nil {
+		l = m.Flag_Cetcd_Beta.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Zetcd_Beta != nil {
+		l = m.Flag_Zetcd_Beta.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.DiskSpaceUsageBytes != 0 {
+		n += 1 + sovMessage(uint64(m.DiskSpaceUsageBytes))
+	}
+	l = len(m.BinaryVersion)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.BinarySHA256)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.MaxRSSBytes != 0 {
+		n += 1 + sovMessage(uint64(m.MaxRSSBytes))
+	}
+	return n
+}
+
+func (m *Health) Size() (n int) {
+	var l int
+	_ = l
+	if m.UnixNano != 0 {
+		n += 1 + sovMessage(uint64(m.UnixNano))
+	}
+	if m.CPUPercent != 0 {
+		n += 9
+	}
+	if m.RSSBytes != 0 {
+		n += 1 + sovMessage(uint64(m.RSSBytes))
+	}
+	if m.DiskReadBytesPerSecond != 0 {
+		n += 1 + sovMessage(uint64(m.DiskReadBytesPerSecond))
+	}
+	if m.DiskWriteBytesPerSecond != 0 {
+		n += 1 + sovMessage(uint64(m.DiskWriteBytesPerSecond))
+	}
+	return n
+}
+
+func (m *PreflightCheck) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Pass {
+		n += 2
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *PreflightResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.UnixNano != 0 {
+		n += 1 + sovMessage(uint64(m.UnixNano))
+	}
+	if m.ReceivedUnixNano != 0 {
+		n += 1 + sovMessage(uint64(m.ReceivedUnixNano))
+	}
+	return n
+}
+
+func (m *GenerateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DatabaseID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.RequestNumber != 0 {
+		n += 1 + sovMessage(uint64(m.RequestNumber))
+	}
+	if m.ConnectionNumber != 0 {
+		n += 1 + sovMessage(uint64(m.ConnectionNumber))
+	}
+	if m.ClientNumber != 0 {
+		n += 1 + sovMessage(uint64(m.ClientNumber))
+	}
+	if m.KeyOffset != 0 {
+		n += 1 + sovMessage(uint64(m.KeyOffset))
+	}
+	if m.StartUnixNano != 0 {
+		n += 1 + sovMessage(uint64(m.StartUnixNano))
+	}
+	l = len(m.MatrixCombinationName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.RateLimitRequestsPerSecond != 0 {
+		n += 1 + sovMessage(uint64(m.RateLimitRequestsPerSecond))
+	}
+	return n
+}
+
+func (m *StopGenerateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DatabaseID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.MatrixCombinationName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *StopGenerateResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Histogram) Size() (n int) {
+	var l int
+	_ = l
+	if m.LowestTrackableValue != 0 {
+		n += 1 + sovMessage(uint64(m.LowestTrackableValue))
+	}
+	if m.HighestTrackableValue != 0 {
+		n += 1 + sovMessage(uint64(m.HighestTrackableValue))
+	}
+	if m.SignificantFigures != 0 {
+		n += 1 + sovMessage(uint64(m.SignificantFigures))
+	}
+	if m.Min != 0 {
+		n += 1 + sovMessage(uint64(m.Min))
+	}
+	if m.Max != 0 {
+		n += 1 + sovMessage(uint64(m.Max))
+	}
+	if m.Sum != 0 {
+		n += 1 + sovMessage(uint64(m.Sum))
+	}
+	if len(m.Counts) > 0 {
+		l = 0
+		for _, e := range m.Counts {
+			l += sovMessage(uint64(e))
+		}
+		n += 1 + sovMessage(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *TimeSeriesDataPoint) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovMessage(uint64(m.Timestamp))
+	}
+	if m.MinLatencyNanoseconds != 0 {
+		n += 1 + sovMessage(uint64(m.MinLatencyNanoseconds))
+	}
+	if m.AvgLatencyNanoseconds != 0 {
+		n += 1 + sovMessage(uint64(m.AvgLatencyNanoseconds))
+	}
+	if m.MaxLatencyNanoseconds != 0 {
+		n += 1 + sovMessage(uint64(m.MaxLatencyNanoseconds))
+	}
+	if m.ThroughPut != 0 {
+		n += 1 + sovMessage(uint64(m.ThroughPut))
+	}
+	if m.Histogram != nil {
+		l = m.Histogram.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ErrorCount != 0 {
+		n += 1 + sovMessage(uint64(m.ErrorCount))
+	}
+	if len(m.ErrorClassCounts) > 0 {
+		for k, v := range m.ErrorClassCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GenerateResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.AvgTotal != 0 {
+		n += 9
+	}
+	if m.TotalNanoseconds != 0 {
+		n += 1 + sovMessage(uint64(m.TotalNanoseconds))
+	}
+	if len(m.ErrorDist) > 0 {
+		for k, v := range m.ErrorDist {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.TimeSeries) > 0 {
+		for _, e := range m.TimeSeries {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.Histogram != nil {
+		l = m.Histogram.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for k, v := range m.Endpoints {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= (Operation(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerLogUpload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TriggerLogUpload = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			m.DatabaseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseID |= (DatabaseID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPIndex", wireType)
+			}
+			m.IPIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IPIndex |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentClientNumber", wireType)
+			}
+			m.CurrentClientNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentClientNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineInitial", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigClientMachineInitial == nil {
+				m.ConfigClientMachineInitial = &ConfigClientMachineInitial{}
+			}
+			if err := m.ConfigClientMachineInitial.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraFlags = append(m.ExtraFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraJVMFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraJVMFlags = append(m.ExtraJVMFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraZooCfg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraZooCfg = append(m.ExtraZooCfg, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDatabaseBinary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigDatabaseBinary == nil {
+				m.ConfigDatabaseBinary = &ConfigDatabaseBinary{}
+			}
+			if err := m.ConfigDatabaseBinary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDatabaseContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigDatabaseContainer == nil {
+				m.ConfigDatabaseContainer = &ConfigDatabaseContainer{}
+			}
+			if err := m.ConfigDatabaseContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDatabaseCgroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigDatabaseCgroup == nil {
+				m.ConfigDatabaseCgroup = &ConfigDatabaseCgroup{}
+			}
+			if err := m.ConfigDatabaseCgroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCLog", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GCLog = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigProfile == nil {
+				m.ConfigProfile = &ConfigProfile{}
+			}
+			if err := m.ConfigProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V2_3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_V2_3 == nil {
+				m.Flag_Etcd_V2_3 = &Flag_Etcd_V2_3{}
+			}
+			if err := m.Flag_Etcd_V2_3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_V3_1 == nil {
+				m.Flag_Etcd_V3_1 = &Flag_Etcd_V3_1{}
+			}
+			if err := m.Flag_Etcd_V3_1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_V3_2 == nil {
+				m.Flag_Etcd_V3_2 = &Flag_Etcd_V3_2{}
+			}
+			if err := m.Flag_Etcd_V3_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Tip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_Tip == nil {
+				m.Flag_Etcd_Tip = &Flag_Etcd_Tip{}
+			}
+			if err := m.Flag_Etcd_Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 200:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_4_9", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zookeeper_R3_4_9 == nil {
+				m.Flag_Zookeeper_R3_4_9 = &Flag_Zookeeper_R3_4_9{}
+			}
+			if err := m.Flag_Zookeeper_R3_4_9.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 201:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_2Alpha", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zookeeper_R3_5_2Alpha == nil {
+				m.Flag_Zookeeper_R3_5_2Alpha = &Flag_Zookeeper_R3_5_2Alpha{}
+			}
+			if err := m.Flag_Zookeeper_R3_5_2Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 202:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_3Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zookeeper_R3_5_3Beta == nil {
+				m.Flag_Zookeeper_R3_5_3Beta = &Flag_Zookeeper_R3_5_3Beta{}
+			}
+			if err := m.Flag_Zookeeper_R3_5_3Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 300:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V0_7_5", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Consul_V0_7_5 == nil {
+				m.Flag_Consul_V0_7_5 = &Flag_Consul_V0_7_5{}
+			}
+			if err := m.Flag_Consul_V0_7_5.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 301:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V0_8_0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Consul_V0_8_0 == nil {
+				m.Flag_Consul_V0_8_0 = &Flag_Consul_V0_8_0{}
+			}
+			if err := m.Flag_Consul_V0_8_0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 302:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V0_8_4", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Consul_V0_8_4 == nil {
+				m.Flag_Consul_V0_8_4 = &Flag_Consul_V0_8_4{}
+			}
+			if err := m.Flag_Consul_V0_8_4.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 400:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd_Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Cetcd_Beta == nil {
+				m.Flag_Cetcd_Beta = &Flag_Cetcd_Beta{}
+			}
+			if err := m.Flag_Cetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 500:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd_Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zetcd_Beta == nil {
+				m.Flag_Zetcd_Beta = &Flag_Zetcd_Beta{}
+			}
+			if err := m.Flag_Zetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskSpaceUsageBytes", wireType)
+			}
+			m.DiskSpaceUsageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskSpaceUsageBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinarySHA256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinarySHA256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &Health{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRSSBytes", wireType)
+			}
+			m.MaxRSSBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRSSBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Health) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Health: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Health: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixNano", wireType)
+			}
+			m.UnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnixNano |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.CPUPercent = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RSSBytes", wireType)
+			}
+			m.RSSBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RSSBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskReadBytesPerSecond", wireType)
+			}
+			m.DiskReadBytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskReadBytesPerSecond |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskWriteBytesPerSecond", wireType)
+			}
+			m.DiskWriteBytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskWriteBytesPerSecond |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreflightCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreflightCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreflightCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pass = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreflightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreflightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreflightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, &PreflightCheck{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixNano", wireType)
+			}
+			m.UnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnixNano |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedUnixNano", wireType)
+			}
+			m.ReceivedUnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedUnixNano |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestNumber", wireType)
+			}
+			m.RequestNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+			}
+			m.ConnectionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientNumber", wireType)
+			}
+			m.ClientNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyOffset", wireType)
+			}
+			m.KeyOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyOffset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartUnixNano", wireType)
+			}
+			m.StartUnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartUnixNano |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatrixCombinationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatrixCombinationName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitRequestsPerSecond", wireType)
+			}
+			m.RateLimitRequestsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopGenerateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopGenerateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopGenerateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatrixCombinationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatrixCombinationName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopGenerateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopGenerateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopGenerateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestTrackableValue", wireType)
+			}
+			m.LowestTrackableValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowestTrackableValue |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestTrackableValue", wireType)
+			}
+			m.HighestTrackableValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestTrackableValue |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignificantFigures", wireType)
+			}
+			m.SignificantFigures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignificantFigures |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			m.Min = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Min |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			m.Sum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sum |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovMessage(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *TimeSeriesDataPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -568,17 +3813,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeSeriesDataPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeSeriesDataPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Operation = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -588,16 +3833,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= (Operation(b) & 0x7F) << shift
+				m.Timestamp |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerLogUpload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLatencyNanoseconds", wireType)
 			}
-			var v int
+			m.MinLatencyNanoseconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -607,17 +3852,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MinLatencyNanoseconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TriggerLogUpload = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgLatencyNanoseconds", wireType)
 			}
-			m.DatabaseID = 0
+			m.AvgLatencyNanoseconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -627,16 +3871,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatabaseID |= (DatabaseID(b) & 0x7F) << shift
+				m.AvgLatencyNanoseconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatencyNanoseconds", wireType)
 			}
-			var stringLen uint64
+			m.MaxLatencyNanoseconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -646,26 +3890,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxLatencyNanoseconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThroughPut", wireType)
 			}
-			var stringLen uint64
+			m.ThroughPut = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -675,26 +3909,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ThroughPut |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
 			}
-			m.IPIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -704,16 +3928,30 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IPIndex |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Histogram == nil {
+				m.Histogram = &Histogram{}
+			}
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentClientNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
 			}
-			m.CurrentClientNumber = 0
+			m.ErrorCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -723,14 +3961,14 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentClientNumber |= (int64(b) & 0x7F) << shift
+				m.ErrorCount |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineInitial", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorClassCounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -754,18 +3992,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigClientMachineInitial == nil {
-				m.ConfigClientMachineInitial = &ConfigClientMachineInitial{}
-			}
-			if err := m.ConfigClientMachineInitial.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 100:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V2_3", wireType)
-			}
-			var msglen int
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -775,30 +4002,12 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Etcd_V2_3 == nil {
-				m.Flag_Etcd_V2_3 = &Flag_Etcd_V2_3{}
-			}
-			if err := m.Flag_Etcd_V2_3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 101:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_1", wireType)
-			}
-			var msglen int
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -808,63 +4017,134 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_V3_1 == nil {
-				m.Flag_Etcd_V3_1 = &Flag_Etcd_V3_1{}
-			}
-			if err := m.Flag_Etcd_V3_1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 102:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_2", wireType)
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.ErrorClassCounts == nil {
+				m.ErrorClassCounts = make(map[string]int64)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				var mapvalue int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapvalue |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
+				m.ErrorClassCounts[mapkey] = mapvalue
+			} else {
+				var mapvalue int64
+				m.ErrorClassCounts[mapkey] = mapvalue
 			}
-			if msglen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgTotal", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_V3_2 == nil {
-				m.Flag_Etcd_V3_2 = &Flag_Etcd_V3_2{}
-			}
-			if err := m.Flag_Etcd_V3_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 103:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Tip", wireType)
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.AvgTotal = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNanoseconds", wireType)
 			}
-			var msglen int
+			m.TotalNanoseconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -874,28 +4154,14 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.TotalNanoseconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Etcd_Tip == nil {
-				m.Flag_Etcd_Tip = &Flag_Etcd_Tip{}
-			}
-			if err := m.Flag_Etcd_Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 200:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_4_9", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorDist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -919,18 +4185,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Zookeeper_R3_4_9 == nil {
-				m.Flag_Zookeeper_R3_4_9 = &Flag_Zookeeper_R3_4_9{}
-			}
-			if err := m.Flag_Zookeeper_R3_4_9.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 201:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_2Alpha", wireType)
-			}
-			var msglen int
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -940,30 +4195,12 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Zookeeper_R3_5_2Alpha == nil {
-				m.Flag_Zookeeper_R3_5_2Alpha = &Flag_Zookeeper_R3_5_2Alpha{}
-			}
-			if err := m.Flag_Zookeeper_R3_5_2Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 202:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_3Beta", wireType)
-			}
-			var msglen int
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -973,28 +4210,64 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Zookeeper_R3_5_3Beta == nil {
-				m.Flag_Zookeeper_R3_5_3Beta = &Flag_Zookeeper_R3_5_3Beta{}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.ErrorDist == nil {
+				m.ErrorDist = make(map[string]int64)
 			}
-			if err := m.Flag_Zookeeper_R3_5_3Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapvalue |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ErrorDist[mapkey] = mapvalue
+			} else {
+				var mapvalue int64
+				m.ErrorDist[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 300:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V0_7_5", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeSeries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1018,16 +4291,14 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Consul_V0_7_5 == nil {
-				m.Flag_Consul_V0_7_5 = &Flag_Consul_V0_7_5{}
-			}
-			if err := m.Flag_Consul_V0_7_5.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TimeSeries = append(m.TimeSeries, &TimeSeriesDataPoint{})
+			if err := m.TimeSeries[len(m.TimeSeries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 301:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V0_8_0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1051,18 +4322,18 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Consul_V0_8_0 == nil {
-				m.Flag_Consul_V0_8_0 = &Flag_Consul_V0_8_0{}
+			if m.Histogram == nil {
+				m.Histogram = &Histogram{}
 			}
-			if err := m.Flag_Consul_V0_8_0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 302:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V0_8_4", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1072,28 +4343,24 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Consul_V0_8_4 == nil {
-				m.Flag_Consul_V0_8_4 = &Flag_Consul_V0_8_4{}
-			}
-			if err := m.Flag_Consul_V0_8_4.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 400:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd_Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1117,18 +4384,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Cetcd_Beta == nil {
-				m.Flag_Cetcd_Beta = &Flag_Cetcd_Beta{}
-			}
-			if err := m.Flag_Cetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 500:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd_Beta", wireType)
-			}
-			var msglen int
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1138,80 +4394,12 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Zetcd_Beta == nil {
-				m.Flag_Zetcd_Beta = &Flag_Zetcd_Beta{}
-			}
-			if err := m.Flag_Zetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1221,31 +4409,76 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskSpaceUsageBytes", wireType)
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthMessage
 			}
-			m.DiskSpaceUsageBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Endpoints == nil {
+				m.Endpoints = make(map[string]*GenerateResponse)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthMessage
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthMessage
+				}
+				if postmsgIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiskSpaceUsageBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				mapvalue := &GenerateResponse{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
 				}
+				iNdEx = postmsgIndex
+				m.Endpoints[mapkey] = mapvalue
+			} else {
+				var mapvalue *GenerateResponse
+				m.Endpoints[mapkey] = mapvalue
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1375,55 +4608,117 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/message.proto", fileDescriptorMessage) }
 
 var fileDescriptorMessage = []byte{
-	// 787 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x5f, 0x4e, 0xeb, 0x46,
-	0x14, 0xc6, 0x63, 0x72, 0xef, 0x25, 0x99, 0x28, 0xb7, 0xb9, 0x73, 0x81, 0x8e, 0x02, 0xa4, 0x16,
-	0xad, 0x50, 0x8a, 0xd4, 0x24, 0xd8, 0x49, 0x81, 0x47, 0x08, 0xad, 0x88, 0xd4, 0x16, 0x34, 0x09,
-	0xa8, 0xe2, 0x65, 0x34, 0x76, 0x26, 0xc6, 0x22, 0xb1, 0xdd, 0xf1, 0x04, 0xb5, 0xac, 0xa2, 0x8f,
-	0x5d, 0x44, 0xdb, 0x75, 0xd0, 0x3e, 0x75, 0x09, 0x2d, 0xed, 0x12, 0xba, 0x80, 0xca, 0xe3, 0xfc,
-	0x99, 0x24, 0x4e, 0xc3, 0x1b, 0xe7, 0xfb, 0xbe, 0xf3, 0x3b, 0xf2, 0xc1, 0x39, 0x06, 0xa8, 0x6b,
-	0x09, 0x16, 0x0a, 0xc6, 0x03, 0xab, 0x3a, 0x60, 0x61, 0x48, 0x1d, 0x56, 0x09, 0xb8, 0x2f, 0x7c,
-	0x08, 0xa6, 0x4e, 0xf1, 0x33, 0xc7, 0x15, 0x77, 0x43, 0xab, 0x62, 0xfb, 0x83, 0xaa, 0xe3, 0x3b,
-	0x7e, 0x55, 0x46, 0xac, 0x61, 0x4f, 0x56, 0xb2, 0x90, 0x7f, 0xc5, 0xad, 0xc5, 0x1d, 0x05, 0xda,
-	0xa5, 0x82, 0x5a, 0x34, 0x64, 0xc4, 0xed, 0x8e, 0xdc, 0xa2, 0xe2, 0xf6, 0xfa, 0xd4, 0x21, 0x4c,
-	0xd8, 0x63, 0xef, 0xa3, 0x79, 0xef, 0xd1, 0xf7, 0xef, 0x19, 0x0b, 0x18, 0x4f, 0x40, 0xcb, 0x80,
-	0xed, 0x7b, 0xe1, 0xb0, 0x3f, 0x72, 0xb7, 0x17, 0xda, 0x15, 0xf6, 0x82, 0x69, 0x2b, 0xe6, 0xbe,
-	0x62, 0xda, 0xbe, 0xd7, 0x73, 0x1d, 0x62, 0xf7, 0x5d, 0xe6, 0x09, 0x32, 0xa0, 0xf6, 0x9d, 0xeb,
-	0x8d, 0xb6, 0xb2, 0xf7, 0x0f, 0x00, 0xeb, 0x98, 0x7d, 0x37, 0x64, 0xa1, 0x80, 0x26, 0xc8, 0x5e,
-	0x06, 0x8c, 0x53, 0xe1, 0xfa, 0x1e, 0xd2, 0x74, 0xad, 0xfc, 0xd6, 0xd8, 0xac, 0x4c, 0x39, 0x95,
-	0x89, 0x89, 0xa7, 0x39, 0x78, 0x00, 0x0a, 0x1d, 0xee, 0x3a, 0x0e, 0xe3, 0x5f, 0xf9, 0xce, 0x75,
-	0xd0, 0xf7, 0x69, 0x17, 0xad, 0xe9, 0x5a, 0x39, 0x83, 0x17, 0x74, 0xf8, 0x39, 0x00, 0xe7, 0xa3,
-	0xf5, 0xb5, 0xce, 0x51, 0x5a, 0x4e, 0xd8, 0x52, 0x27, 0x4c, 0x5d, 0xac, 0x24, 0xa1, 0x0e, 0x72,
-	0xe3, 0xaa, 0x43, 0x1d, 0xf4, 0x4a, 0xd7, 0xca, 0x59, 0xac, 0x4a, 0xf0, 0x13, 0x90, 0xbf, 0x62,
-	0x8c, 0xb7, 0xae, 0xc2, 0xb6, 0xe0, 0xae, 0xe7, 0xa0, 0xd7, 0x32, 0x33, 0x2b, 0x42, 0x04, 0xd6,
-	0x5b, 0x57, 0x2d, 0xaf, 0xcb, 0xbe, 0x47, 0x6f, 0x74, 0xad, 0x9c, 0xc7, 0xe3, 0x12, 0xd6, 0xc0,
-	0xfb, 0xe6, 0x90, 0x73, 0xe6, 0x89, 0xa6, 0xdc, 0xd2, 0x37, 0xc3, 0x81, 0xc5, 0x38, 0x5a, 0xd7,
-	0xb5, 0x72, 0x1a, 0x27, 0x59, 0xb0, 0x07, 0x8a, 0x4d, 0xb9, 0xd7, 0x58, 0xfd, 0x3a, 0xde, 0x6a,
-	0xcb, 0x73, 0x85, 0x4b, 0xfb, 0x28, 0xa3, 0x6b, 0xe5, 0x9c, 0xb1, 0xaf, 0x3e, 0xdb, 0xf2, 0x34,
-	0xfe, 0x1f, 0x12, 0x6c, 0x82, 0x82, 0xfc, 0xe7, 0xca, 0xb7, 0x8a, 0x90, 0x07, 0x83, 0x98, 0xa8,
-	0x2b, 0xe9, 0x3b, 0x2a, 0x7d, 0x3e, 0x83, 0x73, 0x91, 0xf2, 0x85, 0xb0, 0xbb, 0x37, 0x86, 0xb9,
-	0x00, 0x31, 0xc9, 0x21, 0x62, 0x2b, 0x20, 0x26, 0x39, 0x54, 0x20, 0xe6, 0x61, 0x02, 0xc4, 0x40,
-	0xbd, 0x95, 0x10, 0x43, 0x85, 0x18, 0xf0, 0x14, 0x7c, 0xa0, 0x06, 0x84, 0x1b, 0x20, 0x47, 0x32,
-	0xb6, 0x97, 0x31, 0x84, 0x1b, 0x4c, 0x11, 0x1d, 0x37, 0x80, 0xdf, 0x82, 0x0f, 0x63, 0x7f, 0xf2,
-	0x5b, 0x22, 0x84, 0x9b, 0xa4, 0x4e, 0x4e, 0xd0, 0x93, 0x26, 0x59, 0x1f, 0x2f, 0xb2, 0x16, 0xb2,
-	0xf8, 0x5d, 0x64, 0xdc, 0x8e, 0x65, 0x6c, 0xd6, 0x4f, 0xa0, 0x0b, 0x76, 0x93, 0xd2, 0x0d, 0x62,
-	0x10, 0xda, 0x0f, 0xee, 0x28, 0xfa, 0x2d, 0xe6, 0x7f, 0xba, 0x8a, 0x3f, 0xe9, 0xc0, 0x5b, 0x73,
-	0x53, 0x1a, 0xc6, 0x69, 0xa4, 0xc3, 0x1e, 0xd8, 0x49, 0x6e, 0x34, 0x89, 0xc5, 0x04, 0x45, 0xbf,
-	0xc7, 0x93, 0xca, 0xab, 0x27, 0xc5, 0x0d, 0x78, 0x73, 0x7e, 0x90, 0x79, 0xc6, 0x04, 0x85, 0x97,
-	0x60, 0x23, 0x6e, 0x8b, 0xef, 0x0a, 0x21, 0x0f, 0x35, 0x72, 0x44, 0x1a, 0xe8, 0xe7, 0x35, 0xc9,
-	0xd7, 0x17, 0xf9, 0xb3, 0x41, 0xfc, 0x36, 0x52, 0x9b, 0x52, 0xbb, 0xa9, 0x1d, 0x35, 0x12, 0x81,
-	0xc7, 0xa4, 0x86, 0x7e, 0x79, 0x09, 0xf0, 0x98, 0xd4, 0x66, 0x81, 0xc7, 0xb5, 0x25, 0xc0, 0x3a,
-	0xfa, 0xf5, 0x65, 0xc0, 0xfa, 0x1c, 0xb0, 0x0e, 0x2f, 0xc0, 0xbb, 0x51, 0x2e, 0x7e, 0x81, 0xe4,
-	0x3e, 0x7f, 0x4c, 0x4b, 0xda, 0x6e, 0x02, 0x6d, 0x9a, 0xc2, 0x79, 0x89, 0x8a, 0x04, 0xb9, 0xbc,
-	0x09, 0xe9, 0x51, 0x21, 0xfd, 0xbb, 0x94, 0xf4, 0x38, 0x4f, 0xba, 0x1d, 0x93, 0xf6, 0x6e, 0x40,
-	0x06, 0xb3, 0x30, 0xf0, 0xbd, 0x90, 0x45, 0x57, 0xa8, 0x3d, 0xb4, 0x6d, 0x16, 0x86, 0xf2, 0xc8,
-	0x66, 0xf0, 0xb8, 0x8c, 0xae, 0xd0, 0xb9, 0x1b, 0xde, 0xb7, 0x03, 0x6a, 0xb3, 0xeb, 0xe8, 0xd3,
-	0x75, 0xf6, 0x83, 0x60, 0xa1, 0x3c, 0xa7, 0x69, 0x9c, 0x64, 0x1d, 0x54, 0x95, 0x93, 0x0d, 0xb3,
-	0xe0, 0x75, 0x5b, 0x50, 0x2e, 0x0a, 0x29, 0x98, 0x01, 0xaf, 0xda, 0xc2, 0x0f, 0x0a, 0x1a, 0xcc,
-	0x83, 0xec, 0x05, 0xa3, 0x5c, 0x58, 0x8c, 0x8a, 0xc2, 0x9a, 0xf1, 0x25, 0xc8, 0x75, 0x38, 0xf5,
-	0xc2, 0xc0, 0xe7, 0x82, 0x71, 0x78, 0x04, 0x32, 0xb2, 0xec, 0x31, 0x0e, 0xdf, 0xab, 0x4f, 0x34,
-	0xfa, 0x26, 0x14, 0x37, 0x66, 0xc5, 0xf8, 0x11, 0xf6, 0x52, 0x67, 0x1b, 0x4f, 0x7f, 0x95, 0x52,
-	0x4f, 0xcf, 0x25, 0xed, 0x8f, 0xe7, 0x92, 0xf6, 0xe7, 0x73, 0x49, 0xfb, 0xe9, 0xef, 0x52, 0xca,
-	0x7a, 0x23, 0x3f, 0x2a, 0xe6, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x57, 0x68, 0x8f, 0x9f, 0x86,
-	0x07, 0x00, 0x00,
+	// 1791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xd1, 0x6e, 0x1b, 0xb9,
+	0x15, 0xcd, 0x58, 0xb2, 0x2d, 0x5d, 0xc5, 0x8e, 0xc2, 0x38, 0xce, 0x54, 0x49, 0xbc, 0x82, 0x5a,
+	0x2c, 0xd4, 0x14, 0x75, 0x9c, 0x91, 0x9d, 0x78, 0x8b, 0xa2, 0x0b, 0x47, 0xce, 0xc6, 0xde, 0xda,
+	0x89, 0x40, 0x29, 0x6e, 0x11, 0xa0, 0x18, 0x50, 0x23, 0x6a, 0x44, 0x58, 0x1a, 0xaa, 0x1c, 0xca,
+	0xb5, 0xf3, 0x07, 0x7d, 0x6a, 0x1f, 0x8b, 0x7e, 0x43, 0x77, 0xbf, 0x23, 0xed, 0x53, 0xd1, 0x2f,
+	0xe8, 0xa6, 0xef, 0x7d, 0x68, 0xfb, 0x01, 0x05, 0x39, 0x23, 0x89, 0x23, 0x8d, 0xe2, 0xdd, 0x7d,
+	0xe3, 0x3d, 0xf7, 0xdc, 0x43, 0xde, 0xcb, 0x2b, 0x92, 0x23, 0xb0, 0x3b, 0x6d, 0x49, 0x43, 0x49,
+	0xc5, 0xb0, 0xfd, 0x78, 0x40, 0xc3, 0x90, 0xf8, 0x74, 0x7b, 0x28, 0xb8, 0xe4, 0x08, 0xa6, 0x9e,
+	0xd2, 0x4f, 0x7d, 0x26, 0x7b, 0xa3, 0xf6, 0xb6, 0xc7, 0x07, 0x8f, 0x7d, 0xee, 0xf3, 0xc7, 0x9a,
+	0xd2, 0x1e, 0x75, 0xb5, 0xa5, 0x0d, 0x3d, 0x8a, 0x42, 0x4b, 0x0f, 0x0c, 0xd1, 0x0e, 0x91, 0xa4,
+	0x4d, 0x42, 0xea, 0xb2, 0x4e, 0xec, 0x2d, 0x19, 0xde, 0x6e, 0x9f, 0xf8, 0x2e, 0x95, 0xde, 0xd8,
+	0xf7, 0xc9, 0xac, 0xef, 0x1d, 0xe7, 0xe7, 0x94, 0x0e, 0xa9, 0x48, 0x91, 0xd6, 0x04, 0x8f, 0x07,
+	0xe1, 0xa8, 0x1f, 0x7b, 0xef, 0xcf, 0x85, 0x1b, 0xda, 0x73, 0x4e, 0xcf, 0x70, 0x7e, 0x6a, 0x38,
+	0x3d, 0x1e, 0x74, 0x99, 0xef, 0x7a, 0x7d, 0x46, 0x03, 0xe9, 0x0e, 0x88, 0xd7, 0x63, 0x41, 0x5c,
+	0x95, 0xca, 0xd7, 0xeb, 0xb0, 0x8a, 0xe9, 0x6f, 0x47, 0x34, 0x94, 0xa8, 0x06, 0xf9, 0xd7, 0x43,
+	0x2a, 0x88, 0x64, 0x3c, 0xb0, 0xad, 0xb2, 0x55, 0x5d, 0x77, 0xee, 0x6e, 0x4f, 0x75, 0xb6, 0x27,
+	0x4e, 0x3c, 0xe5, 0xa1, 0x47, 0x50, 0x6c, 0x09, 0xe6, 0xfb, 0x54, 0x9c, 0x70, 0xff, 0xcd, 0xb0,
+	0xcf, 0x49, 0xc7, 0x5e, 0x2a, 0x5b, 0xd5, 0x1c, 0x9e, 0xc3, 0xd1, 0x53, 0x80, 0xc3, 0xb8, 0x7c,
+	0xc7, 0x87, 0x76, 0x46, 0xcf, 0xb0, 0x69, 0xce, 0x30, 0xf5, 0x62, 0x83, 0x89, 0xca, 0x50, 0x18,
+	0x5b, 0x2d, 0xe2, 0xdb, 0xd9, 0xb2, 0x55, 0xcd, 0x63, 0x13, 0x42, 0x3f, 0x82, 0xb5, 0x06, 0xa5,
+	0xe2, 0xb8, 0x11, 0x36, 0xa5, 0x60, 0x81, 0x6f, 0x2f, 0x6b, 0x4e, 0x12, 0x44, 0x36, 0xac, 0x1e,
+	0x37, 0x8e, 0x83, 0x0e, 0xbd, 0xb4, 0x57, 0xca, 0x56, 0x75, 0x0d, 0x8f, 0x4d, 0xb4, 0x03, 0x77,
+	0xea, 0x23, 0x21, 0x68, 0x20, 0xeb, 0xba, 0x4a, 0xaf, 0x46, 0x83, 0x36, 0x15, 0xf6, 0x6a, 0xd9,
+	0xaa, 0x66, 0x70, 0x9a, 0x0b, 0x75, 0xa1, 0x54, 0xd7, 0x75, 0x8d, 0xd0, 0xd3, 0xa8, 0xaa, 0xc7,
+	0x01, 0x93, 0x8c, 0xf4, 0xed, 0x5c, 0xd9, 0xaa, 0x16, 0x9c, 0x4f, 0xcd, 0xdc, 0x16, 0xb3, 0xf1,
+	0x47, 0x94, 0xd0, 0x16, 0xc0, 0x8b, 0x4b, 0x29, 0xc8, 0x17, 0x7d, 0xe2, 0x87, 0x76, 0xbe, 0x9c,
+	0xa9, 0xe6, 0xb1, 0x81, 0xa8, 0xcc, 0xb5, 0xf5, 0xe5, 0xd9, 0x69, 0x44, 0x01, 0x4d, 0x49, 0x82,
+	0xaa, 0x82, 0x1a, 0x78, 0xcb, 0x79, 0xbd, 0xeb, 0xdb, 0x05, 0xcd, 0x31, 0x21, 0xd4, 0x82, 0x8d,
+	0x68, 0x15, 0xe3, 0xb2, 0x3e, 0x67, 0x01, 0x11, 0x57, 0xf6, 0x4d, 0x9d, 0x49, 0x79, 0x3e, 0x93,
+	0x24, 0x0f, 0xa7, 0x46, 0xa3, 0xdf, 0xc0, 0xbd, 0x24, 0x5e, 0xe7, 0x81, 0x24, 0x2c, 0xa0, 0xc2,
+	0x5e, 0xd3, 0xc2, 0x3f, 0x5c, 0x2c, 0x3c, 0xa1, 0xe2, 0x45, 0x1a, 0xf3, 0x8b, 0xae, 0xfb, 0x82,
+	0x8f, 0x86, 0xf6, 0xfa, 0x75, 0x8b, 0x8e, 0x78, 0x38, 0x35, 0x1a, 0x6d, 0xc0, 0xf2, 0xcb, 0xfa,
+	0x09, 0xf7, 0xed, 0x5b, 0xba, 0x8f, 0x23, 0x03, 0x7d, 0x0e, 0x6b, 0x11, 0xbb, 0x21, 0x78, 0x97,
+	0xf5, 0xa9, 0x5d, 0xd4, 0x93, 0xfc, 0x60, 0x7e, 0x92, 0x98, 0x80, 0x93, 0x7c, 0x54, 0x87, 0xa2,
+	0xfe, 0x99, 0xea, 0xf3, 0xc1, 0x75, 0x2f, 0x1c, 0xb7, 0x66, 0x77, 0xb4, 0xc6, 0x03, 0x53, 0x63,
+	0x96, 0x83, 0x0b, 0x0a, 0x79, 0x21, 0xbd, 0xce, 0x99, 0x53, 0x9b, 0x13, 0xa9, 0xb9, 0x4f, 0x6c,
+	0x7a, 0x8d, 0x48, 0xcd, 0x7d, 0x62, 0x88, 0xd4, 0x9e, 0xa4, 0x88, 0x38, 0x76, 0xf7, 0x5a, 0x11,
+	0xc7, 0x14, 0x71, 0xd0, 0x01, 0xdc, 0x32, 0x09, 0x92, 0x0d, 0x6d, 0x5f, 0x6b, 0xdc, 0x5f, 0xa4,
+	0x21, 0xd9, 0x70, 0x2a, 0xd1, 0x62, 0x43, 0xf4, 0x6b, 0xb8, 0x17, 0xf9, 0x27, 0xa7, 0xa2, 0xeb,
+	0x8a, 0x9a, 0xbb, 0xeb, 0x7e, 0x66, 0xbf, 0xb7, 0xe6, 0xdb, 0x63, 0x01, 0x17, 0xdf, 0x56, 0x8e,
+	0xb7, 0x63, 0x18, 0xd7, 0x76, 0x3f, 0x43, 0x0c, 0x1e, 0xa6, 0xb1, 0xf7, 0x5c, 0xc7, 0x25, 0xfd,
+	0x61, 0x8f, 0xd8, 0x7f, 0x8d, 0xf4, 0x7f, 0x7c, 0x9d, 0xfe, 0x24, 0x02, 0x6f, 0xce, 0xcc, 0xb2,
+	0xe7, 0x1c, 0x28, 0x1c, 0x75, 0xe1, 0x41, 0x7a, 0x60, 0xcd, 0x6d, 0x53, 0x49, 0xec, 0xbf, 0x45,
+	0x33, 0x55, 0xaf, 0x9f, 0x29, 0x0a, 0xc0, 0x77, 0x67, 0x27, 0xaa, 0x3d, 0xa7, 0x92, 0xa0, 0xd7,
+	0xb0, 0x11, 0x85, 0x45, 0x37, 0x84, 0xeb, 0x5e, 0xec, 0xb8, 0xcf, 0xdc, 0x3d, 0xfb, 0x2f, 0x4b,
+	0xf3, 0xcd, 0x9e, 0x46, 0xc4, 0xeb, 0x0a, 0xad, 0x6b, 0xec, 0x6c, 0xe7, 0xd9, 0x5e, 0xaa, 0xe0,
+	0xbe, 0xbb, 0x63, 0x7f, 0xf5, 0x6d, 0x04, 0xf7, 0xdd, 0x9d, 0xa4, 0xe0, 0xfe, 0xce, 0x02, 0xc1,
+	0x5d, 0xfb, 0xeb, 0x6f, 0x27, 0xb8, 0x3b, 0x23, 0xb8, 0x8b, 0x8e, 0xe0, 0x76, 0xcc, 0x8b, 0x1a,
+	0x48, 0xd7, 0xf3, 0x8f, 0x19, 0xad, 0xf6, 0x30, 0x45, 0x6d, 0xca, 0xc2, 0x6b, 0x5a, 0x4a, 0x01,
+	0xba, 0x78, 0x13, 0xa5, 0x77, 0x86, 0xd2, 0xff, 0x16, 0x2a, 0xbd, 0x9b, 0x55, 0x7a, 0x3b, 0x56,
+	0xaa, 0xfc, 0xdb, 0x82, 0x1c, 0xa6, 0xe1, 0x90, 0x07, 0x21, 0x55, 0x17, 0x4a, 0x73, 0xe4, 0x79,
+	0x34, 0x0c, 0xf5, 0x7d, 0x99, 0xc3, 0x63, 0x53, 0x5d, 0x28, 0x87, 0x2c, 0x3c, 0x6f, 0x0e, 0x89,
+	0x47, 0xdf, 0xa8, 0x57, 0xc8, 0xf3, 0x2b, 0x49, 0x43, 0x7d, 0x33, 0x66, 0x70, 0x9a, 0x4b, 0x1d,
+	0xe4, 0xd1, 0xa1, 0x79, 0x46, 0x45, 0xa8, 0x6e, 0xe0, 0x4c, 0x74, 0x85, 0x25, 0x40, 0x54, 0x81,
+	0x9b, 0x11, 0xd0, 0x3c, 0x3a, 0x70, 0xf6, 0x9e, 0xc6, 0x77, 0x61, 0x02, 0x43, 0x8f, 0x60, 0xe5,
+	0x88, 0x92, 0xbe, 0xec, 0xe9, 0x5b, 0xb0, 0xe0, 0x20, 0x33, 0xc1, 0xc8, 0x83, 0x63, 0x86, 0xba,
+	0x18, 0x4e, 0xc9, 0x25, 0x6e, 0x36, 0xa3, 0xf5, 0xa9, 0x6b, 0x31, 0x8b, 0x4d, 0xa8, 0xf2, 0x0f,
+	0x6b, 0x2c, 0x87, 0x4a, 0x90, 0x7b, 0x13, 0xb0, 0xcb, 0x57, 0x24, 0xe0, 0x3a, 0xdf, 0x0c, 0x9e,
+	0xd8, 0xea, 0x9e, 0xaa, 0x37, 0xde, 0x34, 0xa8, 0xf0, 0x68, 0x20, 0x75, 0x9e, 0x16, 0x36, 0x10,
+	0x15, 0x3b, 0x99, 0x25, 0xa3, 0x67, 0x99, 0xd8, 0xe8, 0x29, 0x6c, 0xaa, 0x8a, 0x60, 0x4a, 0x3a,
+	0x1a, 0x68, 0x50, 0xd1, 0xa4, 0x1e, 0x0f, 0x3a, 0x3a, 0xbd, 0x2c, 0x5e, 0xe0, 0x45, 0xfb, 0x70,
+	0x4f, 0x79, 0x7e, 0x25, 0x98, 0xa4, 0x33, 0x81, 0xcb, 0x3a, 0x70, 0x91, 0xbb, 0xd2, 0x80, 0xf5,
+	0x86, 0xa0, 0xdd, 0x3e, 0xf3, 0x7b, 0xb2, 0xde, 0xa3, 0xde, 0x39, 0x42, 0x90, 0x7d, 0x45, 0x06,
+	0x54, 0xe7, 0x95, 0xc7, 0x7a, 0xac, 0xb0, 0x06, 0x09, 0xc3, 0xf8, 0x3d, 0xa3, 0xc7, 0x68, 0x13,
+	0x56, 0x0e, 0xa9, 0x24, 0xac, 0x1f, 0xef, 0x4f, 0x6c, 0x55, 0xfe, 0x60, 0xc1, 0xed, 0x89, 0xe4,
+	0xa4, 0x41, 0x1c, 0x58, 0xd1, 0xf2, 0xaa, 0x3f, 0x32, 0xd5, 0x82, 0x53, 0x32, 0xb7, 0x22, 0xb9,
+	0x02, 0x1c, 0x33, 0x13, 0x55, 0x5e, 0x9a, 0xa9, 0xf2, 0x23, 0x28, 0x62, 0xea, 0x51, 0x76, 0x41,
+	0x3b, 0x13, 0x4e, 0x46, 0x73, 0xe6, 0xf0, 0xca, 0x37, 0x4b, 0x70, 0xeb, 0x25, 0x0d, 0xa8, 0x20,
+	0x92, 0x8e, 0x9f, 0x78, 0x5b, 0x89, 0x17, 0x58, 0x94, 0xab, 0x81, 0xa8, 0x26, 0x8c, 0xa9, 0xf1,
+	0x0b, 0x28, 0x5a, 0x40, 0x12, 0x54, 0xab, 0xa8, 0xf3, 0x20, 0xa0, 0x9e, 0x7a, 0x01, 0xc6, 0xc4,
+	0x78, 0x15, 0xb3, 0xb8, 0x6a, 0xd8, 0xc4, 0x93, 0x2a, 0xab, 0x79, 0x09, 0x0c, 0x3d, 0x80, 0xfc,
+	0x2f, 0xe9, 0xd5, 0xeb, 0x6e, 0x37, 0xa4, 0x52, 0xef, 0x5c, 0x06, 0x4f, 0x01, 0xb5, 0xa6, 0xa6,
+	0x24, 0x42, 0x4e, 0x12, 0x5e, 0x89, 0xd6, 0x94, 0x00, 0xd1, 0x2e, 0xdc, 0x3d, 0x25, 0x52, 0xb0,
+	0xcb, 0x3a, 0x1f, 0xb4, 0x59, 0xa0, 0x1f, 0xa7, 0x7a, 0x43, 0x57, 0x75, 0x92, 0xe9, 0x4e, 0xf4,
+	0x0b, 0x28, 0x61, 0x22, 0xe9, 0x09, 0x1b, 0x30, 0x19, 0xe7, 0x68, 0x34, 0x51, 0x4e, 0x4f, 0xf4,
+	0x11, 0x46, 0xe5, 0x1c, 0xee, 0x34, 0x25, 0x1f, 0x7e, 0xd7, 0x32, 0x2f, 0x5c, 0xec, 0xd2, 0x47,
+	0x16, 0x5b, 0xd9, 0x84, 0x8d, 0xe4, 0x64, 0x51, 0x93, 0x55, 0xfe, 0x6b, 0x41, 0xfe, 0x88, 0x85,
+	0x92, 0xfb, 0x82, 0x0c, 0x90, 0x03, 0x1b, 0x27, 0xfc, 0x77, 0x34, 0x94, 0x2d, 0x41, 0xbc, 0x73,
+	0xd2, 0xee, 0xd3, 0x33, 0xd2, 0x1f, 0xd1, 0xf8, 0x07, 0x9b, 0xea, 0x53, 0xeb, 0x39, 0x62, 0x7e,
+	0x6f, 0x3e, 0x28, 0xda, 0xfe, 0x74, 0x27, 0xda, 0x06, 0xd4, 0x64, 0x7e, 0xc0, 0xba, 0xcc, 0x23,
+	0x81, 0xfc, 0x82, 0xf9, 0x23, 0x11, 0xff, 0xb8, 0x97, 0x71, 0x8a, 0x07, 0x15, 0x21, 0x73, 0xca,
+	0x82, 0xb8, 0x03, 0xd4, 0x50, 0x23, 0xe4, 0x32, 0xde, 0x72, 0x35, 0x54, 0x48, 0x73, 0x34, 0x88,
+	0xb7, 0x58, 0x0d, 0xd5, 0x0f, 0xae, 0xce, 0x47, 0x81, 0x0c, 0xed, 0xd5, 0x72, 0xa6, 0x9a, 0xc1,
+	0xb1, 0x55, 0xf9, 0x4f, 0x06, 0xee, 0xb4, 0xd8, 0x80, 0x36, 0xa9, 0x60, 0x34, 0x54, 0xc5, 0x6d,
+	0x70, 0x16, 0x48, 0xd5, 0x4c, 0x0a, 0x0e, 0x25, 0x19, 0x0c, 0xe3, 0xa4, 0xa7, 0x80, 0xae, 0x3c,
+	0x0b, 0x4e, 0x88, 0xa4, 0x81, 0x77, 0xa5, 0x1a, 0x27, 0xd4, 0x1b, 0x39, 0x3e, 0x99, 0xd3, 0x9d,
+	0x2a, 0xea, 0xe0, 0xc2, 0x4f, 0x89, 0x8a, 0xba, 0x3e, 0xdd, 0x19, 0xed, 0xf2, 0x65, 0x4a, 0x54,
+	0x36, 0x9e, 0x2b, 0xcd, 0xa9, 0x7a, 0xa7, 0xd5, 0x13, 0x7c, 0xe4, 0xf7, 0x1a, 0xa3, 0xf1, 0xaf,
+	0xc1, 0x40, 0x50, 0xcd, 0xd8, 0x6c, 0x5d, 0xa7, 0x42, 0xf2, 0x2b, 0x6d, 0xe2, 0xc4, 0x46, 0x53,
+	0xa8, 0xaf, 0x08, 0x21, 0xb8, 0xd0, 0xb5, 0x8b, 0x3f, 0x6b, 0x0c, 0x04, 0x11, 0x28, 0x46, 0x56,
+	0x9f, 0x84, 0x61, 0x5c, 0xee, 0x9c, 0x3e, 0xb1, 0xf6, 0x4c, 0xed, 0x94, 0x7a, 0x6f, 0xcf, 0xc6,
+	0xbd, 0x08, 0xa4, 0xb8, 0xc2, 0x73, 0x72, 0xa5, 0x3a, 0xdc, 0x4d, 0xa5, 0xaa, 0x2d, 0x3f, 0xa7,
+	0x57, 0xf1, 0xaf, 0x44, 0x0d, 0xd5, 0x03, 0xfc, 0xc2, 0x68, 0xbf, 0xc8, 0xf8, 0xd9, 0xd2, 0xbe,
+	0x55, 0xf9, 0x73, 0x16, 0x8a, 0xb3, 0xfd, 0xaf, 0x0e, 0xcc, 0x83, 0x0b, 0xbf, 0xc5, 0x25, 0xe9,
+	0x6b, 0x15, 0x0b, 0x4f, 0x6c, 0xfd, 0x79, 0xaa, 0x06, 0xf3, 0x5b, 0x3d, 0x87, 0xa3, 0x63, 0xc8,
+	0xeb, 0x15, 0x1e, 0xb2, 0x50, 0xda, 0x19, 0x9d, 0xfd, 0x4f, 0xcc, 0xec, 0x67, 0x27, 0xde, 0x9e,
+	0xb0, 0xa3, 0x9c, 0xa7, 0xd1, 0xe8, 0x73, 0x80, 0x69, 0xad, 0xec, 0x65, 0xad, 0xf5, 0xc9, 0x35,
+	0x95, 0xc4, 0x46, 0xc8, 0xf7, 0xdb, 0x65, 0x04, 0x59, 0xcc, 0xfb, 0xe3, 0x23, 0x4f, 0x8f, 0x75,
+	0x52, 0x41, 0x67, 0xc8, 0xd9, 0x74, 0x4b, 0xaf, 0x49, 0x6a, 0xcc, 0x1e, 0x27, 0x35, 0xb6, 0x4b,
+	0x3f, 0x87, 0xf5, 0x64, 0xc6, 0xdf, 0x65, 0xeb, 0x4a, 0x6f, 0x61, 0x3d, 0x29, 0x9d, 0x12, 0xed,
+	0x98, 0xd1, 0x33, 0x5f, 0x23, 0xb3, 0x0b, 0x35, 0xb4, 0xbf, 0xcc, 0xe6, 0xb2, 0xc5, 0xe5, 0x47,
+	0x47, 0xc6, 0xff, 0x17, 0x28, 0x0f, 0xcb, 0xfa, 0x82, 0x28, 0xde, 0x40, 0x39, 0xc8, 0xaa, 0x73,
+	0xb3, 0x68, 0xa1, 0x35, 0xc8, 0x1f, 0x51, 0x22, 0x64, 0x9b, 0x12, 0x59, 0x5c, 0x42, 0x05, 0x58,
+	0x8d, 0x3f, 0xce, 0x8a, 0x19, 0x04, 0xb0, 0xd2, 0x94, 0x44, 0x8e, 0xc2, 0x62, 0xd6, 0xf9, 0xbd,
+	0x05, 0x85, 0x96, 0x20, 0x41, 0x38, 0xe4, 0x42, 0x52, 0x81, 0x9e, 0x41, 0x4e, 0x9b, 0x5d, 0x2a,
+	0xd0, 0x1d, 0x73, 0x51, 0xf1, 0x81, 0x5f, 0xda, 0x48, 0x82, 0xf1, 0xc1, 0x7c, 0x03, 0x1d, 0x40,
+	0x7e, 0x72, 0xcb, 0xa7, 0x47, 0x3e, 0x4c, 0x7d, 0x11, 0x4c, 0x25, 0x9c, 0xaf, 0x2c, 0x58, 0x3b,
+	0xe1, 0xa4, 0x13, 0xe7, 0xcf, 0x05, 0x7a, 0x09, 0xb9, 0xd8, 0xa0, 0xe8, 0x7e, 0x7a, 0x89, 0x22,
+	0xed, 0x8f, 0xd6, 0xaf, 0x72, 0x03, 0x35, 0xe1, 0xa6, 0x79, 0xa1, 0xa0, 0x44, 0x87, 0xa6, 0xdc,
+	0x6b, 0xa5, 0xf2, 0x62, 0xc2, 0x58, 0xf4, 0xf9, 0xc6, 0xfb, 0x6f, 0xb6, 0x6e, 0xbc, 0xff, 0xb0,
+	0x65, 0xfd, 0xfd, 0xc3, 0x96, 0xf5, 0xcf, 0x0f, 0x5b, 0xd6, 0x9f, 0xfe, 0xb5, 0x75, 0xa3, 0xbd,
+	0xa2, 0xff, 0x6e, 0xaa, 0xfd, 0x7f, 0x00, 0x49, 0x0b, 0x76, 0xb7, 0xa0, 0x13, 0x00, 0x00,
 }
@@ -18,6 +18,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
 	"github.com/coreos/etcd/pkg/report"
 )
 
@@ -30,6 +31,12 @@ type CumulativeKeyNumToAvgLatency struct {
 	MinLatency time.Duration
 	AvgLatency time.Duration
 	MaxLatency time.Duration
+
+	// percentiles are zero, unless computed by 'FindRangesLatencyPercentiles'
+	P50Latency  time.Duration
+	P90Latency  time.Duration
+	P99Latency  time.Duration
+	P999Latency time.Duration
 }
 
 // CumulativeKeyNumToAvgLatencySlice is a slice of CumulativeKeyNumToAvgLatency to sort by CumulativeKeyNum.
@@ -57,10 +64,21 @@ func (t CumulativeKeyNumToAvgLatencySlice) Less(i, j int) bool {
 // and its average latency is 10ms, it will have 30 data points with
 // latency 10ms.
 func FindRangesLatency(data report.TimeSeries, unit int64, totalRequests int64) CumulativeKeyNumToAvgLatencySlice {
+	return FindRangesLatencyPercentiles(data, nil, unit, totalRequests)
+}
+
+// FindRangesLatencyPercentiles is FindRangesLatency with latency percentiles.
+// 'hs' is the latency histogram of each data point in 'data'. If 'hs' is nil,
+// the percentiles are zero.
+func FindRangesLatencyPercentiles(data report.TimeSeries, hs []*hdrhistogram.Histogram, unit int64, totalRequests int64) CumulativeKeyNumToAvgLatencySlice {
 	// need to sort by timestamps because we want the 'cumulative'
-	// trends as we write more keys, 'report.TimeSeries' already implements
-	// sort interface, so just sort.Sort(data)
-	sort.Sort(data)
+	// trends as we write more keys, sort the indexes to keep 'hs'
+	// matched with 'data'
+	idxs := make([]int, len(data))
+	for i := range idxs {
+		idxs[i] = i
+	}
+	sort.SliceStable(idxs, func(i, j int) bool { return data[idxs[i]].Timestamp < data[idxs[j]].Timestamp })
 
 	cumulKeyN := int64(0)
 	maxKey := int64(0)
@@ -71,21 +89,30 @@ func FindRangesLatency(data report.TimeSeries, unit int64, totalRequests int64)
 	// and we want to map number of keys to latency
 	// so the range is the key
 	// and the value is the cumulative throughput
-	for _, ts := range data {
+	for _, i := range idxs {
+		ts := data[i]
 		cumulKeyN += ts.ThroughPut
 		if cumulKeyN < unit {
 			// not enough data points yet
 			continue
 		}
 
+		v := CumulativeKeyNumToAvgLatency{
+			MinLatency: ts.MinLatency,
+			AvgLatency: ts.AvgLatency,
+			MaxLatency: ts.MaxLatency,
+		}
+		if hs != nil {
+			v.P50Latency = time.Duration(hs[i].ValueAtPercentile(50))
+			v.P90Latency = time.Duration(hs[i].ValueAtPercentile(90))
+			v.P99Latency = time.Duration(hs[i].ValueAtPercentile(99))
+			v.P999Latency = time.Duration(hs[i].ValueAtPercentile(99.9))
+		}
+
 		// cumulKeyN >= unit
 		for cumulKeyN > maxKey {
 			maxKey += unit
-			rm[maxKey] = CumulativeKeyNumToAvgLatency{
-				MinLatency: ts.MinLatency,
-				AvgLatency: ts.AvgLatency,
-				MaxLatency: ts.MaxLatency,
-			}
+			rm[maxKey] = v
 		}
 	}
 
@@ -104,12 +131,8 @@ func FindRangesLatency(data report.TimeSeries, unit int64, totalRequests int64)
 
 	for k, v := range rm {
 		// make sure to use 'k' as CumulativeKeyNum
-		kss = append(kss, CumulativeKeyNumToAvgLatency{
-			CumulativeKeyNum: k,
-			MinLatency:       v.MinLatency,
-			AvgLatency:       v.AvgLatency,
-			MaxLatency:       v.MaxLatency,
-		})
+		v.CumulativeKeyNum = k
+		kss = append(kss, v)
 	}
 
 	// sort by cumulative throughput (number of keys) in ascending order
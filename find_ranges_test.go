@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
 	"github.com/coreos/etcd/pkg/report"
 )
 
@@ -125,3 +126,41 @@ func TestFindRangesData(t *testing.T) {
 		}
 	}
 }
+
+func TestFindRangesLatencyPercentiles(t *testing.T) {
+	// out of order, to make sure histograms are sorted with data points
+	data := report.TimeSeries{
+		{Timestamp: 2, AvgLatency: 2 * time.Millisecond, ThroughPut: 100},
+		{Timestamp: 1, AvgLatency: time.Millisecond, ThroughPut: 100},
+	}
+	hs := []*hdrhistogram.Histogram{newSecondHistogram(), newSecondHistogram()}
+	for i := int64(1); i <= 100; i++ {
+		hs[0].Record(int64(time.Duration(i) * 2 * time.Millisecond))
+		hs[1].Record(int64(time.Duration(i) * time.Millisecond))
+	}
+
+	pss := FindRangesLatencyPercentiles(data, hs, 100, 200)
+	if len(pss) != 2 {
+		t.Fatalf("expected 2 ranges, got %+v", pss)
+	}
+	for i, tt := range []struct {
+		keys     int64
+		avg, p50 time.Duration
+		p99      time.Duration
+	}{
+		{100, time.Millisecond, 50 * time.Millisecond, 99 * time.Millisecond},
+		{200, 2 * time.Millisecond, 100 * time.Millisecond, 198 * time.Millisecond},
+	} {
+		ps := pss[i]
+		if ps.CumulativeKeyNum != tt.keys || ps.AvgLatency != tt.avg {
+			t.Fatalf("#%d: unexpected range %+v", i, ps)
+		}
+		// per-second histograms have 2 significant figures
+		if d := ps.P50Latency - tt.p50; d < -tt.p50/100 || d > tt.p50/100 {
+			t.Fatalf("#%d: expected p50 %v, got %v", i, tt.p50, ps.P50Latency)
+		}
+		if d := ps.P99Latency - tt.p99; d < -tt.p99/100 || d > tt.p99/100 {
+			t.Fatalf("#%d: expected p99 %v, got %v", i, tt.p99, ps.P99Latency)
+		}
+	}
+}
@@ -0,0 +1,482 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/errclass"
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/etcd/pkg/report"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// loadGeneratorStartDelay is the time given to load generators
+// to create database connections before they start sending requests.
+const loadGeneratorStartDelay = 10 * time.Second
+
+type loadGeneratorServer struct {
+	// matrix combination name to configuration
+	cfgs map[string]*Config
+
+	mu sync.Mutex
+	// running benchmark to the function that stops sending requests
+	stops map[string]context.CancelFunc
+}
+
+// NewLoadGeneratorServer returns a new load generator server,
+// that stresses the database with the requests assigned by 'control'.
+func NewLoadGeneratorServer(cfgs []*Config) dbtesterpb.LoadGeneratorServer {
+	s := &loadGeneratorServer{
+		cfgs:  make(map[string]*Config, len(cfgs)),
+		stops: make(map[string]context.CancelFunc),
+	}
+	for _, cfg := range cfgs {
+		s.cfgs[cfg.MatrixCombinationName] = cfg
+	}
+	return s
+}
+
+// generateKey returns the key of a running benchmark.
+func generateKey(matrixCombinationName, databaseID string) string {
+	return matrixCombinationName + "/" + databaseID
+}
+
+// Generate sends the assigned number of requests to the database,
+// and returns the stats to be combined in 'control'. If stopped by
+// StopGenerate, it returns the stats of the requests sent so far.
+func (s *loadGeneratorServer) Generate(ctx context.Context, req *dbtesterpb.GenerateRequest) (*dbtesterpb.GenerateResponse, error) {
+	cfg, ok := s.cfgs[req.MatrixCombinationName]
+	if !ok {
+		return nil, fmt.Errorf("matrix combination %q does not exist", req.MatrixCombinationName)
+	}
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[req.DatabaseID]
+	if !ok {
+		return nil, fmt.Errorf("%q does not exist", req.DatabaseID)
+	}
+	// copy the options, which are shared by all requests to this load generator
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	opts.RequestNumber = req.RequestNumber
+	opts.ConnectionNumber = req.ConnectionNumber
+	opts.ClientNumber = req.ClientNumber
+	opts.RateLimitRequestsPerSecond = req.RateLimitRequestsPerSecond
+	gcfg.ConfigClientMachineBenchmarkOptions = &opts
+
+	vals, err := newValues(gcfg)
+	if err != nil {
+		return nil, err
+	}
+
+	plog.Infof("creating %q handlers [database: %q | requests: %d | clients: %d | key offset: %d]",
+		gcfg.ConfigClientMachineBenchmarkOptions.Type, gcfg.DatabaseID, req.RequestNumber, req.ClientNumber, req.KeyOffset)
+	h, done, reqGen, err := newRequestHandlers(gcfg, req.KeyOffset, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	key := generateKey(req.MatrixCombinationName, req.DatabaseID)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	s.mu.Lock()
+	s.stops[key] = cancel
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.stops, key)
+		s.mu.Unlock()
+	}()
+
+	startAt := time.Unix(0, req.StartUnixNano)
+	if d := time.Until(startAt); d > 0 {
+		plog.Infof("waiting %v to start at %v", d, startAt)
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+		}
+	} else {
+		plog.Warningf("start time %v has already passed (%v ago)", startAt, -d)
+	}
+
+	b := newBenchmark(req.RequestNumber, req.ClientNumber, sampleInterval(gcfg), errclass.RulesFor(gcfg.DatabaseID), h, done, reqGen)
+	b.startRequests(ctx)
+	b.waitAll()
+	printStats(b.stats)
+
+	return toGenerateResponse(b.stats), nil
+}
+
+// StopGenerate stops sending requests of the running benchmark.
+func (s *loadGeneratorServer) StopGenerate(ctx context.Context, req *dbtesterpb.StopGenerateRequest) (*dbtesterpb.StopGenerateResponse, error) {
+	key := generateKey(req.MatrixCombinationName, req.DatabaseID)
+	s.mu.Lock()
+	stop, ok := s.stops[key]
+	s.mu.Unlock()
+	if !ok {
+		plog.Warningf("no running benchmark to stop %q", key)
+		return &dbtesterpb.StopGenerateResponse{}, nil
+	}
+	plog.Warningf("stopping %q; returning the stats of the requests sent so far", key)
+	stop()
+	return &dbtesterpb.StopGenerateResponse{}, nil
+}
+
+// newRequestHandlers creates the request handlers and generator of
+// the benchmark type. Writes start from the key index 'keyOffset'.
+func newRequestHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, keyOffset int64, vals values) (h []ReqHandler, done func(), reqGen func(context.Context, chan<- request), err error) {
+	switch gcfg.ConfigClientMachineBenchmarkOptions.Type {
+	case "write":
+		if h, done, err = newWriteHandlers(gcfg); err != nil {
+			return nil, nil, nil, err
+		}
+		reqGen = func(ctx context.Context, inflightReqs chan<- request) {
+			generateWrites(ctx, gcfg, keyOffset, vals, inflightReqs)
+		}
+	case "read":
+		key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
+		if h, done, err = newReadHandlers(gcfg); err != nil {
+			return nil, nil, nil, err
+		}
+		reqGen = func(ctx context.Context, inflightReqs chan<- request) { generateReads(ctx, gcfg, key, inflightReqs) }
+	case "read-oneshot":
+		key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
+		if h, err = newReadOneshotHandlers(gcfg); err != nil {
+			return nil, nil, nil, err
+		}
+		reqGen = func(ctx context.Context, inflightReqs chan<- request) { generateReads(ctx, gcfg, key, inflightReqs) }
+	default:
+		err = fmt.Errorf("%q is not supported", gcfg.ConfigClientMachineBenchmarkOptions.Type)
+	}
+	return h, done, reqGen, err
+}
+
+// generateReportDistributed splits the requests and clients across
+// all load generators, and combines their stats into one report.
+// When the context is canceled, load generators are stopped and
+// the stats of the requests sent so far are saved as partial.
+func (cfg *Config) generateReportDistributed(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl) error {
+	eps := gcfg.ConfigClientMachineBenchmarkOptions.LoadGeneratorEndpoints
+	reqNs := splitNumber(gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, len(eps))
+	connNs := splitNumber(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber, len(eps))
+	clientNs := splitNumber(gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, len(eps))
+	rateNs := splitNumber(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond, len(eps))
+	if rl := gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond; rl > 0 && rl < int64(len(eps)) {
+		return fmt.Errorf("%q got rate limit %d < load generators %d", gcfg.DatabaseID, rl, len(eps))
+	}
+	startAt := time.Now().Add(loadGeneratorStartDelay)
+
+	conns := make([]*grpc.ClientConn, len(eps))
+	for i, ep := range eps {
+		// per-second histograms of long benchmarks can exceed the default 4 MB message size limit
+		conn, err := grpc.Dial(ep, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)))
+		if err != nil {
+			plog.Errorf("grpc.Dial connecting error (%v) [index: %d | endpoint: %q]", err, i, ep)
+			return fmt.Errorf("%v (%q)", err, ep)
+		}
+		defer conn.Close()
+		conns[i] = conn
+	}
+
+	// generate requests are not canceled with 'ctx', which would discard
+	// the stats; load generators are stopped instead, and return the stats
+	stopc := make(chan struct{})
+	defer close(stopc)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stopc:
+			return
+		}
+		plog.Warningf("stopping load generators (%v)", ctx.Err())
+		for i, conn := range conns {
+			sctx, scancel := context.WithTimeout(context.Background(), 10*time.Second)
+			_, err := dbtesterpb.NewLoadGeneratorClient(conn).StopGenerate(sctx, &dbtesterpb.StopGenerateRequest{
+				DatabaseID:            gcfg.DatabaseID,
+				MatrixCombinationName: cfg.MatrixCombinationName,
+			})
+			scancel()
+			if err != nil {
+				plog.Warningf("cli.StopGenerate error (%v) [index: %d | endpoint: %q]", err, i, eps[i])
+			}
+		}
+	}()
+
+	type result struct {
+		idx int
+		st  reportStats
+	}
+	donec, errc := make(chan result), make(chan error)
+	keyOffset := int64(0)
+	for i := range eps {
+		req := &dbtesterpb.GenerateRequest{
+			DatabaseID:       gcfg.DatabaseID,
+			RequestNumber:    reqNs[i],
+			ConnectionNumber: connNs[i],
+			ClientNumber:     clientNs[i],
+			KeyOffset:        keyOffset,
+			StartUnixNano:    startAt.UnixNano(),
+
+			MatrixCombinationName:      cfg.MatrixCombinationName,
+			RateLimitRequestsPerSecond: rateNs[i],
+		}
+		keyOffset += reqNs[i]
+
+		go func(i int, ep string, req *dbtesterpb.GenerateRequest) {
+			plog.Infof("sending generate request [index: %d | endpoint: %q | request: %+v]", i, ep, req)
+
+			cli := dbtesterpb.NewLoadGeneratorClient(conns[i])
+			resp, err := cli.Generate(context.Background(), req)
+			if err != nil {
+				plog.Errorf("cli.Generate error (%v) [index: %d | endpoint: %q]", err, i, ep)
+				errc <- fmt.Errorf("%v (%q)", err, ep)
+				return
+			}
+
+			st, err := fromGenerateResponse(resp)
+			if err != nil {
+				plog.Errorf("fromGenerateResponse error (%v) [index: %d | endpoint: %q]", err, i, ep)
+				errc <- fmt.Errorf("%v (%q)", err, ep)
+				return
+			}
+			plog.Infof("got generate response [index: %d | endpoint: %q | latencies: %d]", i, ep, st.count())
+			donec <- result{idx: i, st: st}
+		}(i, eps[i], req)
+	}
+
+	results := make([]*reportStats, len(eps))
+	var errs []error
+	for cnt := 0; cnt != len(eps); cnt++ {
+		select {
+		case rs := <-donec:
+			st := rs.st
+			results[rs.idx] = &st
+		case err := <-errc:
+			errs = append(errs, err)
+		}
+	}
+	var stats []reportStats
+	for _, st := range results {
+		if st != nil {
+			stats = append(stats, *st)
+		}
+	}
+	if len(errs) > 0 {
+		if ctx.Err() == nil || len(stats) == 0 {
+			return errs[0]
+		}
+		plog.Warningf("%d out of %d load generators failed after interrupt; saving the stats of the others (%v)", len(errs), len(eps), errs[0])
+	}
+
+	plog.Info("combining all load generator reports")
+	combined := combineConcurrentStats(stats)
+	plog.Printf("got total %d data points and total %f seconds (RPS %f)", combined.count(), combined.Total.Seconds(), combined.RPS)
+
+	plog.Info("combined all load generator reports")
+	printStats(combined)
+	cfg.saveAllStats(gcfg, combined, nil, ctx.Err() != nil)
+	return nil
+}
+
+// combineConcurrentStats combines stats of requests that were sent
+// at the same time. Unlike the variable client number stats, data
+// points of the same sample are merged into one.
+func combineConcurrentStats(stats []reportStats) reportStats {
+	combined := reportStats{ErrorDist: make(map[string]int), Histogram: newLatencyHistogram()}
+	samples := make(map[int64]*hdrhistogram.Histogram)
+	errors := make(map[int64]map[string]int64)
+	endpoints := make(map[string][]reportStats)
+	for _, st := range stats {
+		combined.AvgTotal += st.AvgTotal
+		if combined.Total < st.Total {
+			combined.Total = st.Total
+		}
+		combined.Histogram.Merge(st.Histogram)
+
+		for i, dp := range st.TimeSeries {
+			h, ok := samples[dp.Timestamp]
+			if !ok {
+				h = newSecondHistogram()
+				samples[dp.Timestamp] = h
+			}
+			h.Merge(st.SecondHistograms[i])
+			classes, ok := errors[dp.Timestamp]
+			if !ok {
+				classes = make(map[string]int64)
+				errors[dp.Timestamp] = classes
+			}
+			for class, n := range st.SecondErrorClasses[i] {
+				classes[class] += n
+			}
+		}
+
+		for k, v := range st.ErrorDist {
+			combined.ErrorDist[k] += v
+		}
+
+		for ep, est := range st.Endpoints {
+			endpoints[ep] = append(endpoints[ep], *est)
+		}
+	}
+
+	for ep, ests := range endpoints {
+		if combined.Endpoints == nil {
+			combined.Endpoints = make(map[string]*reportStats, len(endpoints))
+		}
+		est := combineConcurrentStats(ests)
+		est.Role = ests[0].Role
+		combined.Endpoints[ep] = &est
+	}
+
+	for ms, h := range samples {
+		var errN int64
+		for _, n := range errors[ms] {
+			errN += n
+		}
+		combined.TimeSeries = append(combined.TimeSeries, toDataPoint(ms, h))
+		combined.SecondHistograms = append(combined.SecondHistograms, h)
+		combined.SecondErrors = append(combined.SecondErrors, errN)
+		combined.SecondErrorClasses = append(combined.SecondErrorClasses, errors[ms])
+	}
+	sort.Sort(&combined)
+
+	computeStats(&combined)
+	return combined
+}
+
+// splitNumber splits 'total' into 'n' numbers, whose sum is 'total'.
+func splitNumber(total int64, n int) []int64 {
+	ns := make([]int64, n)
+	for i := range ns {
+		ns[i] = total / int64(n)
+		if int64(i) < total%int64(n) {
+			ns[i]++
+		}
+	}
+	return ns
+}
+
+func toGenerateResponse(st reportStats) *dbtesterpb.GenerateResponse {
+	resp := &dbtesterpb.GenerateResponse{
+		AvgTotal:         st.AvgTotal,
+		TotalNanoseconds: int64(st.Total),
+		Role:             st.Role,
+		ErrorDist:        make(map[string]int64, len(st.ErrorDist)),
+		Histogram:        toHistogramPb(st.Histogram),
+		TimeSeries:       make([]*dbtesterpb.TimeSeriesDataPoint, len(st.TimeSeries)),
+	}
+	for k, v := range st.ErrorDist {
+		resp.ErrorDist[k] = int64(v)
+	}
+	for i, dp := range st.TimeSeries {
+		resp.TimeSeries[i] = &dbtesterpb.TimeSeriesDataPoint{
+			Timestamp:             dp.Timestamp,
+			MinLatencyNanoseconds: int64(dp.MinLatency),
+			AvgLatencyNanoseconds: int64(dp.AvgLatency),
+			MaxLatencyNanoseconds: int64(dp.MaxLatency),
+			ThroughPut:            dp.ThroughPut,
+			Histogram:             toHistogramPb(st.SecondHistograms[i]),
+			ErrorCount:            st.SecondErrors[i],
+			ErrorClassCounts:      st.SecondErrorClasses[i],
+		}
+	}
+	for ep, est := range st.Endpoints {
+		if resp.Endpoints == nil {
+			resp.Endpoints = make(map[string]*dbtesterpb.GenerateResponse, len(st.Endpoints))
+		}
+		resp.Endpoints[ep] = toGenerateResponse(*est)
+	}
+	return resp
+}
+
+func fromGenerateResponse(resp *dbtesterpb.GenerateResponse) (reportStats, error) {
+	h, err := fromHistogramPb(resp.Histogram)
+	if err != nil {
+		return reportStats{}, err
+	}
+	st := reportStats{
+		AvgTotal:           resp.AvgTotal,
+		Total:              time.Duration(resp.TotalNanoseconds),
+		ErrorDist:          make(map[string]int, len(resp.ErrorDist)),
+		Histogram:          h,
+		TimeSeries:         make(report.TimeSeries, len(resp.TimeSeries)),
+		SecondHistograms:   make([]*hdrhistogram.Histogram, len(resp.TimeSeries)),
+		SecondErrors:       make([]int64, len(resp.TimeSeries)),
+		SecondErrorClasses: make([]map[string]int64, len(resp.TimeSeries)),
+	}
+	for k, v := range resp.ErrorDist {
+		st.ErrorDist[k] = int(v)
+	}
+	for i, dp := range resp.TimeSeries {
+		st.TimeSeries[i] = report.DataPoint{
+			Timestamp:  dp.Timestamp,
+			MinLatency: time.Duration(dp.MinLatencyNanoseconds),
+			AvgLatency: time.Duration(dp.AvgLatencyNanoseconds),
+			MaxLatency: time.Duration(dp.MaxLatencyNanoseconds),
+			ThroughPut: dp.ThroughPut,
+		}
+		st.SecondErrors[i] = dp.ErrorCount
+		st.SecondErrorClasses[i] = make(map[string]int64, len(dp.ErrorClassCounts))
+		for class, n := range dp.ErrorClassCounts {
+			st.SecondErrorClasses[i][class] = n
+		}
+		if st.SecondHistograms[i], err = fromHistogramPb(dp.Histogram); err != nil {
+			return reportStats{}, err
+		}
+	}
+	for ep, eresp := range resp.Endpoints {
+		est, err := fromGenerateResponse(eresp)
+		if err != nil {
+			return reportStats{}, err
+		}
+		if st.Endpoints == nil {
+			st.Endpoints = make(map[string]*reportStats, len(resp.Endpoints))
+		}
+		st.Endpoints[ep] = &est
+	}
+	return st, nil
+}
+
+func toHistogramPb(h *hdrhistogram.Histogram) *dbtesterpb.Histogram {
+	s := h.Export()
+	return &dbtesterpb.Histogram{
+		LowestTrackableValue:  s.LowestTrackableValue,
+		HighestTrackableValue: s.HighestTrackableValue,
+		SignificantFigures:    s.SignificantFigures,
+		Min:                   s.Min,
+		Max:                   s.Max,
+		Sum:                   s.Sum,
+		Counts:                s.Counts,
+	}
+}
+
+func fromHistogramPb(h *dbtesterpb.Histogram) (*hdrhistogram.Histogram, error) {
+	if h == nil {
+		return nil, fmt.Errorf("got no histogram")
+	}
+	return hdrhistogram.Import(&hdrhistogram.Snapshot{
+		LowestTrackableValue:  h.LowestTrackableValue,
+		HighestTrackableValue: h.HighestTrackableValue,
+		SignificantFigures:    h.SignificantFigures,
+		Min:                   h.Min,
+		Max:                   h.Max,
+		Sum:                   h.Sum,
+		Counts:                h.Counts,
+	})
+}
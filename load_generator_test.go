@@ -0,0 +1,258 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/errclass"
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/etcd/pkg/report"
+	"golang.org/x/net/context"
+)
+
+func Test_splitNumber(t *testing.T) {
+	ns := splitNumber(1000, 3)
+	expected := []int64{334, 333, 333}
+	if !reflect.DeepEqual(ns, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, ns)
+	}
+}
+
+func Test_combineConcurrentStats(t *testing.T) {
+	newHistogram := func(lats ...time.Duration) *hdrhistogram.Histogram {
+		h := newSecondHistogram()
+		for _, lat := range lats {
+			h.Record(int64(lat))
+		}
+		return h
+	}
+	stats := []reportStats{
+		{
+			AvgTotal:  0.3,
+			Total:     2 * time.Second,
+			ErrorDist: map[string]int{"timeout": 1},
+			Histogram: newHistogram(100*time.Millisecond, 200*time.Millisecond),
+			TimeSeries: report.TimeSeries{
+				{Timestamp: 1, MinLatency: time.Millisecond, AvgLatency: 2 * time.Millisecond, MaxLatency: 3 * time.Millisecond, ThroughPut: 3},
+				{Timestamp: 2, MinLatency: time.Millisecond, AvgLatency: time.Millisecond, MaxLatency: time.Millisecond, ThroughPut: 1},
+			},
+			SecondHistograms: []*hdrhistogram.Histogram{
+				newHistogram(time.Millisecond, 2*time.Millisecond, 3*time.Millisecond),
+				newHistogram(time.Millisecond),
+			},
+			SecondErrors:       []int64{0, 1},
+			SecondErrorClasses: []map[string]int64{{}, {errclass.Timeout: 1}},
+		},
+		{
+			AvgTotal:  0.3,
+			Total:     time.Second,
+			ErrorDist: map[string]int{"timeout": 2},
+			Histogram: newHistogram(300*time.Millisecond, 400*time.Millisecond),
+			TimeSeries: report.TimeSeries{
+				{Timestamp: 1, MinLatency: 2 * time.Millisecond, AvgLatency: 5 * time.Millisecond, MaxLatency: 8 * time.Millisecond, ThroughPut: 3},
+			},
+			SecondHistograms: []*hdrhistogram.Histogram{
+				newHistogram(2*time.Millisecond, 5*time.Millisecond, 8*time.Millisecond),
+			},
+			SecondErrors:       []int64{2},
+			SecondErrorClasses: []map[string]int64{{errclass.Timeout: 1, errclass.LeaderChanged: 1}},
+		},
+	}
+	combined := combineConcurrentStats(stats)
+
+	expected := report.TimeSeries{
+		{Timestamp: 1, MinLatency: time.Millisecond, AvgLatency: 3500 * time.Microsecond, MaxLatency: 8 * time.Millisecond, ThroughPut: 6},
+		{Timestamp: 2, MinLatency: time.Millisecond, AvgLatency: time.Millisecond, MaxLatency: time.Millisecond, ThroughPut: 1},
+	}
+	if !reflect.DeepEqual(combined.TimeSeries, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, combined.TimeSeries)
+	}
+	if len(combined.SecondHistograms) != 2 || combined.SecondHistograms[0].Count() != 6 {
+		t.Fatalf("unexpected second histograms %+v", combined.SecondHistograms)
+	}
+	if !reflect.DeepEqual(combined.SecondErrors, []int64{2, 1}) {
+		t.Fatalf("expected second errors [2 1], got %v", combined.SecondErrors)
+	}
+	expectedClasses := []map[string]int64{{errclass.Timeout: 1, errclass.LeaderChanged: 1}, {errclass.Timeout: 1}}
+	if !reflect.DeepEqual(combined.SecondErrorClasses, expectedClasses) {
+		t.Fatalf("expected second error classes %v, got %v", expectedClasses, combined.SecondErrorClasses)
+	}
+	if combined.Total != 2*time.Second {
+		t.Fatalf("expected total %v, got %v", 2*time.Second, combined.Total)
+	}
+	if combined.ErrorDist["timeout"] != 3 {
+		t.Fatalf("expected 3 errors, got %d", combined.ErrorDist["timeout"])
+	}
+	if combined.Fastest != 0.1 || combined.Slowest != 0.4 {
+		t.Fatalf("unexpected fastest %f, slowest %f", combined.Fastest, combined.Slowest)
+	}
+}
+
+func Test_generateResponse(t *testing.T) {
+	r := newLatencyReport(time.Second, errclass.RulesFor("etcd__v3_2"))
+	go func() {
+		start := time.Unix(100, 0)
+		for i := 1; i <= 1000; i++ {
+			r.Results() <- result{Result: report.Result{Start: start, End: start.Add(time.Duration(i) * time.Millisecond)}}
+		}
+		r.Results() <- result{Result: report.Result{Start: start, End: start, Err: errors.New("context deadline exceeded")}}
+		close(r.Results())
+	}()
+	st := <-r.Stats()
+	if st.count() != 1000 || st.ErrorDist["context deadline exceeded"] != 1 || len(st.TimeSeries) != 1 || st.SecondErrors[0] != 1 {
+		t.Fatalf("unexpected stats %+v", st)
+	}
+
+	st2, err := fromGenerateResponse(toGenerateResponse(st))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(st2.percentiles(), st.percentiles()) {
+		t.Fatalf("expected percentiles %v, got %v", st.percentiles(), st2.percentiles())
+	}
+	if !reflect.DeepEqual(st2.TimeSeries, st.TimeSeries) || st2.SecondHistograms[0].Count() != 1000 || st2.SecondErrors[0] != 1 {
+		t.Fatalf("expected time series %+v, got %+v", st.TimeSeries, st2.TimeSeries)
+	}
+	if !reflect.DeepEqual(st2.SecondErrorClasses, []map[string]int64{{errclass.Timeout: 1}}) {
+		t.Fatalf("unexpected second error classes %v", st2.SecondErrorClasses)
+	}
+}
+
+func Test_latencyReportSampleInterval(t *testing.T) {
+	r := newLatencyReport(100*time.Millisecond, errclass.RulesFor("etcd__v3_2"))
+	go func() {
+		start := time.Unix(100, 0)
+		for _, d := range []time.Duration{0, 50 * time.Millisecond, 120 * time.Millisecond, 350 * time.Millisecond} {
+			r.Results() <- result{Result: report.Result{Start: start.Add(d), End: start.Add(d + time.Millisecond)}}
+		}
+		r.Results() <- result{Result: report.Result{Start: start.Add(250 * time.Millisecond), End: start.Add(250 * time.Millisecond), Err: errors.New("timeout")}}
+		close(r.Results())
+	}()
+	st := <-r.Stats()
+
+	var timestamps, throughputs []int64
+	for _, dp := range st.TimeSeries {
+		timestamps = append(timestamps, dp.Timestamp)
+		throughputs = append(throughputs, dp.ThroughPut)
+	}
+	if !reflect.DeepEqual(timestamps, []int64{100000, 100100, 100200, 100300}) {
+		t.Fatalf("unexpected timestamps %v", timestamps)
+	}
+	if !reflect.DeepEqual(throughputs, []int64{2, 1, 0, 1}) {
+		t.Fatalf("unexpected throughputs %v", throughputs)
+	}
+	if !reflect.DeepEqual(st.SecondErrors, []int64{0, 0, 1, 0}) {
+		t.Fatalf("unexpected errors %v", st.SecondErrors)
+	}
+}
+
+func Test_latencyReportEndpoints(t *testing.T) {
+	r := newLatencyReport(time.Second, errclass.RulesFor("etcd__v3_2"))
+	go func() {
+		start := time.Unix(100, 0)
+		leader, follower := dialedEndpoint{endpoint: "a:2379", role: roleLeader}, dialedEndpoint{endpoint: "b:2379", role: roleFollower}
+		for i := 1; i <= 10; i++ {
+			r.Results() <- result{Result: report.Result{Start: start, End: start.Add(time.Millisecond)}, endpoint: leader}
+			r.Results() <- result{Result: report.Result{Start: start, End: start.Add(100 * time.Millisecond)}, endpoint: follower}
+		}
+		r.Results() <- result{Result: report.Result{Start: start, End: start, Err: errors.New("etcdserver: leader changed")}, endpoint: follower}
+		close(r.Results())
+	}()
+	st := <-r.Stats()
+	if st.count() != 20 || len(st.Endpoints) != 2 {
+		t.Fatalf("unexpected stats %+v", st)
+	}
+	a, b := st.Endpoints["a:2379"], st.Endpoints["b:2379"]
+	if a.Role != roleLeader || a.count() != 10 || a.Slowest != 0.001 || len(a.ErrorDist) != 0 {
+		t.Fatalf("unexpected leader stats %+v", a)
+	}
+	if b.Role != roleFollower || b.count() != 10 || b.Slowest != 0.1 || !reflect.DeepEqual(b.SecondErrors, []int64{1}) {
+		t.Fatalf("unexpected follower stats %+v", b)
+	}
+
+	// stats of two load generators
+	st2, err := fromGenerateResponse(toGenerateResponse(st))
+	if err != nil {
+		t.Fatal(err)
+	}
+	combined := combineConcurrentStats([]reportStats{st, st2})
+	if len(combined.Endpoints) != 2 {
+		t.Fatalf("expected 2 endpoints, got %+v", combined.Endpoints)
+	}
+	b = combined.Endpoints["b:2379"]
+	if b.Role != roleFollower || b.count() != 20 || !reflect.DeepEqual(b.SecondErrors, []int64{2}) {
+		t.Fatalf("unexpected combined follower stats %+v", b)
+	}
+}
+
+func Test_loadGeneratorServerStopGenerate(t *testing.T) {
+	s := NewLoadGeneratorServer(nil).(*loadGeneratorServer)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.stops[generateKey("client-number-100", "etcd__v3_2")] = cancel
+
+	// other benchmarks are not stopped
+	if _, err := s.StopGenerate(context.Background(), &dbtesterpb.StopGenerateRequest{DatabaseID: "etcd__v3_2"}); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.Err() != nil {
+		t.Fatal("expected running benchmark of other matrix combination")
+	}
+	if _, err := s.StopGenerate(context.Background(), &dbtesterpb.StopGenerateRequest{DatabaseID: "etcd__v3_2", MatrixCombinationName: "client-number-100"}); err != nil {
+		t.Fatal(err)
+	}
+	if ctx.Err() == nil {
+		t.Fatal("expected benchmark to be stopped")
+	}
+}
+
+func Test_loadGeneratorServerGenerateCopiesOptions(t *testing.T) {
+	opts := &dbtesterpb.ConfigClientMachineBenchmarkOptions{Type: "unknown", RequestNumber: 100, ConnectionNumber: 10, ClientNumber: 10}
+	cfg := &Config{DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+		"etcd__v3_2": {DatabaseID: "etcd__v3_2", ConfigClientMachineBenchmarkOptions: opts},
+	}}
+	s := NewLoadGeneratorServer([]*Config{cfg})
+	if _, err := s.Generate(context.Background(), &dbtesterpb.GenerateRequest{
+		DatabaseID:                 "etcd__v3_2",
+		RequestNumber:              50,
+		ConnectionNumber:           5,
+		ClientNumber:               5,
+		RateLimitRequestsPerSecond: 1000,
+	}); err == nil {
+		t.Fatal("expected error for unknown benchmark type")
+	}
+	expected := dbtesterpb.ConfigClientMachineBenchmarkOptions{Type: "unknown", RequestNumber: 100, ConnectionNumber: 10, ClientNumber: 10}
+	if !reflect.DeepEqual(*opts, expected) {
+		t.Fatalf("expected options %+v unchanged, got %+v", expected, *opts)
+	}
+}
+
+func Test_newRequestHandlersUnknownDatabase(t *testing.T) {
+	for _, typ := range []string{"write", "read", "read-oneshot"} {
+		gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+			DatabaseID:                          "unknown",
+			DatabaseEndpoints:                   []string{"127.0.0.1:2379"},
+			ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{Type: typ, ConnectionNumber: 1, ClientNumber: 1},
+		}
+		if _, _, _, err := newRequestHandlers(gcfg, 0, values{}); err == nil {
+			t.Fatalf("%q: expected error for unknown database", typ)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/errclass"
+	"golang.org/x/net/context"
+)
+
+// metricsLatencyBuckets are the upper bounds of request latency histogram, in seconds.
+var metricsLatencyBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// metricsErrorIndex maps the error class to the index of errclass.Classes.
+var metricsErrorIndex = make(map[string]int, len(errclass.Classes))
+
+func init() {
+	for i, class := range errclass.Classes {
+		metricsErrorIndex[class] = i
+	}
+}
+
+// Metrics exposes the live request metrics of benchmarks in
+// Prometheus text format, labeled by database ID. Counters of
+// each database are kept across databases and runs. Requests
+// are recorded with atomics; the lock is only for the series.
+type Metrics struct {
+	mu         sync.Mutex
+	databaseID string
+	series     map[string]*metricsSeries
+
+	// current is the *metricsSeries of the database in benchmarks,
+	// nil if stopped
+	current atomic.Value
+}
+
+// metricsSeries is the metrics of one database.
+// All fields are accessed atomically.
+type metricsSeries struct {
+	requests     int64
+	inflight     int64
+	clientNumber int64
+	step         int64
+	sumNanos     int64
+
+	// errors are by index of errclass.Classes
+	errors  []int64
+	buckets []int64
+}
+
+// NewMetrics returns a new Metrics.
+func NewMetrics() *Metrics {
+	m := &Metrics{series: make(map[string]*metricsSeries)}
+	m.current.Store((*metricsSeries)(nil))
+	return m
+}
+
+// Start starts recording the requests of the database in benchmarks.
+func (m *Metrics) Start(cfg *Config, databaseID string) {
+	m.mu.Lock()
+	m.databaseID = databaseID
+	s, ok := m.series[databaseID]
+	if !ok {
+		s = &metricsSeries{errors: make([]int64, len(errclass.Classes)), buckets: make([]int64, len(metricsLatencyBuckets))}
+		m.series[databaseID] = s
+	}
+	m.current.Store(s)
+	m.mu.Unlock()
+	cfg.metrics = m
+}
+
+// Stop stops recording the requests, and resets the gauges of the database.
+func (m *Metrics) Stop(cfg *Config) {
+	cfg.metrics = nil
+	m.mu.Lock()
+	m.current.Store((*metricsSeries)(nil))
+	if s, ok := m.series[m.databaseID]; ok {
+		atomic.StoreInt64(&s.inflight, 0)
+		atomic.StoreInt64(&s.clientNumber, 0)
+		atomic.StoreInt64(&s.step, 0)
+	}
+	m.databaseID = ""
+	m.mu.Unlock()
+}
+
+// get returns the series of current database, nil if stopped.
+func (m *Metrics) get() *metricsSeries {
+	if m == nil {
+		return nil
+	}
+	return m.current.Load().(*metricsSeries)
+}
+
+// setClientNumber sets the number of clients, and the 1-based index of
+// the client number step ('connection_client_numbers'), zero if fixed.
+func (m *Metrics) setClientNumber(clientN, step int64) {
+	s := m.get()
+	if s == nil {
+		return
+	}
+	atomic.StoreInt64(&s.clientNumber, clientN)
+	atomic.StoreInt64(&s.step, step)
+}
+
+// begin records a request in flight.
+func (m *Metrics) begin() {
+	if s := m.get(); s != nil {
+		atomic.AddInt64(&s.inflight, 1)
+	}
+}
+
+// end records the latency and error class of a finished request, where
+// 'class' is empty on success. Requests that are not reported (e.g.
+// canceled by interrupts) are ended with 'report' false.
+func (m *Metrics) end(start, end time.Time, class string, report bool) {
+	s := m.get()
+	if s == nil {
+		return
+	}
+	atomic.AddInt64(&s.inflight, -1)
+	if !report {
+		return
+	}
+	atomic.AddInt64(&s.requests, 1)
+	if class != "" {
+		atomic.AddInt64(&s.errors[metricsErrorIndex[class]], 1)
+	}
+	dur := end.Sub(start)
+	atomic.AddInt64(&s.sumNanos, int64(dur))
+	sec := dur.Seconds()
+	for i, le := range metricsLatencyBuckets {
+		if sec <= le {
+			atomic.AddInt64(&s.buckets[i], 1)
+		}
+	}
+}
+
+// ServeHTTP serves the metrics in Prometheus text format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics in Prometheus text format.
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	ids := make([]string, 0, len(m.series))
+	for id := range m.series {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var buf bytes.Buffer
+	family := func(name, typ, help string, fn func(id string, s *metricsSeries)) {
+		fmt.Fprintf(&buf, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+		for _, id := range ids {
+			fn(id, m.series[id])
+		}
+	}
+	family("dbtester_client_requests_total", "counter", "Total number of finished requests.", func(id string, s *metricsSeries) {
+		fmt.Fprintf(&buf, "dbtester_client_requests_total{database_id=%q} %d\n", id, atomic.LoadInt64(&s.requests))
+	})
+	family("dbtester_client_errors_total", "counter", "Total number of failed requests by error class.", func(id string, s *metricsSeries) {
+		for i, class := range errclass.Classes {
+			fmt.Fprintf(&buf, "dbtester_client_errors_total{database_id=%q,class=%q} %d\n", id, class, atomic.LoadInt64(&s.errors[i]))
+		}
+	})
+	family("dbtester_client_inflight_requests", "gauge", "Number of requests in flight.", func(id string, s *metricsSeries) {
+		fmt.Fprintf(&buf, "dbtester_client_inflight_requests{database_id=%q} %d\n", id, atomic.LoadInt64(&s.inflight))
+	})
+	family("dbtester_client_number", "gauge", "Number of clients of the current benchmark.", func(id string, s *metricsSeries) {
+		fmt.Fprintf(&buf, "dbtester_client_number{database_id=%q} %d\n", id, atomic.LoadInt64(&s.clientNumber))
+	})
+	family("dbtester_client_number_step", "gauge", "1-based index of the current client number step, 0 if the client number is fixed.", func(id string, s *metricsSeries) {
+		fmt.Fprintf(&buf, "dbtester_client_number_step{database_id=%q} %d\n", id, atomic.LoadInt64(&s.step))
+	})
+	family("dbtester_client_request_duration_seconds", "histogram", "Latency of finished requests.", func(id string, s *metricsSeries) {
+		// requests may finish while writing, so the count is
+		// loaded first not to be less than the buckets
+		requests := atomic.LoadInt64(&s.requests)
+		for i, le := range metricsLatencyBuckets {
+			n := atomic.LoadInt64(&s.buckets[i])
+			if n > requests {
+				n = requests
+			}
+			fmt.Fprintf(&buf, "dbtester_client_request_duration_seconds_bucket{database_id=%q,le=%q} %d\n", id, fmt.Sprint(le), n)
+		}
+		fmt.Fprintf(&buf, "dbtester_client_request_duration_seconds_bucket{database_id=%q,le=\"+Inf\"} %d\n", id, requests)
+		fmt.Fprintf(&buf, "dbtester_client_request_duration_seconds_sum{database_id=%q} %g\n", id, time.Duration(atomic.LoadInt64(&s.sumNanos)).Seconds())
+		fmt.Fprintf(&buf, "dbtester_client_request_duration_seconds_count{database_id=%q} %d\n", id, requests)
+	})
+	m.mu.Unlock()
+
+	return buf.WriteTo(w)
+}
+
+// Push pushes the metrics to the Prometheus push gateway at 'url'
+// (e.g. 'http://localhost:9091'), grouped by job 'dbtester'.
+func (m *Metrics) Push(url string) error {
+	var buf bytes.Buffer
+	m.WriteTo(&buf)
+
+	req, err := http.NewRequest(http.MethodPut, strings.TrimSuffix(url, "/")+"/metrics/job/dbtester", &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; version=0.0.4")
+	cli := &http.Client{Timeout: 10 * time.Second}
+	resp, err := cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%q returned %q", url, resp.Status)
+	}
+	return nil
+}
+
+// PushEvery pushes the metrics every 'interval' until the context is
+// canceled, and pushes once more on cancel so that the last values are kept.
+func (m *Metrics) PushEvery(ctx context.Context, url string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.Push(url); err != nil {
+				plog.Warningf("failed to push metrics (%v)", err)
+			}
+		case <-ctx.Done():
+			if err := m.Push(url); err != nil {
+				plog.Warningf("failed to push metrics (%v)", err)
+			}
+			return
+		}
+	}
+}
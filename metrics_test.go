@@ -0,0 +1,142 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/errclass"
+)
+
+func TestMetrics(t *testing.T) {
+	cfg := &Config{}
+	m := NewMetrics()
+	m.Start(cfg, "etcd__v3_2")
+	if cfg.metrics != m {
+		t.Fatal("expected metrics to be started")
+	}
+
+	start := time.Unix(100, 0)
+	cfg.metrics.setClientNumber(10, 2)
+	for _, tt := range []struct {
+		latency time.Duration
+		class   string
+		report  bool
+	}{
+		{time.Millisecond, "", true},
+		{20 * time.Millisecond, "", true},
+		{3 * time.Second, errclass.Timeout, true},
+		{time.Millisecond, "", false},
+	} {
+		cfg.metrics.begin()
+		cfg.metrics.end(start, start.Add(tt.latency), tt.class, tt.report)
+	}
+	cfg.metrics.begin()
+
+	var buf bytes.Buffer
+	m.WriteTo(&buf)
+	for _, line := range []string{
+		`dbtester_client_requests_total{database_id="etcd__v3_2"} 3`,
+		`dbtester_client_errors_total{database_id="etcd__v3_2",class="TIMEOUT"} 1`,
+		`dbtester_client_inflight_requests{database_id="etcd__v3_2"} 1`,
+		`dbtester_client_number{database_id="etcd__v3_2"} 10`,
+		`dbtester_client_number_step{database_id="etcd__v3_2"} 2`,
+		`dbtester_client_request_duration_seconds_bucket{database_id="etcd__v3_2",le="0.001"} 1`,
+		`dbtester_client_request_duration_seconds_bucket{database_id="etcd__v3_2",le="0.025"} 2`,
+		`dbtester_client_request_duration_seconds_bucket{database_id="etcd__v3_2",le="+Inf"} 3`,
+		`dbtester_client_request_duration_seconds_count{database_id="etcd__v3_2"} 3`,
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Fatalf("expected %q in\n%s", line, buf.String())
+		}
+	}
+
+	m.Stop(cfg)
+	if cfg.metrics != nil {
+		t.Fatal("expected metrics to be stopped")
+	}
+	buf.Reset()
+	m.WriteTo(&buf)
+	if !strings.Contains(buf.String(), `dbtester_client_inflight_requests{database_id="etcd__v3_2"} 0`) {
+		t.Fatalf("expected gauges to be reset\n%s", buf.String())
+	}
+}
+
+func TestMetricsConcurrent(t *testing.T) {
+	cfg := &Config{}
+	m := NewMetrics()
+	m.Start(cfg, "etcd__v3_2")
+
+	start := time.Unix(100, 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				cfg.metrics.begin()
+				cfg.metrics.end(start, start.Add(time.Millisecond), errclass.Timeout, true)
+				m.WriteTo(ioutil.Discard)
+			}
+		}()
+	}
+	wg.Wait()
+	m.Stop(cfg)
+
+	// requests after stop are not recorded
+	m.begin()
+	m.end(start, start, "", true)
+
+	var buf bytes.Buffer
+	m.WriteTo(&buf)
+	for _, line := range []string{
+		`dbtester_client_requests_total{database_id="etcd__v3_2"} 1000`,
+		`dbtester_client_errors_total{database_id="etcd__v3_2",class="TIMEOUT"} 1000`,
+		`dbtester_client_request_duration_seconds_bucket{database_id="etcd__v3_2",le="0.001"} 1000`,
+		`dbtester_client_request_duration_seconds_sum{database_id="etcd__v3_2"} 1`,
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Fatalf("expected %q in\n%s", line, buf.String())
+		}
+	}
+}
+
+func TestMetricsPush(t *testing.T) {
+	var method, path, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bts, _ := ioutil.ReadAll(r.Body)
+		method, path, body = r.Method, r.URL.Path, string(bts)
+	}))
+	defer ts.Close()
+
+	m := NewMetrics()
+	m.Start(&Config{}, "zookeeper__r3_5_3_beta")
+	if err := m.Push(ts.URL + "/"); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/metrics/job/dbtester" {
+		t.Fatalf("unexpected push %s %s", method, path)
+	}
+	if !strings.Contains(body, `dbtester_client_requests_total{database_id="zookeeper__r3_5_3_beta"} 0`) {
+		t.Fatalf("unexpected body\n%s", body)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package errclass normalizes client errors of databases into error classes.
+package errclass
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Error classes.
+const (
+	Timeout           = "TIMEOUT"
+	ConnectionRefused = "CONNECTION-REFUSED"
+	LeaderChanged     = "LEADER-CHANGED"
+	QuotaExceeded     = "QUOTA-EXCEEDED"
+	NoNode            = "NO-NODE"
+	VersionConflict   = "VERSION-CONFLICT"
+	RateLimited       = "RATE-LIMITED"
+	Other             = "OTHER"
+)
+
+// Classes are all error classes, in order.
+var Classes = []string{
+	Timeout,
+	ConnectionRefused,
+	LeaderChanged,
+	QuotaExceeded,
+	NoNode,
+	VersionConflict,
+	RateLimited,
+	Other,
+}
+
+// Rule classifies the error message that matches the pattern.
+type Rule struct {
+	Class   string
+	Pattern *regexp.Regexp
+}
+
+// EtcdRules are the rules for etcd client errors.
+var EtcdRules = []Rule{
+	{Class: LeaderChanged, Pattern: regexp.MustCompile(`etcdserver: (leader changed|no leader|not leader)|possibly due to previous leader failure`)},
+	{Class: QuotaExceeded, Pattern: regexp.MustCompile(`database space exceeded|etcdserver: request is too large`)},
+	{Class: RateLimited, Pattern: regexp.MustCompile(`etcdserver: too many requests`)},
+	{Class: Timeout, Pattern: regexp.MustCompile(`etcdserver: request timed out`)},
+	{Class: NoNode, Pattern: regexp.MustCompile(`Key not found`)},
+	{Class: VersionConflict, Pattern: regexp.MustCompile(`Compare failed`)},
+}
+
+// ZookeeperRules are the rules for Zookeeper client errors.
+var ZookeeperRules = []Rule{
+	{Class: NoNode, Pattern: regexp.MustCompile(`zk: node does not exist`)},
+	{Class: VersionConflict, Pattern: regexp.MustCompile(`zk: (version conflict|node already exists)`)},
+	{Class: ConnectionRefused, Pattern: regexp.MustCompile(`zk: (could not connect to a server|connection closed|session has been expired)`)},
+}
+
+// ConsulRules are the rules for Consul client errors.
+var ConsulRules = []Rule{
+	{Class: LeaderChanged, Pattern: regexp.MustCompile(`(?i)no cluster leader|leadership lost`)},
+	{Class: RateLimited, Pattern: regexp.MustCompile(`Unexpected response code: 429`)},
+	{Class: QuotaExceeded, Pattern: regexp.MustCompile(`Unexpected response code: 413|Value exceeds \d+ byte limit`)},
+}
+
+// commonRules are matched after the rules of each database.
+var commonRules = []Rule{
+	{Class: RateLimited, Pattern: regexp.MustCompile(`(?i)too many requests|rate: Wait`)},
+	{Class: Timeout, Pattern: regexp.MustCompile(`(?i)context deadline exceeded|DeadlineExceeded|i/o timeout|Client\.Timeout exceeded|timed out`)},
+	{Class: ConnectionRefused, Pattern: regexp.MustCompile(`connection refused|connection reset by peer|no route to host|broken pipe|transport is closing`)},
+}
+
+// RulesFor returns the rules for the database ID, followed by the rules
+// common to all databases. zetcd and cetcd use the Zookeeper and Consul
+// rules, since clients talk to the proxies with those protocols.
+func RulesFor(databaseID string) []Rule {
+	var rules []Rule
+	switch strings.Split(databaseID, "__")[0] {
+	case "etcd":
+		rules = EtcdRules
+	case "zookeeper", "zetcd":
+		rules = ZookeeperRules
+	case "consul", "cetcd":
+		rules = ConsulRules
+	}
+	return append(append([]Rule(nil), rules...), commonRules...)
+}
+
+// Classify returns the class of the first rule that matches the error
+// message, or 'Other' if none matches.
+func Classify(rules []Rule, msg string) string {
+	for _, r := range rules {
+		if r.Pattern.MatchString(msg) {
+			return r.Class
+		}
+	}
+	return Other
+}
+
+// Count returns the number of errors by class, from the number of errors
+// by error message.
+func Count(rules []Rule, errorDist map[string]int) map[string]int {
+	classes := make(map[string]int)
+	for msg, n := range errorDist {
+		classes[Classify(rules, msg)] += n
+	}
+	return classes
+}
@@ -0,0 +1,64 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errclass
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		databaseID string
+		msg        string
+		exp        string
+	}{
+		{"etcd__v3_2", "context deadline exceeded", Timeout},
+		{"etcd__v3_2", "etcdserver: request timed out", Timeout},
+		{"etcd__v3_2", "etcdserver: request timed out, possibly due to previous leader failure", LeaderChanged},
+		{"etcd__v3_2", "etcdserver: leader changed", LeaderChanged},
+		{"etcd__v3_2", "etcdserver: mvcc: database space exceeded", QuotaExceeded},
+		{"etcd__v3_2", "etcdserver: too many requests", RateLimited},
+		{"etcd__v3_2", "rpc error: code = Unavailable desc = grpc: the connection is unavailable: dial tcp 10.0.0.1:2379: getsockopt: connection refused", ConnectionRefused},
+		{"etcd__v2_3", "100: Key not found (/foo) [3]", NoNode},
+		{"etcd__v2_3", "101: Compare failed ([1 != 2]) [3]", VersionConflict},
+		{"zookeeper__r3_4_9", "zk: node does not exist", NoNode},
+		{"zookeeper__r3_4_9", "zk: version conflict", VersionConflict},
+		{"zookeeper__r3_4_9", "zk: could not connect to a server", ConnectionRefused},
+		{"zetcd__beta", `"zk: node does not exist" while getting "/foo"`, NoNode},
+		{"consul__v0_8_4", "Unexpected response code: 500 (No cluster leader)", LeaderChanged},
+		{"consul__v0_8_4", "Unexpected response code: 429 (Too Many Requests)", RateLimited},
+		{"consul__v0_8_4", "Unexpected response code: 413 (Value exceeds 524288 byte limit)", QuotaExceeded},
+		{"cetcd__beta", "Put http://10.0.0.1:8500/v1/kv/foo: net/http: request canceled (Client.Timeout exceeded while awaiting headers)", Timeout},
+		{"consul__v0_8_4", "unknown error", Other},
+	}
+	for i, tt := range tests {
+		if c := Classify(RulesFor(tt.databaseID), tt.msg); c != tt.exp {
+			t.Fatalf("#%d: %q expected %q, got %q", i, tt.msg, tt.exp, c)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	classes := Count(RulesFor("etcd__v3_2"), map[string]int{
+		"context deadline exceeded":     3,
+		"etcdserver: request timed out": 2,
+		"etcdserver: leader changed":    1,
+	})
+	exp := map[string]int{Timeout: 5, LeaderChanged: 1}
+	if !reflect.DeepEqual(classes, exp) {
+		t.Fatalf("expected %v, got %v", exp, classes)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gclog parses JVM garbage collection logs into pauses.
+package gclog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Pause is a stop-the-world garbage collection pause.
+type Pause struct {
+	Time     time.Time
+	Type     string
+	Duration time.Duration
+
+	HeapBeforeBytes uint64
+	HeapAfterBytes  uint64
+}
+
+// TimeLayout is the time layout of '-XX:+PrintGCDateStamps' and
+// '-Xlog:gc:<file>:time' decorations.
+const TimeLayout = "2006-01-02T15:04:05.000-0700"
+
+var (
+	// e.g. '2017-06-01T12:00:00.123+0000: 1.234: [GC (Allocation Failure) [PSYoungGen: 33280K->5104K(38400K)] 33280K->5112K(125952K), 0.0041220 secs] [Times: user=0.01 sys=0.00, real=0.00 secs]'
+	legacyLine  = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}T\S+): [0-9.]+: \[(Full GC|GC)`)
+	legacyHeap  = regexp.MustCompile(`(\d+[BKMG])->(\d+[BKMG])\(\d+[BKMG]\)`)
+	legacySecs  = regexp.MustCompile(`([0-9.]+) secs\]`)
+	legacyMeta  = regexp.MustCompile(`\[(Metaspace|PSPermGen|CMS Perm)[^\]]*\]`)
+	legacyTimes = regexp.MustCompile(`\[Times:.*$`)
+
+	// e.g. '   [Eden: 24.0M(24.0M)->0.0B(13.0M) Survivors: 0.0B->3072.0K Heap: 24.0M(256.0M)->3.5M(256.0M)]'
+	g1Heap = regexp.MustCompile(`Heap: ([0-9.]+[BKMG])\([0-9.]+[BKMG]\)->([0-9.]+[BKMG])\(`)
+
+	// e.g. '[2017-06-01T12:00:00.123+0000][info][gc] GC(0) Pause Young (G1 Evacuation Pause) 24M->3M(256M) 5.123ms'
+	unifiedLine = regexp.MustCompile(`^\[(\d{4}-\d{2}-\d{2}T[^\]]+)\].* GC\(\d+\) (Pause [A-Za-z ]+?)(?: \(.*\))? ([0-9.]+[BKMG])->([0-9.]+[BKMG])\([0-9.]+[BKMG]\) ([0-9.]+)ms$`)
+)
+
+// Parse parses the JVM garbage collection log. It supports the log of
+// '-XX:+PrintGCDetails -XX:+PrintGCDateStamps' (Java 8), and the log of
+// '-Xlog:gc:<file>:time' (Java 9 or later). Lines without date stamps
+// are ignored.
+func Parse(r io.Reader) ([]Pause, error) {
+	var ps []Pause
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \r")
+
+		if m := unifiedLine.FindStringSubmatch(line); m != nil {
+			p, err := newPause(m[1], m[2], m[5]+"ms", m[3], m[4])
+			if err != nil {
+				return nil, err
+			}
+			ps = append(ps, p)
+			continue
+		}
+
+		if m := legacyLine.FindStringSubmatch(line); m != nil {
+			rest := legacyTimes.ReplaceAllString(line, "")
+			rest = legacyMeta.ReplaceAllString(rest, "")
+			secs := legacySecs.FindAllStringSubmatch(rest, -1)
+			if len(secs) == 0 {
+				// G1 details span multiple lines
+				continue
+			}
+			var before, after string
+			if hs := legacyHeap.FindAllStringSubmatch(rest, -1); len(hs) > 0 {
+				before, after = hs[len(hs)-1][1], hs[len(hs)-1][2]
+			}
+			p, err := newPause(m[1], m[2], secs[len(secs)-1][1]+"s", before, after)
+			if err != nil {
+				return nil, err
+			}
+			ps = append(ps, p)
+			continue
+		}
+
+		if m := g1Heap.FindStringSubmatch(line); m != nil && len(ps) > 0 {
+			last := &ps[len(ps)-1]
+			if last.HeapBeforeBytes == 0 && last.HeapAfterBytes == 0 {
+				var err error
+				if last.HeapBeforeBytes, err = parseSize(m[1]); err != nil {
+					return nil, err
+				}
+				if last.HeapAfterBytes, err = parseSize(m[2]); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return ps, scanner.Err()
+}
+
+func newPause(ts, typ, dur, before, after string) (p Pause, err error) {
+	p.Type = typ
+	if p.Time, err = time.Parse(TimeLayout, ts); err != nil {
+		return Pause{}, err
+	}
+	if p.Duration, err = time.ParseDuration(dur); err != nil {
+		return Pause{}, err
+	}
+	if before != "" {
+		if p.HeapBeforeBytes, err = parseSize(before); err != nil {
+			return Pause{}, err
+		}
+		if p.HeapAfterBytes, err = parseSize(after); err != nil {
+			return Pause{}, err
+		}
+	}
+	return p, nil
+}
+
+// parseSize parses sizes like '33280K' or '3.5M'.
+func parseSize(s string) (uint64, error) {
+	if len(s) < 2 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	var unit float64
+	switch s[len(s)-1] {
+	case 'B':
+		unit = 1
+	case 'K':
+		unit = 1 << 10
+	case 'M':
+		unit = 1 << 20
+	case 'G':
+		unit = 1 << 30
+	default:
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	v, err := strconv.ParseFloat(s[:len(s)-1], 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(v * unit), nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gclog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		log string
+		exp []Pause
+	}{
+		{
+			log: `Java HotSpot(TM) 64-Bit Server VM (25.131-b11) for linux-amd64 JRE (1.8.0_131-b11)
+CommandLine flags: -XX:+PrintGCDateStamps -XX:+PrintGCDetails
+2017-06-01T12:00:00.123+0000: 1.234: [GC (Allocation Failure) [PSYoungGen: 33280K->5104K(38400K)] 33280K->5112K(125952K), 0.0041220 secs] [Times: user=0.01 sys=0.00, real=0.00 secs]
+2017-06-01T12:00:01.500+0000: 2.611: [Full GC (Ergonomics) [PSYoungGen: 5104K->0K(38400K)] [ParOldGen: 8K->4950K(87552K)] 5112K->4950K(125952K), [Metaspace: 2746K->2746K(1056768K)], 0.0251000 secs] [Times: user=0.05 sys=0.00, real=0.03 secs]
+2017-06-01T12:00:02.000+0000: 3.111: [GC (Allocation Failure) 2017-06-01T12:00:02.000+0000: 3.111: [ParNew: 1024K->512K(2048K), 0.0100000 secs] 10240K->5120K(102400K), 0.0110000 secs] [Times: user=0.01 sys=0.00, real=0.01 secs]
+`,
+			exp: []Pause{
+				{Time: time.Date(2017, 6, 1, 12, 0, 0, 123e6, time.UTC), Type: "GC", Duration: 4122 * time.Microsecond, HeapBeforeBytes: 33280 << 10, HeapAfterBytes: 5112 << 10},
+				{Time: time.Date(2017, 6, 1, 12, 0, 1, 500e6, time.UTC), Type: "Full GC", Duration: 25100 * time.Microsecond, HeapBeforeBytes: 5112 << 10, HeapAfterBytes: 4950 << 10},
+				{Time: time.Date(2017, 6, 1, 12, 0, 2, 0, time.UTC), Type: "GC", Duration: 11 * time.Millisecond, HeapBeforeBytes: 10240 << 10, HeapAfterBytes: 5120 << 10},
+			},
+		},
+		{
+			log: `2017-06-01T12:00:00.123+0000: 1.234: [GC pause (G1 Evacuation Pause) (young), 0.0051000 secs]
+   [Parallel Time: 4.5 ms, GC Workers: 8]
+   [Eden: 24.0M(24.0M)->0.0B(13.0M) Survivors: 0.0B->3072.0K Heap: 24.0M(256.0M)->3.5M(256.0M)]
+ [Times: user=0.02 sys=0.00, real=0.01 secs]
+`,
+			exp: []Pause{
+				{Time: time.Date(2017, 6, 1, 12, 0, 0, 123e6, time.UTC), Type: "GC", Duration: 5100 * time.Microsecond, HeapBeforeBytes: 24 << 20, HeapAfterBytes: 3670016},
+			},
+		},
+		{
+			log: `[2017-06-01T12:00:00.123+0000] Using G1
+[2017-06-01T12:00:00.500+0000] GC(0) Pause Young (Normal) (G1 Evacuation Pause) 24M->3M(256M) 5.123ms
+[2017-06-01T12:00:01.000+0000] GC(1) Pause Remark 10M->10M(256M) 1.500ms
+[2017-06-01T12:00:01.100+0000] GC(1) Concurrent Cycle 120.000ms
+`,
+			exp: []Pause{
+				{Time: time.Date(2017, 6, 1, 12, 0, 0, 500e6, time.UTC), Type: "Pause Young", Duration: 5123 * time.Microsecond, HeapBeforeBytes: 24 << 20, HeapAfterBytes: 3 << 20},
+				{Time: time.Date(2017, 6, 1, 12, 0, 1, 0, time.UTC), Type: "Pause Remark", Duration: 1500 * time.Microsecond, HeapBeforeBytes: 10 << 20, HeapAfterBytes: 10 << 20},
+			},
+		},
+	}
+	for i, tt := range tests {
+		ps, err := Parse(strings.NewReader(tt.log))
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if len(ps) != len(tt.exp) {
+			t.Fatalf("#%d: expected %d pauses, got %d (%+v)", i, len(tt.exp), len(ps), ps)
+		}
+		for j := range ps {
+			ps[j].Time = ps[j].Time.UTC()
+			if !reflect.DeepEqual(ps[j], tt.exp[j]) {
+				t.Fatalf("#%d-%d: expected %+v, got %+v", i, j, tt.exp[j], ps[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,373 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hdrhistogram implements High Dynamic Range histogram, that records
+// values in bounded memory with the configured number of significant figures,
+// and merges with other histograms.
+//
+// See http://hdrhistogram.org for the algorithm.
+package hdrhistogram
+
+import (
+	"fmt"
+	"math"
+)
+
+// Histogram records int64 values between the lowest and highest trackable
+// values. Values out of the range are counted in the first or last bucket,
+// while the minimum, maximum and sum of recorded values are exact. Counts
+// are allocated as values are recorded, so the memory is bounded by the
+// largest recorded value.
+type Histogram struct {
+	lowest  int64
+	highest int64
+	sigfigs int
+
+	unitMagnitude               uint
+	subBucketHalfCountMagnitude uint
+	subBucketCount              int
+	subBucketHalfCount          int
+	subBucketMask               int64
+	countsLen                   int
+
+	counts     []int64
+	totalCount int64
+	min        int64
+	max        int64
+	sum        int64
+}
+
+// New returns a new Histogram. 'sigfigs' must be between 1 and 5.
+func New(lowest, highest int64, sigfigs int) *Histogram {
+	if lowest < 1 {
+		lowest = 1
+	}
+	if highest < 2*lowest {
+		highest = 2 * lowest
+	}
+	if sigfigs < 1 {
+		sigfigs = 1
+	}
+	if sigfigs > 5 {
+		sigfigs = 5
+	}
+
+	largestValueWithSingleUnitResolution := 2 * math.Pow10(sigfigs)
+	subBucketCountMagnitude := uint(math.Ceil(math.Log2(largestValueWithSingleUnitResolution)))
+	subBucketHalfCountMagnitude := subBucketCountMagnitude - 1
+	unitMagnitude := uint(math.Floor(math.Log2(float64(lowest))))
+	subBucketCount := 1 << (subBucketHalfCountMagnitude + 1)
+
+	bucketsNeeded := 1
+	smallestUntrackableValue := int64(subBucketCount) << unitMagnitude
+	for smallestUntrackableValue < highest {
+		if smallestUntrackableValue > math.MaxInt64/2 {
+			bucketsNeeded++
+			break
+		}
+		smallestUntrackableValue <<= 1
+		bucketsNeeded++
+	}
+
+	return &Histogram{
+		lowest:  lowest,
+		highest: highest,
+		sigfigs: sigfigs,
+
+		unitMagnitude:               unitMagnitude,
+		subBucketHalfCountMagnitude: subBucketHalfCountMagnitude,
+		subBucketCount:              subBucketCount,
+		subBucketHalfCount:          subBucketCount / 2,
+		subBucketMask:               int64(subBucketCount-1) << unitMagnitude,
+		countsLen:                   (bucketsNeeded + 1) * (subBucketCount / 2),
+
+		min: math.MaxInt64,
+	}
+}
+
+// Record records the value.
+func (h *Histogram) Record(v int64) {
+	h.RecordN(v, 1)
+}
+
+// RecordN records the value 'n' times.
+func (h *Histogram) RecordN(v, n int64) {
+	if n <= 0 {
+		return
+	}
+	if v < 0 {
+		v = 0
+	}
+	idx := h.countsIndexFor(v)
+	if v > h.highest {
+		idx = h.countsIndexFor(h.highest)
+	}
+	if idx >= len(h.counts) {
+		h.grow(idx + 1)
+	}
+	h.counts[idx] += n
+	h.totalCount += n
+	h.sum += v * n
+	if v < h.min {
+		h.min = v
+	}
+	if v > h.max {
+		h.max = v
+	}
+}
+
+func (h *Histogram) grow(n int) {
+	if n > h.countsLen {
+		n = h.countsLen
+	}
+	counts := make([]int64, n)
+	copy(counts, h.counts)
+	h.counts = counts
+}
+
+// Merge adds all values of the other histogram. If the other histogram has
+// different configuration, its values are recorded at the median of each
+// equivalent range.
+func (h *Histogram) Merge(o *Histogram) {
+	if o == nil || o.totalCount == 0 {
+		return
+	}
+	if h.lowest == o.lowest && h.highest == o.highest && h.sigfigs == o.sigfigs {
+		if len(o.counts) > len(h.counts) {
+			h.grow(len(o.counts))
+		}
+		for i, c := range o.counts {
+			h.counts[i] += c
+		}
+		h.totalCount += o.totalCount
+		h.sum += o.sum
+	} else {
+		min, max, sum := h.min, h.max, h.sum
+		for _, b := range o.Buckets() {
+			h.RecordN(b.Value, b.Count)
+		}
+		// keep the exact sum, minimum and maximum
+		h.min, h.max, h.sum = min, max, sum+o.sum
+	}
+	if o.min < h.min {
+		h.min = o.min
+	}
+	if o.max > h.max {
+		h.max = o.max
+	}
+}
+
+// Count returns the number of recorded values.
+func (h *Histogram) Count() int64 { return h.totalCount }
+
+// Min returns the minimum recorded value, or 0 if empty.
+func (h *Histogram) Min() int64 {
+	if h.totalCount == 0 {
+		return 0
+	}
+	return h.min
+}
+
+// Max returns the maximum recorded value, or 0 if empty.
+func (h *Histogram) Max() int64 { return h.max }
+
+// Sum returns the sum of recorded values.
+func (h *Histogram) Sum() int64 { return h.sum }
+
+// Mean returns the mean of recorded values, or 0 if empty.
+func (h *Histogram) Mean() float64 {
+	if h.totalCount == 0 {
+		return 0
+	}
+	return float64(h.sum) / float64(h.totalCount)
+}
+
+// StdDev returns the standard deviation of recorded values, computed
+// with the median of each equivalent range.
+func (h *Histogram) StdDev() float64 {
+	if h.totalCount == 0 {
+		return 0
+	}
+	mean := h.Mean()
+	var geometricDevTotal float64
+	for _, b := range h.Buckets() {
+		dev := float64(b.Value) - mean
+		geometricDevTotal += dev * dev * float64(b.Count)
+	}
+	return math.Sqrt(geometricDevTotal / float64(h.totalCount))
+}
+
+// ValueAtPercentile returns the value that the given percentage (0 to 100)
+// of recorded values are less than or equal to, within the precision of
+// the histogram. It returns 0 if empty.
+func (h *Histogram) ValueAtPercentile(p float64) int64 {
+	if h.totalCount == 0 {
+		return 0
+	}
+	if p > 100 {
+		p = 100
+	}
+	countAtPercentile := int64(p/100*float64(h.totalCount) + 0.5)
+	if countAtPercentile < 1 {
+		countAtPercentile = 1
+	}
+	var total int64
+	for i, c := range h.counts {
+		total += c
+		if total == h.totalCount {
+			// the bucket of the maximum
+			return h.max
+		}
+		if total >= countAtPercentile {
+			v := h.highestEquivalentValue(h.valueFromCountsIndex(i))
+			if v > h.max {
+				v = h.max
+			}
+			if v < h.min {
+				v = h.min
+			}
+			return v
+		}
+	}
+	return h.max
+}
+
+// Bucket is the number of recorded values in an equivalent range.
+type Bucket struct {
+	// Value is the median of the equivalent range.
+	Value int64
+	Count int64
+}
+
+// Buckets returns the non-empty buckets, in increasing order of values.
+func (h *Histogram) Buckets() []Bucket {
+	var bs []Bucket
+	for i, c := range h.counts {
+		if c == 0 {
+			continue
+		}
+		bs = append(bs, Bucket{Value: h.medianEquivalentValue(h.valueFromCountsIndex(i)), Count: c})
+	}
+	return bs
+}
+
+// Snapshot is the serializable form of Histogram.
+type Snapshot struct {
+	LowestTrackableValue  int64
+	HighestTrackableValue int64
+	SignificantFigures    int32
+
+	Min    int64
+	Max    int64
+	Sum    int64
+	Counts []int64
+}
+
+// Export returns the snapshot of the histogram.
+func (h *Histogram) Export() *Snapshot {
+	n := len(h.counts)
+	for n > 0 && h.counts[n-1] == 0 {
+		n--
+	}
+	return &Snapshot{
+		LowestTrackableValue:  h.lowest,
+		HighestTrackableValue: h.highest,
+		SignificantFigures:    int32(h.sigfigs),
+		Min:                   h.Min(),
+		Max:                   h.max,
+		Sum:                   h.sum,
+		Counts:                append([]int64(nil), h.counts[:n]...),
+	}
+}
+
+// Import returns the histogram of the snapshot.
+func Import(s *Snapshot) (*Histogram, error) {
+	h := New(s.LowestTrackableValue, s.HighestTrackableValue, int(s.SignificantFigures))
+	if h.lowest != s.LowestTrackableValue || h.highest != s.HighestTrackableValue || int32(h.sigfigs) != s.SignificantFigures {
+		return nil, fmt.Errorf("invalid histogram configuration %+v", s)
+	}
+	if len(s.Counts) > h.countsLen {
+		return nil, fmt.Errorf("got %d counts, expected at most %d", len(s.Counts), h.countsLen)
+	}
+	h.counts = append([]int64(nil), s.Counts...)
+	for _, c := range h.counts {
+		h.totalCount += c
+	}
+	if h.totalCount > 0 {
+		h.min, h.max, h.sum = s.Min, s.Max, s.Sum
+	}
+	return h, nil
+}
+
+func (h *Histogram) bucketIndex(v int64) int {
+	pow2Ceiling := bitLen64(uint64(v | h.subBucketMask))
+	return pow2Ceiling - int(h.unitMagnitude) - int(h.subBucketHalfCountMagnitude+1)
+}
+
+func (h *Histogram) subBucketIndex(v int64, bucketIdx int) int {
+	return int(v >> (uint(bucketIdx) + h.unitMagnitude))
+}
+
+func (h *Histogram) countsIndexFor(v int64) int {
+	bucketIdx := h.bucketIndex(v)
+	subBucketIdx := h.subBucketIndex(v, bucketIdx)
+	bucketBaseIdx := (bucketIdx + 1) << h.subBucketHalfCountMagnitude
+	return bucketBaseIdx + subBucketIdx - h.subBucketHalfCount
+}
+
+func (h *Histogram) valueFromCountsIndex(idx int) int64 {
+	bucketIdx := (idx >> h.subBucketHalfCountMagnitude) - 1
+	subBucketIdx := (idx & (h.subBucketHalfCount - 1)) + h.subBucketHalfCount
+	if bucketIdx < 0 {
+		subBucketIdx -= h.subBucketHalfCount
+		bucketIdx = 0
+	}
+	return int64(subBucketIdx) << (uint(bucketIdx) + h.unitMagnitude)
+}
+
+func (h *Histogram) sizeOfEquivalentValueRange(v int64) int64 {
+	bucketIdx := h.bucketIndex(v)
+	subBucketIdx := h.subBucketIndex(v, bucketIdx)
+	adjustedBucket := bucketIdx
+	if subBucketIdx >= h.subBucketCount {
+		adjustedBucket++
+	}
+	return int64(1) << (h.unitMagnitude + uint(adjustedBucket))
+}
+
+func (h *Histogram) lowestEquivalentValue(v int64) int64 {
+	bucketIdx := h.bucketIndex(v)
+	subBucketIdx := h.subBucketIndex(v, bucketIdx)
+	return int64(subBucketIdx) << (uint(bucketIdx) + h.unitMagnitude)
+}
+
+func (h *Histogram) highestEquivalentValue(v int64) int64 {
+	return h.lowestEquivalentValue(v) + h.sizeOfEquivalentValueRange(v) - 1
+}
+
+func (h *Histogram) medianEquivalentValue(v int64) int64 {
+	return h.lowestEquivalentValue(v) + h.sizeOfEquivalentValueRange(v)>>1
+}
+
+// bitLen64 returns the minimum number of bits to represent x, zero if x is 0.
+// It is the same as 'math/bits.Len64', which requires Go 1.9.
+func bitLen64(x uint64) (n int) {
+	for ; x >= 1<<16; x >>= 16 {
+		n += 16
+	}
+	for ; x != 0; x >>= 1 {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,133 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hdrhistogram
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	h := New(1, 3600*1000*1000, 3)
+	for i := int64(1); i <= 10000; i++ {
+		h.Record(i * 1000)
+	}
+	if h.Count() != 10000 || h.Min() != 1000 || h.Max() != 10000000 {
+		t.Fatalf("unexpected count %d, min %d, max %d", h.Count(), h.Min(), h.Max())
+	}
+	if h.Mean() != 5000500 {
+		t.Fatalf("expected mean 5000500, got %f", h.Mean())
+	}
+	for _, tt := range []struct {
+		percentile float64
+		expected   int64
+	}{
+		{50, 5000000},
+		{90, 9000000},
+		{99, 9900000},
+		{99.99, 9999000},
+		{100, 10000000},
+	} {
+		v := h.ValueAtPercentile(tt.percentile)
+		if math.Abs(float64(v-tt.expected)) > float64(tt.expected)/1000 {
+			t.Fatalf("p%v: expected %d within 0.1%%, got %d", tt.percentile, tt.expected, v)
+		}
+	}
+	if sd := h.StdDev(); math.Abs(sd-2886751) > 2886751.0/1000 {
+		t.Fatalf("unexpected standard deviation %f", sd)
+	}
+}
+
+func TestHistogramMerge(t *testing.T) {
+	h1, h2 := New(1, 1000000, 3), New(1, 1000000, 3)
+	for i := int64(1); i <= 100; i++ {
+		h1.Record(i)
+		h2.Record(i * 1000)
+	}
+	h1.Merge(h2)
+	if h1.Count() != 200 || h1.Min() != 1 || h1.Max() != 100000 || h1.Sum() != 5050+5050000 {
+		t.Fatalf("unexpected count %d, min %d, max %d, sum %d", h1.Count(), h1.Min(), h1.Max(), h1.Sum())
+	}
+	if v := h1.ValueAtPercentile(50); v != 100 {
+		t.Fatalf("expected p50 100, got %d", v)
+	}
+
+	// different configuration
+	h3 := New(1, 1000000, 2)
+	h3.Merge(h2)
+	if h3.Count() != 100 || h3.Min() != 1000 || h3.Max() != 100000 || h3.Sum() != 5050000 {
+		t.Fatalf("unexpected count %d, min %d, max %d, sum %d", h3.Count(), h3.Min(), h3.Max(), h3.Sum())
+	}
+	if v := h3.ValueAtPercentile(50); math.Abs(float64(v-50000)) > 50000.0/100 {
+		t.Fatalf("expected p50 50000 within 1%%, got %d", v)
+	}
+}
+
+func TestHistogramOutOfRange(t *testing.T) {
+	h := New(1000, 1000000, 2)
+	h.Record(1)
+	h.Record(5000000)
+	if h.Count() != 2 || h.Min() != 1 || h.Max() != 5000000 {
+		t.Fatalf("unexpected count %d, min %d, max %d", h.Count(), h.Min(), h.Max())
+	}
+	if v := h.ValueAtPercentile(100); v != 5000000 {
+		t.Fatalf("expected p100 5000000, got %d", v)
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	h := New(1000, 3600*1000*1000*1000, 2)
+	for i := int64(1); i <= 1000; i++ {
+		h.Record(i * 1000000)
+	}
+	s := h.Export()
+	h2, err := Import(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(h2.Export(), s) {
+		t.Fatalf("expected %+v, got %+v", s, h2.Export())
+	}
+	if h2.ValueAtPercentile(99) != h.ValueAtPercentile(99) {
+		t.Fatalf("expected p99 %d, got %d", h.ValueAtPercentile(99), h2.ValueAtPercentile(99))
+	}
+
+	if _, err = Import(&Snapshot{LowestTrackableValue: 1, HighestTrackableValue: 10, SignificantFigures: 2, Counts: make([]int64, 100000)}); err == nil {
+		t.Fatal("expected error on too many counts")
+	}
+}
+
+func Test_bitLen64(t *testing.T) {
+	tests := []struct {
+		x   uint64
+		exp int
+	}{
+		{0, 0},
+		{1, 1},
+		{2, 2},
+		{3, 2},
+		{1<<16 - 1, 16},
+		{1 << 16, 17},
+		{1<<40 + 12345, 41},
+		{math.MaxInt64, 63},
+		{math.MaxUint64, 64},
+	}
+	for i, tt := range tests {
+		if n := bitLen64(tt.x); n != tt.exp {
+			t.Fatalf("#%d: expected bitLen64(%d) = %d, got %d", i, tt.x, tt.exp, n)
+		}
+	}
+}
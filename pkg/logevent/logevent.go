@@ -0,0 +1,162 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logevent extracts notable events from database logs.
+package logevent
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event is a notable event in the database log.
+type Event struct {
+	Time time.Time
+	Name string
+	// Duration is zero if the log does not report it.
+	Duration time.Duration
+	Line     string
+}
+
+// Rule extracts the event from a log line.
+type Rule struct {
+	Name    string
+	Pattern *regexp.Regexp
+	// Unit is the unit of the first submatch of Pattern, if any.
+	// If zero, the submatch is parsed with 'time.ParseDuration'.
+	Unit time.Duration
+}
+
+// ZookeeperRules are the rules for Zookeeper logs.
+var ZookeeperRules = []Rule{
+	{Name: "FSYNC-SLOW", Pattern: regexp.MustCompile(`fsync-ing the write ahead log in \S+ took (\d+)ms`), Unit: time.Millisecond},
+	{Name: "SNAPSHOT", Pattern: regexp.MustCompile(`Snapshotting: `)},
+	{Name: "SNAPSHOT-SKIPPED", Pattern: regexp.MustCompile(`Too busy to snap, skipping`)},
+	{Name: "LEADER-ELECTION", Pattern: regexp.MustCompile(`LEADER ELECTION TOOK - (\d+)`), Unit: time.Millisecond},
+}
+
+// EtcdRules are the rules for etcd logs.
+var EtcdRules = []Rule{
+	{Name: "APPLY-SLOW", Pattern: regexp.MustCompile(`apply entries took too long \[([0-9.]+[a-zµ]+) for`)},
+	{Name: "APPLY-SLOW", Pattern: regexp.MustCompile(`apply request took too long".*"took":"([0-9.]+[a-zµ]+)"`)},
+	{Name: "LEADER-CHANGE", Pattern: regexp.MustCompile(`elected leader \S+ at term|changed leader from \S+ to \S+ at term|lost leader \S+ at term`)},
+	{Name: "COMPACTION", Pattern: regexp.MustCompile(`finished scheduled compaction`)},
+	{Name: "RAFT-LOG-COMPACTION", Pattern: regexp.MustCompile(`compacted raft log at`)},
+}
+
+// ConsulRules are the rules for Consul logs.
+var ConsulRules = []Rule{
+	{Name: "ELECTION-START", Pattern: regexp.MustCompile(`entering Candidate state`)},
+	{Name: "LEADER-CHANGE", Pattern: regexp.MustCompile(`New leader elected`)},
+}
+
+// RulesFor returns the rules for the database ID. zetcd and cetcd
+// use etcd rules, since agents log the etcd behind the proxy.
+func RulesFor(databaseID string) []Rule {
+	switch strings.Split(databaseID, "__")[0] {
+	case "zookeeper":
+		return ZookeeperRules
+	case "etcd", "zetcd", "cetcd":
+		return EtcdRules
+	case "consul":
+		return ConsulRules
+	}
+	return nil
+}
+
+// Names returns the event names of the rules, in order and without duplicates.
+func Names(rules []Rule) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, r := range rules {
+		if !seen[r.Name] {
+			seen[r.Name] = true
+			names = append(names, r.Name)
+		}
+	}
+	return names
+}
+
+// Parse extracts the events from the log. Lines without timestamps are
+// ignored. Timestamps without time zone are parsed in UTC.
+func Parse(r io.Reader, rules []Rule) ([]Event, error) {
+	var evs []Event
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		for _, rule := range rules {
+			m := rule.Pattern.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			ts, ok := parseTime(line)
+			if !ok {
+				break
+			}
+			ev := Event{Time: ts, Name: rule.Name, Line: strings.TrimSpace(line)}
+			if len(m) > 1 {
+				ev.Duration = parseDuration(m[1], rule.Unit)
+			}
+			evs = append(evs, ev)
+			break
+		}
+	}
+	return evs, scanner.Err()
+}
+
+var timeFormats = []struct {
+	re     *regexp.Regexp
+	layout string
+}{
+	// Zookeeper, e.g. '2017-02-10 18:55:46,382 [myid:3] - WARN ...'
+	{regexp.MustCompile(`^(\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2},\d{3}) `), "2006-01-02 15:04:05,000"},
+	// etcd, e.g. '2017-02-10 18:55:46.382071 W | etcdserver: ...'
+	{regexp.MustCompile(`^(\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\.\d{6}) `), "2006-01-02 15:04:05.000000"},
+	// etcd structured logging, e.g. '{"level":"warn","ts":"2017-02-10T18:55:46.382Z",...}'
+	{regexp.MustCompile(`"ts":"(\d{4}-\d{2}-\d{2}T[^"]+)"`), time.RFC3339Nano},
+	// Consul, e.g. '    2017/02/10 18:55:46 [INFO] consul: ...'
+	{regexp.MustCompile(`^\s*(\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2}) `), "2006/01/02 15:04:05"},
+	// Consul, e.g. '2017-02-10T18:55:46.382Z [INFO] ...'
+	{regexp.MustCompile(`^\s*(\d{4}-\d{2}-\d{2}T\S+) `), time.RFC3339Nano},
+}
+
+func parseTime(line string) (time.Time, bool) {
+	for _, f := range timeFormats {
+		m := f.re.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		if ts, err := time.Parse(f.layout, m[1]); err == nil {
+			return ts, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func parseDuration(s string, unit time.Duration) time.Duration {
+	if unit == 0 {
+		d, _ := time.ParseDuration(s)
+		return d
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(v * float64(unit))
+}
@@ -0,0 +1,87 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logevent
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		databaseID string
+		log        string
+		exp        []Event
+	}{
+		{
+			"zookeeper__r3_4_9",
+			`2017-02-10 18:55:38,997 [myid:3] - WARN  [SyncThread:3:SyncRequestProcessor@148] - Too busy to snap, skipping
+2017-02-10 18:55:38,998 [myid:3] - INFO  [SyncThread:3:FileTxnLog@203] - Creating new log file: log.1000c0c51
+2017-02-10 18:55:40,855 [myid:3] - INFO  [Snapshot Thread:FileTxnSnapLog@240] - Snapshotting: 0x1000cd1ca to /home/gyuho/zookeeper/zookeeper.data/version-2/snapshot.1000cd1ca
+2017-02-10 18:55:46,382 [myid:3] - WARN  [SyncThread:3:FileTxnLog@338] - fsync-ing the write ahead log in SyncThread:3 took 1062ms which will adversely effect operation latency. See the ZooKeeper troubleshooting guide
+2017-02-10 19:22:16,549 [myid:2] - INFO  [QuorumPeer[myid=2]/0:0:0:0:0:0:0:0:2181:Follower@61] - FOLLOWING - LEADER ELECTION TOOK - 22978
+`,
+			[]Event{
+				{Time: time.Date(2017, 2, 10, 18, 55, 38, 997e6, time.UTC), Name: "SNAPSHOT-SKIPPED"},
+				{Time: time.Date(2017, 2, 10, 18, 55, 40, 855e6, time.UTC), Name: "SNAPSHOT"},
+				{Time: time.Date(2017, 2, 10, 18, 55, 46, 382e6, time.UTC), Name: "FSYNC-SLOW", Duration: 1062 * time.Millisecond},
+				{Time: time.Date(2017, 2, 10, 19, 22, 16, 549e6, time.UTC), Name: "LEADER-ELECTION", Duration: 22978 * time.Millisecond},
+			},
+		},
+		{
+			"etcd__v3_2",
+			`2017-02-10 18:55:46.382071 W | etcdserver: apply entries took too long [1.234s for 1 entries]
+2017-02-10 18:55:47.000000 I | raft: raft.node: 8e9e05c52164694d elected leader 8e9e05c52164694d at term 2
+2017-02-10 18:55:48.000000 I | mvcc: finished scheduled compaction at 1000 (took 1.2ms)
+{"level":"warn","ts":"2017-02-10T18:55:49.5Z","caller":"etcdserver/util.go:163","msg":"apply request took too long","took":"250ms","expected-duration":"100ms"}
+`,
+			[]Event{
+				{Time: time.Date(2017, 2, 10, 18, 55, 46, 382071e3, time.UTC), Name: "APPLY-SLOW", Duration: 1234 * time.Millisecond},
+				{Time: time.Date(2017, 2, 10, 18, 55, 47, 0, time.UTC), Name: "LEADER-CHANGE"},
+				{Time: time.Date(2017, 2, 10, 18, 55, 48, 0, time.UTC), Name: "COMPACTION"},
+				{Time: time.Date(2017, 2, 10, 18, 55, 49, 5e8, time.UTC), Name: "APPLY-SLOW", Duration: 250 * time.Millisecond},
+			},
+		},
+		{
+			"consul__v0_8_4",
+			`    2017/02/10 18:55:46 [WARN] raft: Heartbeat timeout from "10.0.0.1:8300" reached, starting election
+    2017/02/10 18:55:46 [INFO] raft: Node at 10.0.0.2:8300 [Candidate] entering Candidate state in term 3
+    2017/02/10 18:55:47 [INFO] consul: New leader elected: consul-2
+`,
+			[]Event{
+				{Time: time.Date(2017, 2, 10, 18, 55, 46, 0, time.UTC), Name: "ELECTION-START"},
+				{Time: time.Date(2017, 2, 10, 18, 55, 47, 0, time.UTC), Name: "LEADER-CHANGE"},
+			},
+		},
+	}
+	for i, tt := range tests {
+		evs, err := Parse(strings.NewReader(tt.log), RulesFor(tt.databaseID))
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if len(evs) != len(tt.exp) {
+			t.Fatalf("#%d: expected %d events, got %d (%+v)", i, len(tt.exp), len(evs), evs)
+		}
+		for j := range evs {
+			evs[j].Time = evs[j].Time.UTC()
+			evs[j].Line = ""
+			if !reflect.DeepEqual(evs[j], tt.exp[j]) {
+				t.Fatalf("#%d-%d: expected %+v, got %+v", i, j, tt.exp[j], evs[j])
+			}
+		}
+	}
+}
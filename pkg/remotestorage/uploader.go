@@ -17,7 +17,9 @@ package remotestorage
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -35,6 +37,10 @@ type Uploader interface {
 
 	// UploadDir uploads a directory.
 	UploadDir(bucket, src, dst string, opts ...OpOption) error
+
+	// DownloadFile downloads a file. It returns an error satisfying
+	// os.IsNotExist if the file does not exist in the bucket.
+	DownloadFile(bucket, src, dst string) error
 }
 
 // GoogleCloudStorage wraps Google Cloud Storage API.
@@ -174,3 +180,45 @@ func (g *GoogleCloudStorage) UploadDir(bucket, src, dst string, opts ...OpOption
 	plog.Printf("finished uploading %q", src)
 	return nil
 }
+
+// DownloadFile downloads a file from Google Cloud Storage.
+func (g *GoogleCloudStorage) DownloadFile(bucket, src, dst string) error {
+	if g == nil {
+		return fmt.Errorf("GoogleCloudStorage is nil")
+	}
+
+	ctx := context.Background()
+
+	client, err := storage.NewClient(ctx, option.WithTokenSource(g.Config.TokenSource(ctx)))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	plog.Printf("downloading %q ---> %q", src, dst)
+	rd, err := client.Bucket(bucket).Object(src).NewReader(ctx)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return &os.PathError{Op: "download", Path: src, Err: os.ErrNotExist}
+		}
+		return err
+	}
+	defer rd.Close()
+
+	if dir := filepath.Dir(dst); dir != "." {
+		if err = os.MkdirAll(dir, 0777); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(dst, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0777)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err = io.Copy(f, rd); err != nil {
+		return err
+	}
+	plog.Printf("finished downloading %q", src)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package syscheck implements the machine checks shared by agent and control preflight.
+package syscheck
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/gyuho/linux-inspect/inspect"
+)
+
+// CheckDiskDevice returns an error if the disk device is not found.
+func CheckDiskDevice(name string) error {
+	dss, err := inspect.GetDS()
+	if err != nil {
+		return err
+	}
+	for _, elem := range dss {
+		if elem.Device == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("disk device %q is not found", name)
+}
+
+// CheckReachable dials the TCP address until it connects or the
+// timeout expires, since the other end may not be listening yet.
+func CheckReachable(addr string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		conn, err := net.DialTimeout("tcp", addr, time.Second)
+		if err == nil {
+			return conn.Close()
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%q is not reachable (%v)", addr, err)
+		}
+		time.Sleep(300 * time.Millisecond)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syscheck
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestCheckReachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	if err = CheckReachable(addr, time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	ln.Close()
+	if err = CheckReachable(addr, 500*time.Millisecond); err == nil {
+		t.Fatalf("expected %q to be unreachable", addr)
+	}
+}
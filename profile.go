@@ -0,0 +1,213 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"golang.org/x/net/context"
+)
+
+// Profiler captures Go runtime profiles of the databases and the control
+// process, periodically or when the p99 latency of the last second exceeds
+// the threshold in 'profile' configuration.
+type Profiler struct {
+	cfg        *Config
+	databaseID string
+	pcfg       dbtesterpb.ConfigProfile
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	capturing int32
+
+	mu sync.Mutex
+	// stopped is true once Stop is called, so that
+	// no more captures are added to the wait group
+	stopped     bool
+	second      int64
+	latencies   []float64
+	lastCapture time.Time
+	paths       []string
+}
+
+// NewProfiler returns a new Profiler for the database.
+// It returns nil if the database has no 'profile' configuration.
+func (cfg *Config) NewProfiler(databaseID string) *Profiler {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok || gcfg.ConfigProfile == nil {
+		return nil
+	}
+	return &Profiler{cfg: cfg, databaseID: databaseID, pcfg: *gcfg.ConfigProfile}
+}
+
+// Start starts capturing profiles periodically, and enables the
+// captures triggered by latency in benchmarks.
+func (p *Profiler) Start(ctx context.Context) {
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	p.cfg.profiler = p
+	if p.pcfg.IntervalSecond <= 0 {
+		return
+	}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(time.Duration(p.pcfg.IntervalSecond) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+				p.capture(fmt.Sprintf("every %d seconds", p.pcfg.IntervalSecond))
+			}
+		}
+	}()
+}
+
+// Stop stops capturing profiles, and waits for the captures in progress.
+func (p *Profiler) Stop() {
+	p.cfg.profiler = nil
+	p.mu.Lock()
+	p.stopped = true
+	p.mu.Unlock()
+	p.cancel()
+	p.wg.Wait()
+}
+
+// Paths returns the profile paths of the control process.
+func (p *Profiler) Paths() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.paths...)
+}
+
+// observe records the latency of a request, and captures profiles
+// if the p99 latency of the previous second exceeds the threshold.
+func (p *Profiler) observe(start, end time.Time) {
+	if p == nil || p.pcfg.P99LatencyMs <= 0 || p.ctx.Err() != nil {
+		return
+	}
+	sec := end.Unix()
+	lat := float64(end.Sub(start)) / float64(time.Millisecond)
+
+	p.mu.Lock()
+	if sec == p.second {
+		p.latencies = append(p.latencies, lat)
+		p.mu.Unlock()
+		return
+	}
+	prev := p.latencies
+	p.second, p.latencies = sec, []float64{lat}
+	cooldown := time.Duration(p.pcfg.CooldownSecond) * time.Second
+	if len(prev) == 0 || time.Since(p.lastCapture) < cooldown {
+		p.mu.Unlock()
+		return
+	}
+	sort.Float64s(prev)
+	p99 := prev[(len(prev)*99)/100]
+	if p99 <= p.pcfg.P99LatencyMs {
+		p.mu.Unlock()
+		return
+	}
+	if p.stopped {
+		p.mu.Unlock()
+		return
+	}
+	p.lastCapture = time.Now()
+	p.wg.Add(1)
+	p.mu.Unlock()
+
+	go func() {
+		defer p.wg.Done()
+		p.capture(fmt.Sprintf("p99 latency %.2f ms exceeded %.2f ms", p99, p.pcfg.P99LatencyMs))
+	}()
+}
+
+// capture requests agents to capture the database profiles,
+// and captures the profiles of the control process.
+func (p *Profiler) capture(reason string) {
+	if !atomic.CompareAndSwapInt32(&p.capturing, 0, 1) {
+		plog.Warningf("profiles are being captured; skipping (%s)", reason)
+		return
+	}
+	defer atomic.StoreInt32(&p.capturing, 0)
+	plog.Infof("capturing profiles (%s)", reason)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if _, err := p.cfg.BroadcaseRequest(p.ctx, p.databaseID, dbtesterpb.Operation_Profile); err != nil {
+			plog.Warningf("failed to request profiles (%v)", err)
+		}
+	}()
+	if err := p.captureControl(); err != nil {
+		plog.Warningf("failed to capture control profiles (%v)", err)
+	}
+	wg.Wait()
+}
+
+// captureControl captures the CPU profile, heap profile and
+// goroutine dump of the control process.
+func (p *Profiler) captureControl() error {
+	tag := p.cfg.DatabaseIDToConfigClientMachineAgentControl[p.databaseID].DatabaseTag
+	ts := time.Now().Unix()
+	path := func(kind, ext string) string {
+		return filepath.Join(p.cfg.ConfigClientMachineInitial.PathPrefix, fmt.Sprintf("%s-client-%d-%s.%s", tag, ts, kind, ext))
+	}
+	write := func(fpath string, fn func(*os.File) error) error {
+		f, err := os.Create(fpath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err = fn(f); err != nil {
+			return err
+		}
+		p.mu.Lock()
+		p.paths = append(p.paths, fpath)
+		p.mu.Unlock()
+		plog.Infof("saved profile %q", fpath)
+		return nil
+	}
+
+	if err := write(path("heap", "pprof"), func(f *os.File) error { return pprof.Lookup("heap").WriteTo(f, 0) }); err != nil {
+		return err
+	}
+	if err := write(path("goroutine", "txt"), func(f *os.File) error { return pprof.Lookup("goroutine").WriteTo(f, 2) }); err != nil {
+		return err
+	}
+	return write(path("cpu", "pprof"), func(f *os.File) error {
+		if err := pprof.StartCPUProfile(f); err != nil {
+			return err
+		}
+		select {
+		case <-time.After(time.Duration(p.pcfg.CPUSecond) * time.Second):
+		case <-p.ctx.Done():
+		}
+		pprof.StopCPUProfile()
+		return nil
+	})
+}
@@ -16,7 +16,9 @@ package dbtester
 
 import (
 	"bytes"
+	"encoding/csv"
 	"fmt"
+	"os"
 	"path/filepath"
 )
 
@@ -33,6 +35,10 @@ func (cfg *Config) WriteREADME(summary string) error {
 	buf.WriteString(summary)
 	buf.WriteString("```\n\n\n")
 
+	if err := cfg.writeBinarySummary(buf); err != nil {
+		return err
+	}
+
 	for _, img := range cfg.Images {
 		switch img.Type {
 		case "local":
@@ -49,3 +55,43 @@ func (cfg *Config) WriteREADME(summary string) error {
 
 	return toFile(buf.String(), cfg.ConfigAnalyzeMachineREADME.OutputPath)
 }
+
+// writeBinarySummary writes the database binaries that were tested,
+// if agents reported them.
+func (cfg *Config) writeBinarySummary(buf *bytes.Buffer) error {
+	var rows [][]string
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		fpath := cfg.AnalyzeBinarySummaryPath(databaseID)
+		f, err := os.Open(fpath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		records, err := csv.NewReader(f).ReadAll()
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%v (%q)", err, fpath)
+		}
+		if len(records) == 0 {
+			// empty file without header
+			continue
+		}
+		for _, rec := range records[1:] {
+			// columns of BinarySummaryColumns
+			rows = append(rows, []string{cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseDescription, rec[1], rec[2], rec[3]})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	buf.WriteString("| Database | Endpoint | Version | SHA-256 |\n")
+	buf.WriteString("|---|---|---|---|\n")
+	for _, row := range rows {
+		buf.WriteString(fmt.Sprintf("| %s | %s | `%s` | `%s` |\n", row[0], row[1], row[2], row[3]))
+	}
+	buf.WriteString("\n\n")
+	return nil
+}
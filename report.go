@@ -21,27 +21,39 @@ import (
 
 	"github.com/cheggaaa/pb"
 	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/errclass"
 	"github.com/coreos/etcd/pkg/report"
 	"golang.org/x/net/context"
 )
 
 type benchmark struct {
 	bar        *pb.ProgressBar
-	report     report.Report
-	reportDone <-chan report.Stats
-	stats      report.Stats
+	report     *latencyReport
+	reportDone <-chan reportStats
+	stats      reportStats
 
 	reqHandlers []ReqHandler
-	reqGen      func(chan<- request)
+	reqGen      func(context.Context, chan<- request)
 	reqDone     func()
 	wg          sync.WaitGroup
 
 	mu           sync.RWMutex
 	inflightReqs chan request
+
+	// profiler is notified of request latencies, if not nil
+	profiler *Profiler
+	// metrics records live request metrics, if not nil
+	metrics *Metrics
+	// dashboard draws live progress in place of the progress bar, if not nil
+	dashboard *Dashboard
+	// step is the 1-based index of client number step, zero if fixed
+	step int64
 }
 
-// pass totalN in case that 'cfg' is manipulated
-func newBenchmark(totalN int64, clientsN int64, reqHandlers []ReqHandler, reqDone func(), reqGen func(chan<- request)) (b *benchmark) {
+// pass totalN in case that 'cfg' is manipulated, 'interval'
+// is the sample interval of latency and throughput timeseries,
+// and 'errRules' classify the errors of each sample
+func newBenchmark(totalN int64, clientsN int64, interval time.Duration, errRules []errclass.Rule, reqHandlers []ReqHandler, reqDone func(), reqGen func(context.Context, chan<- request)) (b *benchmark) {
 	b = &benchmark{
 		bar:         pb.New(int(totalN)),
 		reqHandlers: reqHandlers,
@@ -52,13 +64,12 @@ func newBenchmark(totalN int64, clientsN int64, reqHandlers []ReqHandler, reqDon
 	b.inflightReqs = make(chan request, clientsN)
 
 	b.bar.Format("Bom !")
-	b.bar.Start()
-	b.report = report.NewReportSample("%4.4f")
+	b.report = newLatencyReport(interval, errRules)
 	return
 }
 
 // only useful when multiple ranges of requests are run with one report
-func (b *benchmark) reset(clientsN int64, reqHandlers []ReqHandler, reqDone func(), reqGen func(chan<- request)) {
+func (b *benchmark) reset(clientsN int64, reqHandlers []ReqHandler, reqDone func(), reqGen func(context.Context, chan<- request)) {
 	if len(reqHandlers) == 0 {
 		panic(fmt.Errorf("got 0 reqHandlers"))
 	}
@@ -79,7 +90,14 @@ func (b *benchmark) getInflightsReqs() (ch chan request) {
 	return
 }
 
-func (b *benchmark) startRequests() {
+// startRequests starts sending requests until all requests are generated,
+// or the context is canceled. Requests that fail because of the canceled
+// context are not reported.
+func (b *benchmark) startRequests(ctx context.Context) {
+	b.metrics.setClientNumber(int64(len(b.reqHandlers)), b.step)
+	b.dashboard.begin(b.bar.Total, int64(len(b.reqHandlers)), b.step)
+	b.bar.NotPrint = b.dashboard != nil
+	b.bar.Start()
 	for i := range b.reqHandlers {
 		b.wg.Add(1)
 		go func(rh ReqHandler) {
@@ -88,14 +106,27 @@ func (b *benchmark) startRequests() {
 				if rh == nil {
 					panic(fmt.Errorf("got nil rh"))
 				}
+				b.metrics.begin()
 				st := time.Now()
-				err := rh(context.Background(), &req)
-				b.report.Results() <- report.Result{Err: err, Start: st, End: time.Now()}
+				err := rh(ctx, &req)
+				if err != nil && ctx.Err() != nil {
+					b.metrics.end(st, time.Now(), "", false)
+					continue
+				}
+				end := time.Now()
+				var class string
+				if err != nil {
+					class = errclass.Classify(b.report.errRules, err.Error())
+				}
+				b.metrics.end(st, end, class, true)
+				b.dashboard.observe(st, end, class)
+				b.report.Results() <- result{Result: report.Result{Err: err, Start: st, End: end}, endpoint: req.endpoint, class: class}
+				b.profiler.observe(st, end)
 				b.bar.Increment()
 			}
 		}(b.reqHandlers[i])
 	}
-	go b.reqGen(b.getInflightsReqs())
+	go b.reqGen(ctx, b.getInflightsReqs())
 	b.reportDone = b.report.Stats()
 }
 
@@ -118,9 +149,9 @@ func (b *benchmark) waitAll() {
 	b.finishReports()
 }
 
-func printStats(st report.Stats) {
+func printStats(st reportStats) {
 	// to be piped to cfg.Log via stdout when dbtester executed
-	if len(st.Lats) > 0 {
+	if st.count() > 0 {
 		fmt.Printf("Total: %v\n", st.Total)
 		fmt.Printf("Slowest: %f secs\n", st.Slowest)
 		fmt.Printf("Fastest: %f secs\n", st.Fastest)
@@ -131,16 +162,25 @@ func printStats(st report.Stats) {
 		for k, v := range st.ErrorDist {
 			fmt.Printf("ERROR %q : %d\n", k, v)
 		}
+		classes := st.errorClasses()
+		for _, class := range errclass.Classes {
+			if classes[class] > 0 {
+				fmt.Printf("ERROR-CLASS %s : %d\n", class, classes[class])
+			}
+		}
 	} else {
 		fmt.Println("ERRRO: 0")
 	}
 }
 
-func (cfg *Config) generateReport(gcfg dbtesterpb.ConfigClientMachineAgentControl, h []ReqHandler, reqDone func(), reqGen func(chan<- request)) {
-	b := newBenchmark(gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, h, reqDone, reqGen)
-	b.startRequests()
+func (cfg *Config) generateReport(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, h []ReqHandler, reqDone func(), reqGen func(context.Context, chan<- request)) {
+	b := newBenchmark(gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, sampleInterval(gcfg), errclass.RulesFor(gcfg.DatabaseID), h, reqDone, reqGen)
+	b.profiler = cfg.profiler
+	b.metrics = cfg.metrics
+	b.dashboard = cfg.dashboard
+	b.startRequests(ctx)
 	b.waitAll()
 
 	printStats(b.stats)
-	cfg.saveAllStats(gcfg, b.stats, nil)
+	cfg.saveAllStats(gcfg, b.stats, nil, ctx.Err() != nil)
 }
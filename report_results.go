@@ -0,0 +1,349 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/errclass"
+)
+
+// ResultsSchemaVersion is the version of 'results.json' schema.
+// It is incremented on incompatible changes, so that consumers
+// can reject the results they do not understand.
+const ResultsSchemaVersion = 1
+
+// resultsPath is the file name of machine-readable results.
+const resultsPath = "results.json"
+
+// ClientResultsPath returns the path to save the machine-readable results in client machine.
+func (cfg *Config) ClientResultsPath() string {
+	return filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, resultsPath)
+}
+
+// AnalyzeResultsPath returns the path of the machine-readable results to analyze.
+func (cfg *Config) AnalyzeResultsPath(databaseID string) string {
+	return cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].PathPrefix + "-" + resultsPath
+}
+
+// Results is the machine-readable results of one database benchmark.
+// Latencies are in milliseconds, and throughputs in requests per second.
+type Results struct {
+	SchemaVersion int `json:"schema_version"`
+
+	TestTitle             string `json:"test_title"`
+	TestDescription       string `json:"test_description"`
+	MatrixCombinationName string `json:"matrix_combination_name,omitempty"`
+	TrialIndex            int    `json:"trial_index,omitempty"`
+
+	DatabaseID          string `json:"database_id"`
+	DatabaseTag         string `json:"database_tag"`
+	DatabaseDescription string `json:"database_description"`
+
+	// Partial is true if the benchmark was interrupted.
+	Partial bool `json:"partial"`
+	// FinishedAt is the time that the results are saved, in RFC3339.
+	FinishedAt string `json:"finished_at"`
+
+	Config           dbtesterpb.ConfigClientMachineAgentControl `json:"config"`
+	DatabaseBinaries []ResultsBinary                            `json:"database_binaries"`
+	Environment      ResultsEnvironment                         `json:"environment"`
+
+	ResultsStats
+
+	// Server is the resource usage of the database servers, reported by
+	// agents on stop. It is nil if the databases are not stopped.
+	Server *ResultsServer `json:"server,omitempty"`
+
+	// Endpoints are the results of each database endpoint, sorted by endpoint.
+	Endpoints []ResultsEndpoint `json:"endpoints,omitempty"`
+}
+
+// ResultsBinary is the database binary of an endpoint, reported by agents on start.
+type ResultsBinary struct {
+	Endpoint string `json:"endpoint"`
+	Version  string `json:"version"`
+	SHA256   string `json:"sha256"`
+}
+
+// ResultsEnvironment is the environment of the client machine.
+type ResultsEnvironment struct {
+	Hostname  string `json:"hostname"`
+	GoVersion string `json:"go_version"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+	NumCPU    int    `json:"num_cpu"`
+}
+
+// ResultsServer is the resource usage of the database servers, in bytes.
+type ResultsServer struct {
+	// MaxMemoryBytes is the maximum resident set size of database processes.
+	MaxMemoryBytes uint64 `json:"max_memory_bytes"`
+	// AvgDiskSpaceUsageBytes is the average data size of databases on disk.
+	AvgDiskSpaceUsageBytes float64 `json:"avg_disk_space_usage_bytes"`
+}
+
+// ResultsStats is the latency and throughput of a benchmark.
+type ResultsStats struct {
+	Summary     ResultsSummary      `json:"summary"`
+	Percentiles []ResultsPercentile `json:"percentiles"`
+	TimeSeries  []ResultsDataPoint  `json:"timeseries"`
+
+	// Errors is the number of errors by error message.
+	Errors map[string]int64 `json:"errors"`
+	// ErrorClasses is the number of errors by error class.
+	ErrorClasses map[string]int64 `json:"error_classes"`
+}
+
+// ResultsSummary is the summary of latencies and throughput.
+type ResultsSummary struct {
+	TotalSeconds      float64 `json:"total_seconds"`
+	Requests          int64   `json:"requests"`
+	Errors            int64   `json:"errors"`
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	FastestLatencyMs  float64 `json:"fastest_latency_ms"`
+	AverageLatencyMs  float64 `json:"average_latency_ms"`
+	SlowestLatencyMs  float64 `json:"slowest_latency_ms"`
+	StddevLatencyMs   float64 `json:"stddev_latency_ms"`
+}
+
+// ResultsPercentile is the latency at a percentile (e.g. 99.9).
+type ResultsPercentile struct {
+	Percentile float64 `json:"percentile"`
+	LatencyMs  float64 `json:"latency_ms"`
+}
+
+// ResultsDataPoint is the latency and throughput of a sample.
+type ResultsDataPoint struct {
+	UnixMillisecond int64 `json:"unix_millisecond"`
+	ClientNumber    int64 `json:"client_number,omitempty"`
+
+	MinLatencyMs  float64 `json:"min_latency_ms"`
+	AvgLatencyMs  float64 `json:"avg_latency_ms"`
+	MaxLatencyMs  float64 `json:"max_latency_ms"`
+	P50LatencyMs  float64 `json:"p50_latency_ms"`
+	P90LatencyMs  float64 `json:"p90_latency_ms"`
+	P99LatencyMs  float64 `json:"p99_latency_ms"`
+	P999LatencyMs float64 `json:"p99_9_latency_ms"`
+
+	Throughput   int64            `json:"throughput"`
+	ErrorCount   int64            `json:"error_count"`
+	ErrorClasses map[string]int64 `json:"error_classes,omitempty"`
+}
+
+// ResultsEndpoint is the results of one database endpoint.
+type ResultsEndpoint struct {
+	Endpoint string `json:"endpoint"`
+	// Role is the role of the endpoint at dial time.
+	Role string `json:"role"`
+
+	ResultsStats
+}
+
+// ReadResults reads the machine-readable results, and returns
+// an error if the schema version is not supported.
+func ReadResults(fpath string) (*Results, error) {
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	rs := &Results{}
+	if err = json.Unmarshal(bts, rs); err != nil {
+		return nil, fmt.Errorf("%v (%q)", err, fpath)
+	}
+	if rs.SchemaVersion != ResultsSchemaVersion {
+		return nil, fmt.Errorf("%q has schema version %d, expected %d", fpath, rs.SchemaVersion, ResultsSchemaVersion)
+	}
+	return rs, nil
+}
+
+// saveResults saves the machine-readable results.
+func (cfg *Config) saveResults(gcfg dbtesterpb.ConfigClientMachineAgentControl, st reportStats, clientNs []int64, partial bool) {
+	rs := newResults(cfg, gcfg, st, clientNs, partial)
+	bts, err := json.MarshalIndent(rs, "", "  ")
+	if err != nil {
+		plog.Fatal(err)
+	}
+	if err = toFile(string(bts)+"\n", cfg.ClientResultsPath()); err != nil {
+		plog.Fatal(err)
+	}
+}
+
+// SaveServerResults adds the server resource usage in the responses to
+// stop request to the machine-readable results. It does nothing if the
+// results of the database are not saved (e.g. benchmark step is skipped).
+func (cfg *Config) SaveServerResults(databaseID string, idxToResponse map[int]dbtesterpb.Response) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	fpath := cfg.ClientResultsPath()
+	if len(idxToResponse) == 0 || !exist(fpath) {
+		return nil
+	}
+	rs, err := ReadResults(fpath)
+	if err != nil {
+		return err
+	}
+	if rs.DatabaseID != gcfg.DatabaseID {
+		return nil
+	}
+	rs.Server = newResultsServer(len(gcfg.DatabaseEndpoints), idxToResponse)
+	bts, err := json.MarshalIndent(rs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return toFile(string(bts)+"\n", fpath)
+}
+
+func newResultsServer(n int, idxToResponse map[int]dbtesterpb.Response) *ResultsServer {
+	rs := &ResultsServer{}
+	var sum int64
+	for i := 0; i < n; i++ {
+		resp := idxToResponse[i]
+		if resp.MaxRSSBytes > rs.MaxMemoryBytes {
+			rs.MaxMemoryBytes = resp.MaxRSSBytes
+		}
+		sum += resp.DiskSpaceUsageBytes
+	}
+	if n > 0 {
+		rs.AvgDiskSpaceUsageBytes = float64(sum) / float64(n)
+	}
+	return rs
+}
+
+func newResults(cfg *Config, gcfg dbtesterpb.ConfigClientMachineAgentControl, st reportStats, clientNs []int64, partial bool) Results {
+	if len(clientNs) == 0 && len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) == 0 {
+		clientNs = make([]int64, len(st.TimeSeries))
+		for i := range clientNs {
+			clientNs[i] = gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber
+		}
+	}
+	hostname, _ := os.Hostname()
+	rs := Results{
+		SchemaVersion: ResultsSchemaVersion,
+
+		TestTitle:             cfg.TestTitle,
+		TestDescription:       cfg.TestDescription,
+		MatrixCombinationName: cfg.MatrixCombinationName,
+		TrialIndex:            cfg.TrialIndex,
+
+		DatabaseID:          gcfg.DatabaseID,
+		DatabaseTag:         gcfg.DatabaseTag,
+		DatabaseDescription: gcfg.DatabaseDescription,
+
+		Partial:    partial,
+		FinishedAt: time.Now().UTC().Format(time.RFC3339),
+
+		Config:           gcfg,
+		DatabaseBinaries: append([]ResultsBinary{}, cfg.binaries...),
+		Environment: ResultsEnvironment{
+			Hostname:  hostname,
+			GoVersion: runtime.Version(),
+			OS:        runtime.GOOS,
+			Arch:      runtime.GOARCH,
+			NumCPU:    runtime.NumCPU(),
+		},
+
+		ResultsStats: newResultsStats(gcfg, st, clientNs),
+	}
+
+	eps := make([]string, 0, len(st.Endpoints))
+	for ep := range st.Endpoints {
+		eps = append(eps, ep)
+	}
+	sort.Strings(eps)
+	for _, ep := range eps {
+		est := st.Endpoints[ep]
+		rs.Endpoints = append(rs.Endpoints, ResultsEndpoint{
+			Endpoint:     ep,
+			Role:         est.Role,
+			ResultsStats: newResultsStats(gcfg, *est, nil),
+		})
+	}
+	return rs
+}
+
+func newResultsStats(gcfg dbtesterpb.ConfigClientMachineAgentControl, st reportStats, clientNs []int64) ResultsStats {
+	rs := ResultsStats{
+		Summary: ResultsSummary{
+			TotalSeconds:      st.Total.Seconds(),
+			Requests:          st.count(),
+			RequestsPerSecond: st.RPS,
+			FastestLatencyMs:  1000 * st.Fastest,
+			AverageLatencyMs:  1000 * st.Average,
+			SlowestLatencyMs:  1000 * st.Slowest,
+			StddevLatencyMs:   1000 * st.Stddev,
+		},
+		Percentiles:  make([]ResultsPercentile, len(latencyPercentiles)),
+		TimeSeries:   make([]ResultsDataPoint, len(st.TimeSeries)),
+		Errors:       make(map[string]int64, len(st.ErrorDist)),
+		ErrorClasses: make(map[string]int64),
+	}
+	for msg, n := range st.ErrorDist {
+		rs.Errors[msg] = int64(n)
+		rs.Summary.Errors += int64(n)
+	}
+	for class, n := range st.errorClasses() {
+		rs.ErrorClasses[class] = n
+	}
+	for i, sec := range st.percentiles() {
+		rs.Percentiles[i] = ResultsPercentile{Percentile: latencyPercentiles[i], LatencyMs: 1000 * sec}
+	}
+
+	samplesPerSecond := int64(time.Second / sampleInterval(gcfg))
+	for i, dp := range st.TimeSeries {
+		rdp := ResultsDataPoint{
+			UnixMillisecond: dp.Timestamp,
+			MinLatencyMs:    toMillisecond(dp.MinLatency),
+			AvgLatencyMs:    toMillisecond(dp.AvgLatency),
+			MaxLatencyMs:    toMillisecond(dp.MaxLatency),
+			Throughput:      dp.ThroughPut * samplesPerSecond,
+		}
+		if i < len(clientNs) {
+			rdp.ClientNumber = clientNs[i]
+		}
+		if i < len(st.SecondHistograms) {
+			h := st.SecondHistograms[i]
+			rdp.P50LatencyMs = toMillisecond(time.Duration(h.ValueAtPercentile(50)))
+			rdp.P90LatencyMs = toMillisecond(time.Duration(h.ValueAtPercentile(90)))
+			rdp.P99LatencyMs = toMillisecond(time.Duration(h.ValueAtPercentile(99)))
+			rdp.P999LatencyMs = toMillisecond(time.Duration(h.ValueAtPercentile(99.9)))
+		}
+		if i < len(st.SecondErrors) {
+			rdp.ErrorCount = st.SecondErrors[i]
+		}
+		if i < len(st.SecondErrorClasses) {
+			for _, class := range errclass.Classes {
+				if n := st.SecondErrorClasses[i][class]; n > 0 {
+					if rdp.ErrorClasses == nil {
+						rdp.ErrorClasses = make(map[string]int64)
+					}
+					rdp.ErrorClasses[class] = n
+				}
+			}
+		}
+		rs.TimeSeries[i] = rdp
+	}
+	return rs
+}
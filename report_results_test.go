@@ -0,0 +1,114 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/errclass"
+	"github.com/coreos/etcd/pkg/report"
+)
+
+func Test_saveResults(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "results")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID:  "etcd__v3_2",
+		DatabaseTag: "etcd-v3.2-go1.8.3",
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			ClientNumber:              10,
+			SampleIntervalMillisecond: 1000,
+		},
+	}
+	cfg := &Config{
+		TestTitle:                  "test",
+		ConfigClientMachineInitial: dbtesterpb.ConfigClientMachineInitial{PathPrefix: dir},
+		binaries:                   []ResultsBinary{{Endpoint: "a:2379", Version: "3.2.0"}},
+	}
+
+	r := newLatencyReport(time.Second, errclass.RulesFor(gcfg.DatabaseID))
+	go func() {
+		start := time.Unix(100, 0)
+		ep := dialedEndpoint{endpoint: "a:2379", role: roleLeader}
+		for i := 1; i <= 100; i++ {
+			r.Results() <- result{Result: report.Result{Start: start, End: start.Add(time.Duration(i) * time.Millisecond)}, endpoint: ep}
+		}
+		r.Results() <- result{Result: report.Result{Start: start, End: start, Err: errors.New("context deadline exceeded")}, endpoint: ep}
+		close(r.Results())
+	}()
+	st := <-r.Stats()
+
+	cfg.saveResults(gcfg, st, nil, false)
+	rs, err := ReadResults(filepath.Join(dir, "results.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs.SchemaVersion != ResultsSchemaVersion || rs.DatabaseID != "etcd__v3_2" || rs.Config.DatabaseTag != "etcd-v3.2-go1.8.3" {
+		t.Fatalf("unexpected results %+v", rs)
+	}
+	if !reflect.DeepEqual(rs.DatabaseBinaries, cfg.binaries) {
+		t.Fatalf("expected binaries %+v, got %+v", cfg.binaries, rs.DatabaseBinaries)
+	}
+	if rs.Summary.Requests != 100 || rs.Summary.Errors != 1 || rs.Summary.SlowestLatencyMs != 100 {
+		t.Fatalf("unexpected summary %+v", rs.Summary)
+	}
+	if len(rs.Percentiles) != len(latencyPercentiles) {
+		t.Fatalf("expected %d percentiles, got %d", len(latencyPercentiles), len(rs.Percentiles))
+	}
+	if !reflect.DeepEqual(rs.ErrorClasses, map[string]int64{errclass.Timeout: 1}) {
+		t.Fatalf("unexpected error classes %v", rs.ErrorClasses)
+	}
+	if len(rs.TimeSeries) != 1 || rs.TimeSeries[0].UnixMillisecond != 100000 || rs.TimeSeries[0].Throughput != 100 || rs.TimeSeries[0].ClientNumber != 10 || rs.TimeSeries[0].ErrorCount != 1 {
+		t.Fatalf("unexpected time series %+v", rs.TimeSeries)
+	}
+	if len(rs.Endpoints) != 1 || rs.Endpoints[0].Role != roleLeader || rs.Endpoints[0].Summary.Requests != 100 {
+		t.Fatalf("unexpected endpoints %+v", rs.Endpoints)
+	}
+	if rs.Server != nil {
+		t.Fatalf("expected no server usage before stop, got %+v", rs.Server)
+	}
+
+	gcfg.DatabaseEndpoints = []string{"a:2379", "b:2379"}
+	cfg.DatabaseIDToConfigClientMachineAgentControl = map[string]dbtesterpb.ConfigClientMachineAgentControl{"etcd__v3_2": gcfg}
+	idxToResp := map[int]dbtesterpb.Response{
+		0: {Success: true, DiskSpaceUsageBytes: 100, MaxRSSBytes: 3000},
+		1: {Success: true, DiskSpaceUsageBytes: 200, MaxRSSBytes: 2000},
+	}
+	if err = cfg.SaveServerResults("etcd__v3_2", idxToResp); err != nil {
+		t.Fatal(err)
+	}
+	rs, err = ReadResults(filepath.Join(dir, "results.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expServer := &ResultsServer{MaxMemoryBytes: 3000, AvgDiskSpaceUsageBytes: 150}
+	if !reflect.DeepEqual(rs.Server, expServer) {
+		t.Fatalf("expected server usage %+v, got %+v", expServer, rs.Server)
+	}
+	if rs.Summary.Requests != 100 {
+		t.Fatalf("unexpected summary after saving server usage %+v", rs.Summary)
+	}
+}
@@ -17,13 +17,17 @@ package dbtester
 import (
 	"fmt"
 	"math"
+	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/errclass"
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
 	"github.com/coreos/dbtester/pkg/remotestorage"
-	"github.com/coreos/etcd/pkg/report"
 	humanize "github.com/dustin/go-humanize"
 	"github.com/gyuho/dataframe"
 )
@@ -71,7 +75,90 @@ func (cfg *Config) SaveDiskSpaceUsageSummary(databaseID string, idxToResponse ma
 	return fr.CSV(cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath)
 }
 
-func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
+// BinarySummaryColumns defines database binary summary columns.
+var BinarySummaryColumns = []string{
+	"INDEX",
+	"DATABASE-ENDPOINT",
+	"BINARY-VERSION",
+	"BINARY-SHA256",
+}
+
+// binarySummaryPath is the file name of database binary summary.
+const binarySummaryPath = "server-binary-summary.csv"
+
+// ClientBinarySummaryPath returns the path to save the database binary summary in client machine.
+func (cfg *Config) ClientBinarySummaryPath() string {
+	return filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, binarySummaryPath)
+}
+
+// AnalyzeBinarySummaryPath returns the path of the database binary summary to analyze.
+func (cfg *Config) AnalyzeBinarySummaryPath(databaseID string) string {
+	return cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].PathPrefix + "-" + binarySummaryPath
+}
+
+// endpointSummaryPath is the file name of client latency distribution by database endpoint.
+const endpointSummaryPath = "client-latency-by-endpoint-summary.csv"
+
+// endpointTimeseriesPath is the file name of client latency and throughput timeseries by database endpoint.
+const endpointTimeseriesPath = "client-latency-by-endpoint-timeseries.csv"
+
+// ClientEndpointSummaryPath returns the path to save the latency distribution by endpoint in client machine.
+func (cfg *Config) ClientEndpointSummaryPath() string {
+	return filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, endpointSummaryPath)
+}
+
+// ClientEndpointTimeseriesPath returns the path to save the timeseries by endpoint in client machine.
+func (cfg *Config) ClientEndpointTimeseriesPath() string {
+	return filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, endpointTimeseriesPath)
+}
+
+// AnalyzeEndpointSummaryPath returns the path of the latency distribution by endpoint to analyze.
+func (cfg *Config) AnalyzeEndpointSummaryPath(databaseID string) string {
+	return cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].PathPrefix + "-" + endpointSummaryPath
+}
+
+// AnalyzeEndpointTimeseriesPath returns the path of the timeseries by endpoint to analyze.
+func (cfg *Config) AnalyzeEndpointTimeseriesPath(databaseID string) string {
+	return cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].PathPrefix + "-" + endpointTimeseriesPath
+}
+
+// SaveBinarySummary saves the version and checksum of database binaries,
+// as reported by agents on start.
+func (cfg *Config) SaveBinarySummary(databaseID string, idxToResponse map[int]dbtesterpb.Response) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+
+	cols := make([]dataframe.Column, len(BinarySummaryColumns))
+	for i := range BinarySummaryColumns {
+		cols[i] = dataframe.NewColumn(BinarySummaryColumns[i])
+	}
+	cfg.binaries = cfg.binaries[:0]
+	for i := range gcfg.DatabaseEndpoints {
+		cfg.binaries = append(cfg.binaries, ResultsBinary{
+			Endpoint: gcfg.DatabaseEndpoints[i],
+			Version:  idxToResponse[i].BinaryVersion,
+			SHA256:   idxToResponse[i].BinarySHA256,
+		})
+		cols[0].PushBack(dataframe.NewStringValue(i))
+		cols[1].PushBack(dataframe.NewStringValue(gcfg.DatabaseEndpoints[i]))
+		cols[2].PushBack(dataframe.NewStringValue(idxToResponse[i].BinaryVersion))
+		cols[3].PushBack(dataframe.NewStringValue(idxToResponse[i].BinarySHA256))
+	}
+
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ClientBinarySummaryPath())
+}
+
+// saveDataLatencyDistributionSummary saves the summary of latencies.
+// If the benchmark was interrupted, the summary is marked as partial.
+func (cfg *Config) saveDataLatencyDistributionSummary(st reportStats, partial bool) {
 	fr := dataframe.New()
 
 	c1 := dataframe.NewColumn("TOTAL-SECONDS")
@@ -126,20 +213,37 @@ func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
 		}
 	}
 
+	classes := st.errorClasses()
+	for _, class := range errclass.Classes {
+		if classes[class] == 0 {
+			continue
+		}
+		classcol := dataframe.NewColumn("ERROR-CLASS-" + class)
+		classcol.PushBack(dataframe.NewStringValue(classes[class]))
+		if err := fr.AddColumn(classcol); err != nil {
+			plog.Fatal(err)
+		}
+	}
+
+	if partial {
+		pcol := dataframe.NewColumn("PARTIAL")
+		pcol.PushBack(dataframe.NewStringValue("true"))
+		if err := fr.AddColumn(pcol); err != nil {
+			plog.Fatal(err)
+		}
+	}
+
 	if err := fr.CSVHorizontal(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath); err != nil {
 		plog.Fatal(err)
 	}
 }
 
-func (cfg *Config) saveDataLatencyDistributionPercentile(st report.Stats) {
-	pctls, seconds := report.Percentiles(st.Lats)
+func (cfg *Config) saveDataLatencyDistributionPercentile(st reportStats) {
+	seconds := st.percentiles()
 	c1 := dataframe.NewColumn("LATENCY-PERCENTILE")
 	c2 := dataframe.NewColumn("LATENCY-MS")
-	for i := range pctls {
-		pct := fmt.Sprintf("p%.1f", pctls[i])
-		if strings.HasSuffix(pct, ".0") {
-			pct = strings.Replace(pct, ".0", "", -1)
-		}
+	for i := range latencyPercentiles {
+		pct := "p" + strconv.FormatFloat(latencyPercentiles[i], 'f', -1, 64)
 
 		c1.PushBack(dataframe.NewStringValue(pct))
 		c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*seconds[i])))
@@ -157,22 +261,18 @@ func (cfg *Config) saveDataLatencyDistributionPercentile(st report.Stats) {
 	}
 }
 
-func (cfg *Config) saveDataLatencyDistributionAll(st report.Stats) {
+func (cfg *Config) saveDataLatencyDistributionAll(st reportStats) {
 	min := int64(math.MaxInt64)
 	max := int64(-100000)
 	rm := make(map[int64]int64)
-	for _, lt := range st.Lats {
-		// convert second(float64) to millisecond
-		ms := lt * 1000
+	for _, b := range st.Histogram.Buckets() {
+		// convert nanosecond to millisecond
+		ms := toMillisecond(time.Duration(b.Value))
 
 		// truncate all digits below 10ms
 		// (e.g. 125.11ms becomes 120ms)
 		v := int64(math.Trunc(ms/10) * 10)
-		if _, ok := rm[v]; !ok {
-			rm[v] = 1
-		} else {
-			rm[v]++
-		}
+		rm[v] += b.Count
 
 		if min > v {
 			min = v
@@ -210,7 +310,7 @@ func (cfg *Config) saveDataLatencyDistributionAll(st report.Stats) {
 	}
 }
 
-func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigClientMachineAgentControl, st report.Stats, clientNs []int64) {
+func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigClientMachineAgentControl, st reportStats, clientNs []int64) {
 	if len(clientNs) == 0 && len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) == 0 {
 		clientNs = make([]int64, len(st.TimeSeries))
 		for i := range clientNs {
@@ -223,14 +323,49 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	c4 := dataframe.NewColumn("AVG-LATENCY-MS")
 	c5 := dataframe.NewColumn("MAX-LATENCY-MS")
 	c6 := dataframe.NewColumn("AVG-THROUGHPUT")
+	pcols := make([]dataframe.Column, len(timeseriesPercentiles))
+	for j, p := range timeseriesPercentiles {
+		pcols[j] = dataframe.NewColumn(p.column)
+	}
+	c7 := dataframe.NewColumn("ERROR-COUNT")
+	c8 := dataframe.NewColumn("ERROR-RATE")
+	ecols := make([]dataframe.Column, len(errclass.Classes))
+	for j, class := range errclass.Classes {
+		ecols[j] = dataframe.NewColumn("ERROR-RATE-" + class)
+	}
+	c9 := dataframe.NewColumn("UNIX-MILLISECOND")
+	samplesPerSecond := int64(time.Second / sampleInterval(gcfg))
 	for i := range st.TimeSeries {
-		// this Timestamp is unix seconds
-		c1.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", st.TimeSeries[i].Timestamp)))
+		// this Timestamp is unix milliseconds of the sample
+		c1.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", st.TimeSeries[i].Timestamp/1000)))
 		c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", clientNs[i])))
 		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(st.TimeSeries[i].MinLatency))))
 		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(st.TimeSeries[i].AvgLatency))))
 		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(st.TimeSeries[i].MaxLatency))))
-		c6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", st.TimeSeries[i].ThroughPut)))
+		// throughput is requests per second, even with sub-second samples
+		c6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", st.TimeSeries[i].ThroughPut*samplesPerSecond)))
+		for j, p := range timeseriesPercentiles {
+			var lat time.Duration
+			if i < len(st.SecondHistograms) {
+				lat = time.Duration(st.SecondHistograms[i].ValueAtPercentile(p.percentile))
+			}
+			pcols[j].PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(lat))))
+		}
+		var errCnt int64
+		if i < len(st.SecondErrors) {
+			errCnt = st.SecondErrors[i]
+		}
+		c7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", errCnt)))
+		// error rates are errors per second, like throughput
+		c8.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", errCnt*samplesPerSecond)))
+		for j, class := range errclass.Classes {
+			var classCnt int64
+			if i < len(st.SecondErrorClasses) {
+				classCnt = st.SecondErrorClasses[i][class]
+			}
+			ecols[j].PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", classCnt*samplesPerSecond)))
+		}
+		c9.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", st.TimeSeries[i].Timestamp)))
 	}
 
 	fr := dataframe.New()
@@ -252,22 +387,53 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	if err := fr.AddColumn(c6); err != nil {
 		plog.Fatal(err)
 	}
+	for _, col := range pcols {
+		if err := fr.AddColumn(col); err != nil {
+			plog.Fatal(err)
+		}
+	}
+	if err := fr.AddColumn(c7); err != nil {
+		plog.Fatal(err)
+	}
+	if err := fr.AddColumn(c8); err != nil {
+		plog.Fatal(err)
+	}
+	for _, col := range ecols {
+		if err := fr.AddColumn(col); err != nil {
+			plog.Fatal(err)
+		}
+	}
+	if err := fr.AddColumn(c9); err != nil {
+		plog.Fatal(err)
+	}
 
 	if err := fr.CSV(cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath); err != nil {
 		plog.Fatal(err)
 	}
 
 	// aggregate latency by the number of keys
-	tss := FindRangesLatency(st.TimeSeries, 1000, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+	var hs []*hdrhistogram.Histogram
+	if len(st.SecondHistograms) == len(st.TimeSeries) {
+		hs = st.SecondHistograms
+	}
+	tss := FindRangesLatencyPercentiles(st.TimeSeries, hs, 1000, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
 	ctt1 := dataframe.NewColumn("KEYS")
 	ctt2 := dataframe.NewColumn("MIN-LATENCY-MS")
 	ctt3 := dataframe.NewColumn("AVG-LATENCY-MS")
 	ctt4 := dataframe.NewColumn("MAX-LATENCY-MS")
+	ctt5 := dataframe.NewColumn("P50-LATENCY-MS")
+	ctt6 := dataframe.NewColumn("P90-LATENCY-MS")
+	ctt7 := dataframe.NewColumn("P99-LATENCY-MS")
+	ctt8 := dataframe.NewColumn("P99.9-LATENCY-MS")
 	for i := range tss {
 		ctt1.PushBack(dataframe.NewStringValue(tss[i].CumulativeKeyNum))
 		ctt2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(tss[i].MinLatency))))
 		ctt3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(tss[i].AvgLatency))))
 		ctt4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(tss[i].MaxLatency))))
+		ctt5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(tss[i].P50Latency))))
+		ctt6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(tss[i].P90Latency))))
+		ctt7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(tss[i].P99Latency))))
+		ctt8.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(tss[i].P999Latency))))
 	}
 
 	frr := dataframe.New()
@@ -283,17 +449,116 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	if err := frr.AddColumn(ctt4); err != nil {
 		plog.Fatal(err)
 	}
+	for _, col := range []dataframe.Column{ctt5, ctt6, ctt7, ctt8} {
+		if err := frr.AddColumn(col); err != nil {
+			plog.Fatal(err)
+		}
+	}
 
 	if err := frr.CSV(cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath); err != nil {
 		plog.Fatal(err)
 	}
 }
 
-func (cfg *Config) saveAllStats(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats report.Stats, clientNs []int64) {
-	cfg.saveDataLatencyDistributionSummary(stats)
+// saveDataLatencyByEndpoint saves the latency distribution and the
+// timeseries of each database endpoint, sorted by endpoint.
+func (cfg *Config) saveDataLatencyByEndpoint(gcfg dbtesterpb.ConfigClientMachineAgentControl, st reportStats) {
+	eps := make([]string, 0, len(st.Endpoints))
+	for ep := range st.Endpoints {
+		eps = append(eps, ep)
+	}
+	sort.Strings(eps)
+
+	sc1 := dataframe.NewColumn("ENDPOINT")
+	sc2 := dataframe.NewColumn("ROLE")
+	sc3 := dataframe.NewColumn("REQUESTS")
+	sc4 := dataframe.NewColumn("ERROR-COUNT")
+	sc5 := dataframe.NewColumn("REQUESTS-PER-SECOND")
+	sc6 := dataframe.NewColumn("AVERAGE-LATENCY-MS")
+	spcols := make([]dataframe.Column, len(timeseriesPercentiles))
+	for j, p := range timeseriesPercentiles {
+		spcols[j] = dataframe.NewColumn(p.column)
+	}
+	sc7 := dataframe.NewColumn("SLOWEST-LATENCY-MS")
+
+	tc1 := dataframe.NewColumn("UNIX-SECOND")
+	tc2 := dataframe.NewColumn("ENDPOINT")
+	tc3 := dataframe.NewColumn("ROLE")
+	tc4 := dataframe.NewColumn("AVG-LATENCY-MS")
+	tpcols := make([]dataframe.Column, len(timeseriesPercentiles))
+	for j, p := range timeseriesPercentiles {
+		tpcols[j] = dataframe.NewColumn(p.column)
+	}
+	tc5 := dataframe.NewColumn("AVG-THROUGHPUT")
+	tc6 := dataframe.NewColumn("ERROR-COUNT")
+	tc7 := dataframe.NewColumn("UNIX-MILLISECOND")
+
+	samplesPerSecond := int64(time.Second / sampleInterval(gcfg))
+	for _, ep := range eps {
+		est := st.Endpoints[ep]
+		var errCnt int
+		for _, n := range est.ErrorDist {
+			errCnt += n
+		}
+		sc1.PushBack(dataframe.NewStringValue(ep))
+		sc2.PushBack(dataframe.NewStringValue(est.Role))
+		sc3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", est.count())))
+		sc4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", errCnt)))
+		sc5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", est.RPS)))
+		sc6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", 1000*est.Average)))
+		for j, p := range timeseriesPercentiles {
+			lat := time.Duration(est.Histogram.ValueAtPercentile(p.percentile))
+			spcols[j].PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(lat))))
+		}
+		sc7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", 1000*est.Slowest)))
+
+		for i, dp := range est.TimeSeries {
+			tc1.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", dp.Timestamp/1000)))
+			tc2.PushBack(dataframe.NewStringValue(ep))
+			tc3.PushBack(dataframe.NewStringValue(est.Role))
+			tc4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(dp.AvgLatency))))
+			for j, p := range timeseriesPercentiles {
+				lat := time.Duration(est.SecondHistograms[i].ValueAtPercentile(p.percentile))
+				tpcols[j].PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(lat))))
+			}
+			tc5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", dp.ThroughPut*samplesPerSecond)))
+			tc6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", est.SecondErrors[i])))
+			tc7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", dp.Timestamp)))
+		}
+	}
+
+	fr := dataframe.New()
+	scols := append([]dataframe.Column{sc1, sc2, sc3, sc4, sc5, sc6}, spcols...)
+	for _, col := range append(scols, sc7) {
+		if err := fr.AddColumn(col); err != nil {
+			plog.Fatal(err)
+		}
+	}
+	if err := fr.CSV(cfg.ClientEndpointSummaryPath()); err != nil {
+		plog.Fatal(err)
+	}
+
+	frt := dataframe.New()
+	tcols := append([]dataframe.Column{tc1, tc2, tc3, tc4}, tpcols...)
+	for _, col := range append(tcols, tc5, tc6, tc7) {
+		if err := frt.AddColumn(col); err != nil {
+			plog.Fatal(err)
+		}
+	}
+	if err := frt.CSV(cfg.ClientEndpointTimeseriesPath()); err != nil {
+		plog.Fatal(err)
+	}
+}
+
+func (cfg *Config) saveAllStats(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats reportStats, clientNs []int64, partial bool) {
+	cfg.saveDataLatencyDistributionSummary(stats, partial)
 	cfg.saveDataLatencyDistributionPercentile(stats)
 	cfg.saveDataLatencyDistributionAll(stats)
 	cfg.saveDataLatencyThroughputTimeseries(gcfg, stats, clientNs)
+	if len(stats.Endpoints) > 0 {
+		cfg.saveDataLatencyByEndpoint(gcfg, stats)
+	}
+	cfg.saveResults(gcfg, stats, clientNs, partial)
 }
 
 // UploadToGoogle uploads target file to Google Cloud Storage.
@@ -311,11 +576,7 @@ func (cfg *Config) UploadToGoogle(databaseID string, targetPath string) error {
 	}
 
 	srcPath := targetPath
-	dstPath := filepath.Base(targetPath)
-	if !strings.HasPrefix(dstPath, gcfg.DatabaseTag) {
-		dstPath = fmt.Sprintf("%s-%s", gcfg.DatabaseTag, dstPath)
-	}
-	dstPath = filepath.Join(cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstPath)
+	dstPath := filepath.Join(cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, uploadedName(gcfg.DatabaseTag, targetPath))
 
 	var uerr error
 	for k := 0; k < 30; k++ {
@@ -328,3 +589,128 @@ func (cfg *Config) UploadToGoogle(databaseID string, targetPath string) error {
 	}
 	return uerr
 }
+
+// uploadedName returns the file name in Google Cloud Storage
+// of a file in client machine.
+func uploadedName(databaseTag, clientPath string) string {
+	name := filepath.Base(clientPath)
+	if !strings.HasPrefix(name, databaseTag) {
+		name = fmt.Sprintf("%s-%s", databaseTag, name)
+	}
+	return name
+}
+
+// analyzeInput is a file that 'analyze' reads.
+type analyzeInput struct {
+	// clientPath is the path in client machine, empty if uploaded by agents.
+	clientPath  string
+	analyzePath string
+	// optional is true if the file is not uploaded in every run
+	// (e.g. results of older versions, or no database endpoint is tagged).
+	optional bool
+}
+
+// analyzeInputs returns the files that 'analyze' reads for the database.
+func (cfg *Config) analyzeInputs(databaseID string) []analyzeInput {
+	amc := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+	cmc := cfg.ConfigClientMachineInitial
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+
+	ins := []analyzeInput{
+		{clientPath: cmc.ClientSystemMetricsInterpolatedPath, analyzePath: amc.ClientSystemMetricsInterpolatedPath},
+		{clientPath: cfg.ClientBinarySummaryPath(), analyzePath: cfg.AnalyzeBinarySummaryPath(databaseID), optional: !gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase},
+	}
+	if cfg.ConfigSLOSearch.Enabled() {
+		ins = append(ins, analyzeInput{clientPath: cfg.ClientSLOSearchPath(), analyzePath: cfg.AnalyzeSLOSearchPath(databaseID)})
+	} else {
+		ins = append(ins,
+			analyzeInput{clientPath: cmc.ClientLatencyThroughputTimeseriesPath, analyzePath: amc.ClientLatencyThroughputTimeseriesPath},
+			analyzeInput{clientPath: cmc.ClientLatencyDistributionAllPath, analyzePath: amc.ClientLatencyDistributionAllPath},
+			analyzeInput{clientPath: cmc.ClientLatencyDistributionPercentilePath, analyzePath: amc.ClientLatencyDistributionPercentilePath},
+			analyzeInput{clientPath: cmc.ClientLatencyDistributionSummaryPath, analyzePath: amc.ClientLatencyDistributionSummaryPath},
+			analyzeInput{clientPath: cmc.ClientLatencyByKeyNumberPath, analyzePath: amc.ClientLatencyByKeyNumberPath},
+			analyzeInput{clientPath: cmc.ServerDiskSpaceUsageSummaryPath, analyzePath: amc.ServerDiskSpaceUsageSummaryPath},
+			analyzeInput{clientPath: cfg.ClientResultsPath(), analyzePath: cfg.AnalyzeResultsPath(databaseID), optional: true},
+			analyzeInput{clientPath: cfg.ClientEndpointSummaryPath(), analyzePath: cfg.AnalyzeEndpointSummaryPath(databaseID), optional: true},
+			analyzeInput{clientPath: cfg.ClientEndpointTimeseriesPath(), analyzePath: cfg.AnalyzeEndpointTimeseriesPath(databaseID), optional: true},
+		)
+	}
+	for _, list := range [][]string{
+		amc.ServerSystemMetricsInterpolatedPathList,
+		amc.ServerDatabaseMetricsPathList,
+		amc.ServerGCPausesPathList,
+		amc.ServerDatabaseLogPathList,
+	} {
+		for _, fpath := range list {
+			ins = append(ins, analyzeInput{analyzePath: fpath})
+		}
+	}
+	return ins
+}
+
+// CheckAnalyzeInputs returns an error if 'analyze' cannot read the results
+// of the database after the run: the results must be uploaded, and the
+// analyze paths must have the same file names as uploaded.
+func (cfg *Config) CheckAnalyzeInputs(databaseID string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	if _, ok = cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]; !ok {
+		return fmt.Errorf("%q has no analyze configuration", databaseID)
+	}
+	if !gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs {
+		return fmt.Errorf("%q does not upload results (step4_upload_logs is false)", databaseID)
+	}
+	for _, in := range cfg.analyzeInputs(databaseID) {
+		name := filepath.Base(in.analyzePath)
+		if in.clientPath == "" {
+			// agents upload as '<database tag>-<agent index>-<file name>'
+			if !strings.HasPrefix(name, gcfg.DatabaseTag+"-") {
+				return fmt.Errorf("%q analyzes %q, but agents upload files prefixed with %q", databaseID, in.analyzePath, gcfg.DatabaseTag+"-")
+			}
+			continue
+		}
+		if exp := uploadedName(gcfg.DatabaseTag, in.clientPath); name != exp {
+			return fmt.Errorf("%q analyzes %q, but %q is uploaded as %q", databaseID, in.analyzePath, in.clientPath, exp)
+		}
+	}
+	return nil
+}
+
+// DownloadFromGoogle downloads the results of the database from Google Cloud
+// Storage to the analyze paths, so that 'analyze' runs in client machine.
+func (cfg *Config) DownloadFromGoogle(databaseID string) error {
+	if err := cfg.CheckAnalyzeInputs(databaseID); err != nil {
+		return err
+	}
+	u, err := remotestorage.NewGoogleCloudStorage([]byte(cfg.ConfigClientMachineInitial.GoogleCloudStorageKey), cfg.ConfigClientMachineInitial.GoogleCloudProjectName)
+	if err != nil {
+		return err
+	}
+
+	for _, in := range cfg.analyzeInputs(databaseID) {
+		srcPath := filepath.Join(cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, filepath.Base(in.analyzePath))
+
+		var derr error
+		for k := 0; k < 30; k++ {
+			if derr = u.DownloadFile(cfg.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcPath, in.analyzePath); derr != nil {
+				if os.IsNotExist(derr) {
+					break
+				}
+				plog.Printf("#%d: error %v while downloading %q", k, derr, srcPath)
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			break
+		}
+		if derr != nil {
+			if in.optional && os.IsNotExist(derr) {
+				plog.Printf("skipped %q (%v)", srcPath, derr)
+				continue
+			}
+			return derr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,356 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"math"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/errclass"
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/etcd/pkg/report"
+)
+
+// Latencies are recorded in nanoseconds, from 1 microsecond to 1 hour.
+// Per-second histograms have fewer significant figures, to bound the
+// memory of long benchmarks.
+const (
+	histogramLowestNanosecond         = int64(time.Microsecond)
+	histogramHighestNanosecond        = int64(time.Hour)
+	histogramSignificantFigures       = 3
+	histogramSecondSignificantFigures = 2
+)
+
+func newLatencyHistogram() *hdrhistogram.Histogram {
+	return hdrhistogram.New(histogramLowestNanosecond, histogramHighestNanosecond, histogramSignificantFigures)
+}
+
+func newSecondHistogram() *hdrhistogram.Histogram {
+	return hdrhistogram.New(histogramLowestNanosecond, histogramHighestNanosecond, histogramSecondSignificantFigures)
+}
+
+// latencyPercentiles are the percentiles saved in 'ClientLatencyDistributionPercentilePath'.
+var latencyPercentiles = []float64{10, 25, 50, 75, 90, 95, 99, 99.9, 99.99}
+
+// timeseriesPercentiles are the percentiles of each sample,
+// saved in 'ClientLatencyThroughputTimeseriesPath'.
+var timeseriesPercentiles = []struct {
+	column     string
+	percentile float64
+}{
+	{"P50-LATENCY-MS", 50},
+	{"P90-LATENCY-MS", 90},
+	{"P99-LATENCY-MS", 99},
+	{"P99.9-LATENCY-MS", 99.9},
+}
+
+// reportStats is the latency and throughput of a benchmark phase,
+// or the combined phases. Latencies are kept in HDR histograms, so
+// the memory is bounded regardless of the number of requests.
+type reportStats struct {
+	AvgTotal float64
+	Fastest  float64
+	Slowest  float64
+	Average  float64
+	Stddev   float64
+	RPS      float64
+	Total    time.Duration
+
+	ErrorDist map[string]int
+
+	// Histogram is the latencies of all successful requests.
+	Histogram *hdrhistogram.Histogram
+
+	// TimeSeries is the latency and throughput by sample interval,
+	// whose timestamps are the unix milliseconds of each sample.
+	// SecondHistograms and SecondErrors are the latencies and the
+	// number of failed requests of each data point in TimeSeries.
+	// SecondErrorClasses are the number of failed requests by error class.
+	TimeSeries         report.TimeSeries
+	SecondHistograms   []*hdrhistogram.Histogram
+	SecondErrors       []int64
+	SecondErrorClasses []map[string]int64
+
+	// Role is the role of the endpoint at dial time, in the stats of one endpoint.
+	Role string
+	// Endpoints are the stats of the requests served by each database endpoint.
+	Endpoints map[string]*reportStats
+}
+
+// Len, Swap and Less sort TimeSeries, SecondHistograms, SecondErrors
+// and SecondErrorClasses together by timestamp.
+func (st *reportStats) Len() int { return len(st.TimeSeries) }
+func (st *reportStats) Swap(i, j int) {
+	st.TimeSeries[i], st.TimeSeries[j] = st.TimeSeries[j], st.TimeSeries[i]
+	st.SecondHistograms[i], st.SecondHistograms[j] = st.SecondHistograms[j], st.SecondHistograms[i]
+	st.SecondErrors[i], st.SecondErrors[j] = st.SecondErrors[j], st.SecondErrors[i]
+	st.SecondErrorClasses[i], st.SecondErrorClasses[j] = st.SecondErrorClasses[j], st.SecondErrorClasses[i]
+}
+func (st *reportStats) Less(i, j int) bool {
+	return st.TimeSeries[i].Timestamp < st.TimeSeries[j].Timestamp
+}
+
+// count returns the number of successful requests.
+func (st *reportStats) count() int64 {
+	if st.Histogram == nil {
+		return 0
+	}
+	return st.Histogram.Count()
+}
+
+// errorClasses returns the number of failed requests by error class.
+func (st *reportStats) errorClasses() map[string]int64 {
+	classes := make(map[string]int64)
+	for _, cs := range st.SecondErrorClasses {
+		for class, n := range cs {
+			classes[class] += n
+		}
+	}
+	return classes
+}
+
+// percentiles returns the latencies in seconds of 'latencyPercentiles'.
+func (st *reportStats) percentiles() []float64 {
+	seconds := make([]float64, len(latencyPercentiles))
+	if st.Histogram == nil {
+		return seconds
+	}
+	for i, p := range latencyPercentiles {
+		seconds[i] = time.Duration(st.Histogram.ValueAtPercentile(p)).Seconds()
+	}
+	return seconds
+}
+
+// computeStats computes the average, RPS, standard deviation,
+// fastest and slowest latencies from the histogram.
+func computeStats(st *reportStats) {
+	n := st.count()
+	if n == 0 {
+		return
+	}
+	st.Average = st.AvgTotal / float64(n)
+	st.RPS = float64(n) / st.Total.Seconds()
+	st.Stddev = time.Duration(st.Histogram.StdDev()).Seconds()
+	st.Fastest = time.Duration(st.Histogram.Min()).Seconds()
+	st.Slowest = time.Duration(st.Histogram.Max()).Seconds()
+}
+
+// appendStats appends the stats of the next phase to 'dst', including
+// the stats of each endpoint. Stats are computed by the caller.
+func appendStats(dst *reportStats, st reportStats) {
+	dst.AvgTotal += st.AvgTotal
+	dst.Total += st.Total
+	dst.Histogram.Merge(st.Histogram)
+	dst.TimeSeries = append(dst.TimeSeries, st.TimeSeries...)
+	dst.SecondHistograms = append(dst.SecondHistograms, st.SecondHistograms...)
+	dst.SecondErrors = append(dst.SecondErrors, st.SecondErrors...)
+	dst.SecondErrorClasses = append(dst.SecondErrorClasses, st.SecondErrorClasses...)
+	for k, v := range st.ErrorDist {
+		dst.ErrorDist[k] += v
+	}
+
+	for ep, est := range st.Endpoints {
+		if dst.Endpoints == nil {
+			dst.Endpoints = make(map[string]*reportStats)
+		}
+		dest, ok := dst.Endpoints[ep]
+		if !ok {
+			dest = &reportStats{ErrorDist: make(map[string]int), Histogram: newLatencyHistogram(), Role: est.Role}
+			dst.Endpoints[ep] = dest
+		}
+		appendStats(dest, *est)
+	}
+}
+
+// result is the result of a request, with the endpoint that served it.
+type result struct {
+	report.Result
+	endpoint dialedEndpoint
+	// class is the error class, classified by the
+	// error rules of the report if empty
+	class string
+}
+
+// latencyReport records the results into histograms of the
+// whole phase and of each sample interval. Errors are counted
+// by sample and by the error class of 'errRules'. Results with
+// endpoints are also recorded in the report of each endpoint.
+type latencyReport struct {
+	results  chan result
+	interval time.Duration
+	errRules []errclass.Rule
+
+	stats     reportStats
+	samples   map[int64]*hdrhistogram.Histogram
+	errors    map[int64]map[string]int64
+	endpoints map[string]*latencyReport
+}
+
+func newLatencyReport(interval time.Duration, errRules []errclass.Rule) *latencyReport {
+	return &latencyReport{
+		results:  make(chan result, 16),
+		interval: interval,
+		errRules: errRules,
+		stats: reportStats{
+			ErrorDist: make(map[string]int),
+			Histogram: newLatencyHistogram(),
+		},
+		samples: make(map[int64]*hdrhistogram.Histogram),
+		errors:  make(map[int64]map[string]int64),
+	}
+}
+
+func (r *latencyReport) Results() chan<- result { return r.results }
+
+// Stats returns the stats, once all results are processed
+// and the results channel is closed.
+func (r *latencyReport) Stats() <-chan reportStats {
+	donec := make(chan reportStats, 1)
+	go func() {
+		defer close(donec)
+		r.processResults()
+		donec <- r.stats
+	}()
+	return donec
+}
+
+func (r *latencyReport) processResults() {
+	st := time.Now()
+	for res := range r.results {
+		if res.Err != nil && res.class == "" {
+			res.class = errclass.Classify(r.errRules, res.Err.Error())
+		}
+		r.record(res)
+		if res.endpoint.endpoint == "" {
+			continue
+		}
+		er, ok := r.endpoints[res.endpoint.endpoint]
+		if !ok {
+			er = newLatencyReport(r.interval, r.errRules)
+			er.stats.Role = res.endpoint.role
+			if r.endpoints == nil {
+				r.endpoints = make(map[string]*latencyReport)
+			}
+			r.endpoints[res.endpoint.endpoint] = er
+		}
+		er.record(res)
+	}
+	total := time.Since(st)
+
+	r.finish(total)
+	if len(r.endpoints) > 0 {
+		r.stats.Endpoints = make(map[string]*reportStats, len(r.endpoints))
+		for ep, er := range r.endpoints {
+			er.finish(total)
+			est := er.stats
+			r.stats.Endpoints[ep] = &est
+		}
+	}
+}
+
+func (r *latencyReport) record(res result) {
+	if res.Err != nil {
+		msg := res.Err.Error()
+		r.stats.ErrorDist[msg]++
+
+		ms := sampleOf(res.Start, r.interval)
+		classes, ok := r.errors[ms]
+		if !ok {
+			classes = make(map[string]int64)
+			r.errors[ms] = classes
+		}
+		classes[res.class]++
+		return
+	}
+	dur := res.Duration()
+	r.stats.AvgTotal += dur.Seconds()
+	r.stats.Histogram.Record(int64(dur))
+
+	ms := sampleOf(res.Start, r.interval)
+	h, ok := r.samples[ms]
+	if !ok {
+		h = newSecondHistogram()
+		r.samples[ms] = h
+	}
+	h.Record(int64(dur))
+}
+
+func (r *latencyReport) finish(total time.Duration) {
+	r.stats.Total = total
+	computeStats(&r.stats)
+	r.stats.TimeSeries, r.stats.SecondHistograms, r.stats.SecondErrors, r.stats.SecondErrorClasses = samplesToTimeSeries(r.samples, r.errors, r.interval)
+}
+
+// sampleOf returns the unix millisecond of the sample that 't' belongs to.
+func sampleOf(t time.Time, interval time.Duration) int64 {
+	ns := t.UnixNano()
+	return (ns - ns%int64(interval)) / int64(time.Millisecond)
+}
+
+// samplesToTimeSeries converts histograms and error counts by class by
+// sample unix millisecond to time series, filling in the samples without
+// results. It returns the total and by-class error counts of each sample.
+func samplesToTimeSeries(samples map[int64]*hdrhistogram.Histogram, errors map[int64]map[string]int64, interval time.Duration) (report.TimeSeries, []*hdrhistogram.Histogram, []int64, []map[string]int64) {
+	if len(samples) == 0 && len(errors) == 0 {
+		return nil, nil, nil, nil
+	}
+	minTs, maxTs := int64(math.MaxInt64), int64(math.MinInt64)
+	for ms := range samples {
+		if minTs > ms {
+			minTs = ms
+		}
+		if maxTs < ms {
+			maxTs = ms
+		}
+	}
+	for ms := range errors {
+		if minTs > ms {
+			minTs = ms
+		}
+		if maxTs < ms {
+			maxTs = ms
+		}
+	}
+	step := int64(interval / time.Millisecond)
+	ts := make(report.TimeSeries, (maxTs-minTs)/step+1)
+	hs := make([]*hdrhistogram.Histogram, len(ts))
+	es := make([]int64, len(ts))
+	cs := make([]map[string]int64, len(ts))
+	for i := range ts {
+		ms := minTs + int64(i)*step
+		h, ok := samples[ms]
+		if !ok {
+			h = newSecondHistogram()
+		}
+		ts[i], hs[i] = toDataPoint(ms, h), h
+		cs[i] = make(map[string]int64, len(errors[ms]))
+		for class, n := range errors[ms] {
+			cs[i][class] = n
+			es[i] += n
+		}
+	}
+	return ts, hs, es, cs
+}
+
+// toDataPoint returns the data point of the histogram.
+func toDataPoint(ms int64, h *hdrhistogram.Histogram) report.DataPoint {
+	return report.DataPoint{
+		Timestamp:  ms,
+		MinLatency: time.Duration(h.Min()),
+		AvgLatency: time.Duration(h.Mean()),
+		MaxLatency: time.Duration(h.Max()),
+		ThroughPut: h.Count(),
+	}
+}
@@ -16,17 +16,15 @@ package dbtester
 
 import (
 	"fmt"
-	"math"
-	"os"
-	"sort"
 	"sync"
 	"time"
 
 	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/errclass"
 
 	"github.com/coreos/etcd/clientv3"
-	"github.com/coreos/etcd/pkg/report"
 	consulapi "github.com/hashicorp/consul/api"
+	"github.com/samuel/go-zookeeper/zk"
 	"golang.org/x/net/context"
 	"golang.org/x/time/rate"
 )
@@ -44,8 +42,9 @@ func newValues(gcfg dbtesterpb.ConfigClientMachineAgentControl) (v values, rerr
 	return
 }
 
-// Stress stresses the database.
-func (cfg *Config) Stress(databaseID string) error {
+// Stress stresses the database. If the context is canceled, it stops
+// generating requests and saves the stats of completed requests as partial.
+func (cfg *Config) Stress(ctx context.Context, databaseID string) error {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
 		return fmt.Errorf("%q does not exist", databaseID)
@@ -60,19 +59,30 @@ func (cfg *Config) Stress(databaseID string) error {
 	case "write":
 		plog.Println("write generateReport is started...")
 
-		// fixed number of client numbers
-		if len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) == 0 {
-			h, done := newWriteHandlers(gcfg)
-			reqGen := func(inflightReqs chan<- request) { generateWrites(gcfg, 0, vals, inflightReqs) }
-			cfg.generateReport(gcfg, h, done, reqGen)
+		if len(gcfg.ConfigClientMachineBenchmarkOptions.LoadGeneratorEndpoints) > 0 {
+			// split requests across multiple client machines
+			if err = cfg.generateReportDistributed(ctx, gcfg); err != nil {
+				return err
+			}
+
+		} else if len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) == 0 {
+			// fixed number of client numbers
+			h, done, err := newWriteHandlers(gcfg)
+			if err != nil {
+				return err
+			}
+			reqGen := func(ctx context.Context, inflightReqs chan<- request) {
+				generateWrites(ctx, gcfg, 0, vals, inflightReqs)
+			}
+			cfg.generateReport(ctx, gcfg, h, done, reqGen)
 
 		} else {
 			// variable client numbers
 			rs := assignRequest(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
 
-			var stats []report.Stats
+			var stats []reportStats
 			reqCompleted := int64(0)
-			for i := 0; i < len(rs); i++ {
+			for i := 0; i < len(rs) && ctx.Err() == nil; i++ {
 				copied := gcfg
 				copied.ConfigClientMachineBenchmarkOptions.ConnectionNumber = gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers[i]
 				copied.ConfigClientMachineBenchmarkOptions.ClientNumber = gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers[i]
@@ -82,18 +92,29 @@ func (cfg *Config) Stress(databaseID string) error {
 
 				go func() {
 					plog.Infof("signaling agent with client number %d", copied.ConfigClientMachineBenchmarkOptions.ClientNumber)
-					if _, err := (&ncfg).BroadcaseRequest(databaseID, dbtesterpb.Operation_Heartbeat); err != nil {
-						plog.Panic(err)
+					if _, err := (&ncfg).BroadcaseRequest(ctx, databaseID, dbtesterpb.Operation_Heartbeat); err != nil {
+						plog.Warningf("heartbeat failed (%v)", err)
 					}
 				}()
 
-				h, done := newWriteHandlers(copied)
-				reqGen := func(inflightReqs chan<- request) { generateWrites(copied, reqCompleted, vals, inflightReqs) }
-				b := newBenchmark(copied.ConfigClientMachineBenchmarkOptions.RequestNumber, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+				h, done, herr := newWriteHandlers(copied)
+				if herr != nil {
+					// save the stats of the client numbers finished so far
+					err = herr
+					break
+				}
+				reqGen := func(ctx context.Context, inflightReqs chan<- request) {
+					generateWrites(ctx, copied, reqCompleted, vals, inflightReqs)
+				}
+				b := newBenchmark(copied.ConfigClientMachineBenchmarkOptions.RequestNumber, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, sampleInterval(copied), errclass.RulesFor(copied.DatabaseID), h, done, reqGen)
+				b.profiler = cfg.profiler
+				b.metrics = cfg.metrics
+				b.dashboard = cfg.dashboard
+				b.step = int64(i + 1)
 
 				// wait until rs[i] requests are finished
 				// do not end reports yet
-				b.startRequests()
+				b.startRequests(ctx)
 				b.waitRequestsEnd()
 
 				plog.Print("finishing reports...")
@@ -106,13 +127,10 @@ func (cfg *Config) Stress(databaseID string) error {
 			}
 			plog.Info("combining all reports")
 
-			combined := report.Stats{ErrorDist: make(map[string]int)}
+			combined := reportStats{ErrorDist: make(map[string]int), Histogram: newLatencyHistogram()}
 			combinedClientNumber := make([]int64, 0, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
 			for i, st := range stats {
-				combined.AvgTotal += st.AvgTotal
-				combined.Total += st.Total
-				combined.Lats = append(combined.Lats, st.Lats...)
-				combined.TimeSeries = append(combined.TimeSeries, st.TimeSeries...)
+				appendStats(&combined, st)
 				//
 				// Need to handle duplicate unix second timestamps when two ranges are merged.
 				// This can happen when the following run happens within the same unix timesecond,
@@ -135,38 +153,23 @@ func (cfg *Config) Stress(databaseID string) error {
 					clientNs[i] = clientN
 				}
 				combinedClientNumber = append(combinedClientNumber, clientNs...)
-
-				for k, v := range st.ErrorDist {
-					if _, ok := combined.ErrorDist[k]; !ok {
-						combined.ErrorDist[k] = v
-					} else {
-						combined.ErrorDist[k] += v
-					}
-				}
 			}
 			if len(combined.TimeSeries) != len(combinedClientNumber) {
 				return fmt.Errorf("len(combined.TimeSeries) %d != len(combinedClientNumber) %d", len(combined.TimeSeries), len(combinedClientNumber))
 			}
 
-			combined.Average = combined.AvgTotal / float64(len(combined.Lats))
-			combined.RPS = float64(len(combined.Lats)) / combined.Total.Seconds()
-			plog.Printf("got total %d data points and total %f seconds (RPS %f)", len(combined.Lats), combined.Total.Seconds(), combined.RPS)
-
-			for i := range combined.Lats {
-				dev := combined.Lats[i] - combined.Average
-				combined.Stddev += dev * dev
-			}
-			combined.Stddev = math.Sqrt(combined.Stddev / float64(len(combined.Lats)))
-
-			sort.Float64s(combined.Lats)
-			if len(combined.Lats) > 0 {
-				combined.Fastest = combined.Lats[0]
-				combined.Slowest = combined.Lats[len(combined.Lats)-1]
+			computeStats(&combined)
+			for _, est := range combined.Endpoints {
+				computeStats(est)
 			}
+			plog.Printf("got total %d data points and total %f seconds (RPS %f)", combined.count(), combined.Total.Seconds(), combined.RPS)
 
 			plog.Info("combined all reports")
 			printStats(combined)
-			cfg.saveAllStats(gcfg, combined, combinedClientNumber)
+			cfg.saveAllStats(gcfg, combined, combinedClientNumber, ctx.Err() != nil || err != nil)
+			if err != nil {
+				return err
+			}
 		}
 
 		plog.Println("write generateReport is finished...")
@@ -183,7 +186,7 @@ func (cfg *Config) Stress(databaseID string) error {
 		case "consul__v0_7_5", "consul__v0_8_0", "consul__v0_8_4", "cetcd__beta":
 			totalKeysFunc = getTotalKeysConsul
 		default:
-			plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+			return fmt.Errorf("%q is unknown database ID", gcfg.DatabaseID)
 		}
 		for k, v := range totalKeysFunc(gcfg.DatabaseEndpoints) {
 			plog.Infof("expected write total results [expected_total: %d | database: %q | endpoint: %q | number_of_keys: %d]",
@@ -198,8 +201,12 @@ func (cfg *Config) Stress(databaseID string) error {
 			plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
 			var err error
 			for i := 0; i < 7; i++ {
-				clients := mustCreateClientsEtcdv2(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				_, err = clients[0].Set(context.Background(), key, value, nil)
+				clients, _, cerr := createClientsEtcdv2(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+				if cerr != nil {
+					err = cerr
+					continue
+				}
+				_, err = clients[0].Set(ctx, key, value, nil)
 				if err != nil {
 					continue
 				}
@@ -207,19 +214,23 @@ func (cfg *Config) Stress(databaseID string) error {
 				break
 			}
 			if err != nil {
-				plog.Errorf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
+				return fmt.Errorf("write error [request: PUT | key: %q | database: %q] (%v)", key, gcfg.DatabaseID, err)
 			}
 
 		case "etcd__v3_1", "etcd__v3_2", "etcd__tip":
 			plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
 			var err error
 			for i := 0; i < 7; i++ {
-				clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+				clients, _, cerr := createClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
 					totalConns:   1,
 					totalClients: 1,
 				})
-				_, err = clients[0].Do(context.Background(), clientv3.OpPut(key, value))
+				if cerr != nil {
+					err = cerr
+					continue
+				}
+				_, err = clients[0].Do(ctx, clientv3.OpPut(key, value))
+				clients[0].Close()
 				if err != nil {
 					continue
 				}
@@ -227,35 +238,41 @@ func (cfg *Config) Stress(databaseID string) error {
 				break
 			}
 			if err != nil {
-				plog.Errorf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
+				return fmt.Errorf("write error [request: PUT | key: %q | database: %q] (%v)", key, gcfg.DatabaseID, err)
 			}
 
 		case "zookeeper__r3_4_9", "zookeeper__r3_5_2_alpha", "zookeeper__r3_5_3_beta", "zetcd__beta":
 			plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
 			var err error
 			for i := 0; i < 7; i++ {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				_, err = conns[0].Create("/"+key, vals.bytes[0], zkCreateFlags, zkCreateACL)
-				if err != nil {
+				conns, _, cerr := createConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+				if cerr != nil {
+					err = cerr
 					continue
 				}
+				_, err = conns[0].Create("/"+key, vals.bytes[0], zkCreateFlags, zkCreateACL)
 				for j := range conns {
 					conns[j].Close()
 				}
+				if err != nil {
+					continue
+				}
 				plog.Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
 				break
 			}
 			if err != nil {
-				plog.Errorf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
+				return fmt.Errorf("write error [request: PUT | key: %q | database: %q] (%v)", key, gcfg.DatabaseID, err)
 			}
 
 		case "consul__v0_7_5", "consul__v0_8_0", "consul__v0_8_4", "cetcd__beta":
 			plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
 			var err error
 			for i := 0; i < 7; i++ {
-				clients := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+				clients, _, cerr := createConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+				if cerr != nil {
+					err = cerr
+					continue
+				}
 				_, err = clients[0].Put(&consulapi.KVPair{Key: key, Value: vals.bytes[0]}, nil)
 				if err != nil {
 					continue
@@ -264,17 +281,25 @@ func (cfg *Config) Stress(databaseID string) error {
 				break
 			}
 			if err != nil {
-				plog.Errorf("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
+				return fmt.Errorf("write error [request: PUT | key: %q | database: %q] (%v)", key, gcfg.DatabaseID, err)
 			}
 
 		default:
-			plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+			return fmt.Errorf("%q is unknown database ID", gcfg.DatabaseID)
 		}
 
-		h, done := newReadHandlers(gcfg)
-		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, key, inflightReqs) }
-		cfg.generateReport(gcfg, h, done, reqGen)
+		if len(gcfg.ConfigClientMachineBenchmarkOptions.LoadGeneratorEndpoints) > 0 {
+			if err = cfg.generateReportDistributed(ctx, gcfg); err != nil {
+				return err
+			}
+		} else {
+			h, done, err := newReadHandlers(gcfg)
+			if err != nil {
+				return err
+			}
+			reqGen := func(ctx context.Context, inflightReqs chan<- request) { generateReads(ctx, gcfg, key, inflightReqs) }
+			cfg.generateReport(ctx, gcfg, h, done, reqGen)
+		}
 		plog.Println("read generateReport is finished...")
 
 	case "read-oneshot":
@@ -283,101 +308,147 @@ func (cfg *Config) Stress(databaseID string) error {
 		var err error
 		switch gcfg.DatabaseID {
 		case "etcd__v2_3":
-			clients := mustCreateClientsEtcdv2(gcfg.DatabaseEndpoints, 1)
-			_, err = clients[0].Set(context.Background(), key, value, nil)
+			clients, _, cerr := createClientsEtcdv2(gcfg.DatabaseEndpoints, 1)
+			if cerr != nil {
+				return cerr
+			}
+			_, err = clients[0].Set(ctx, key, value, nil)
 
 		case "etcd__v3_1", "etcd__v3_2", "etcd__tip":
-			clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+			clients, _, cerr := createClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
 				totalConns:   1,
 				totalClients: 1,
 			})
-			_, err = clients[0].Do(context.Background(), clientv3.OpPut(key, value))
+			if cerr != nil {
+				return cerr
+			}
+			_, err = clients[0].Do(ctx, clientv3.OpPut(key, value))
 			clients[0].Close()
 
 		case "zookeeper__r3_4_9", "zookeeper__r3_5_2_alpha", "zookeeper__r3_5_3_beta", "zetcd__beta":
-			conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, 1)
+			conns, _, cerr := createConnsZk(gcfg.DatabaseEndpoints, 1)
+			if cerr != nil {
+				return cerr
+			}
 			_, err = conns[0].Create("/"+key, vals.bytes[0], zkCreateFlags, zkCreateACL)
 			conns[0].Close()
 
 		case "consul__v0_7_5", "consul__v0_8_0", "consul__v0_8_4", "cetcd__beta":
-			clients := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
+			clients, _, cerr := createConnsConsul(gcfg.DatabaseEndpoints, 1)
+			if cerr != nil {
+				return cerr
+			}
 			_, err = clients[0].Put(&consulapi.KVPair{Key: key, Value: vals.bytes[0]}, nil)
 
 		default:
-			plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+			return fmt.Errorf("%q is unknown database ID", gcfg.DatabaseID)
 		}
 		if err != nil {
-			plog.Errorf("write error on read-oneshot (%v)", err)
-			os.Exit(1)
+			return fmt.Errorf("write error on read-oneshot (%v)", err)
 		}
 
-		h := newReadOneshotHandlers(gcfg)
-		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, key, inflightReqs) }
-		cfg.generateReport(gcfg, h, nil, reqGen)
+		if len(gcfg.ConfigClientMachineBenchmarkOptions.LoadGeneratorEndpoints) > 0 {
+			if err = cfg.generateReportDistributed(ctx, gcfg); err != nil {
+				return err
+			}
+		} else {
+			h, err := newReadOneshotHandlers(gcfg)
+			if err != nil {
+				return err
+			}
+			reqGen := func(ctx context.Context, inflightReqs chan<- request) { generateReads(ctx, gcfg, key, inflightReqs) }
+			cfg.generateReport(ctx, gcfg, h, nil, reqGen)
+		}
 		plog.Println("read-oneshot generateReport is finished...")
 	}
 
 	return nil
 }
 
-func newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+func newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func(), err error) {
 	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	roles := getEndpointRoles(gcfg)
 	switch gcfg.DatabaseID {
 	case "etcd__v2_3":
-		conns := mustCreateClientsEtcdv2(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns, eps, err := createClientsEtcdv2(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		if err != nil {
+			return nil, nil, err
+		}
 		for i := range conns {
 			rhs[i] = newGetEtcd2(conns[i])
 		}
+		tagEndpoints(rhs, eps, roles)
 	case "etcd__v3_1", "etcd__v3_2", "etcd__tip":
-		clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		clients, eps, err := createClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
 			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
 			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
 		})
+		if err != nil {
+			return nil, nil, err
+		}
 		for i := range clients {
 			rhs[i] = newGetEtcd3(clients[i].KV)
 		}
+		tagEndpoints(rhs, eps, roles)
 		done = func() {
 			for i := range clients {
 				clients[i].Close()
 			}
 		}
 	case "zookeeper__r3_4_9", "zookeeper__r3_5_2_alpha", "zookeeper__r3_5_3_beta", "zetcd__beta":
-		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns, eps, err := createConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		if err != nil {
+			return nil, nil, err
+		}
 		for i := range conns {
 			rhs[i] = newGetZK(conns[i])
 		}
+		tagEndpoints(rhs, eps, roles)
 		done = func() {
 			for i := range conns {
 				conns[i].Close()
 			}
 		}
 	case "consul__v0_7_5", "consul__v0_8_0", "consul__v0_8_4", "cetcd__beta":
-		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns, eps, err := createConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		if err != nil {
+			return nil, nil, err
+		}
 		for i := range conns {
 			rhs[i] = newGetConsul(conns[i])
 		}
+		tagEndpoints(rhs, eps, roles)
 	default:
-		plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+		return nil, nil, fmt.Errorf("%q is unknown database ID", gcfg.DatabaseID)
 	}
-	return rhs, done
+	return rhs, done, nil
 }
 
-func newWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+func newWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func(), err error) {
 	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	roles := getEndpointRoles(gcfg)
 	switch gcfg.DatabaseID {
 	case "etcd__v2_3":
-		conns := mustCreateClientsEtcdv2(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns, eps, err := createClientsEtcdv2(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		if err != nil {
+			return nil, nil, err
+		}
 		for i := range conns {
 			rhs[i] = newPutEtcd2(conns[i])
 		}
+		tagEndpoints(rhs, eps, roles)
 	case "etcd__v3_1", "etcd__v3_2", "etcd__tip":
-		etcdClients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		etcdClients, eps, err := createClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
 			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
 			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
 		})
+		if err != nil {
+			return nil, nil, err
+		}
 		for i := range etcdClients {
 			rhs[i] = newPutEtcd3(etcdClients[i])
 		}
+		tagEndpoints(rhs, eps, roles)
 		done = func() {
 			for i := range etcdClients {
 				etcdClients[i].Close()
@@ -388,26 +459,35 @@ func newWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Re
 			key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
 			valueBts := randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)
 			plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
 			for i := 0; i < 7; i++ {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				_, err = conns[0].Create("/"+key, valueBts, zkCreateFlags, zkCreateACL)
+				var conns []*zk.Conn
+				conns, _, err = createConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 				if err != nil {
 					continue
 				}
+				_, err = conns[0].Create("/"+key, valueBts, zkCreateFlags, zkCreateACL)
+				if err == zk.ErrNodeExists {
+					// created by other load generator
+					err = nil
+				}
 				for j := range conns {
 					conns[j].Close()
 				}
+				if err != nil {
+					continue
+				}
 				plog.Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
 				break
 			}
 			if err != nil {
-				plog.Errorf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
+				return nil, nil, fmt.Errorf("write error [request: PUT | key: %q | database: %q] (%v)", key, gcfg.DatabaseID, err)
 			}
 		}
 
-		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns, eps, err := createConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		if err != nil {
+			return nil, nil, err
+		}
 		for i := range conns {
 			if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
 				rhs[i] = newPutOverwriteZK(conns[i])
@@ -415,18 +495,23 @@ func newWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Re
 				rhs[i] = newPutCreateZK(conns[i])
 			}
 		}
+		tagEndpoints(rhs, eps, roles)
 		done = func() {
 			for i := range conns {
 				conns[i].Close()
 			}
 		}
 	case "consul__v0_7_5", "consul__v0_8_0", "consul__v0_8_4", "cetcd__beta":
-		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns, eps, err := createConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		if err != nil {
+			return nil, nil, err
+		}
 		for i := range conns {
 			rhs[i] = newPutConsul(conns[i])
 		}
+		tagEndpoints(rhs, eps, roles)
 	default:
-		plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+		return nil, nil, fmt.Errorf("%q is unknown database ID", gcfg.DatabaseID)
 	}
 
 	for k := range rhs {
@@ -434,52 +519,67 @@ func newWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Re
 			plog.Panicf("%d-th write handler is nil (out of %d)", k, len(rhs))
 		}
 	}
-	return
+	return rhs, done, nil
 }
 
-func newReadOneshotHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) []ReqHandler {
+// newReadOneshotHandlers returns the handlers that connect for each
+// request. Connection errors are returned as the errors of requests.
+func newReadOneshotHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) ([]ReqHandler, error) {
 	rhs := make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	roles := getEndpointRoles(gcfg)
 	switch gcfg.DatabaseID {
 	case "etcd__v2_3":
 		for i := range rhs {
 			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateClientsEtcdv2(gcfg.DatabaseEndpoints, 1)
-				return newGetEtcd2(conns[0])(ctx, req)
+				conns, eps, err := createClientsEtcdv2(gcfg.DatabaseEndpoints, 1)
+				if err != nil {
+					return err
+				}
+				return withEndpoint(roles.dialed(eps[0]), newGetEtcd2(conns[0]))(ctx, req)
 			}
 		}
 	case "etcd__v3_1", "etcd__v3_2", "etcd__tip":
 		for i := range rhs {
 			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+				conns, eps, err := createClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
 					totalConns:   1,
 					totalClients: 1,
 				})
+				if err != nil {
+					return err
+				}
 				defer conns[0].Close()
-				return newGetEtcd3(conns[0])(ctx, req)
+				return withEndpoint(roles.dialed(eps[0]), newGetEtcd3(conns[0]))(ctx, req)
 			}
 		}
 	case "zookeeper__r3_4_9", "zookeeper__r3_5_2_alpha", "zookeeper__r3_5_3_beta", "zetcd__beta":
 		for i := range rhs {
 			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+				conns, eps, err := createConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+				if err != nil {
+					return err
+				}
 				defer conns[0].Close()
-				return newGetZK(conns[0])(ctx, req)
+				return withEndpoint(roles.dialed(eps[0]), newGetZK(conns[0]))(ctx, req)
 			}
 		}
 	case "consul__v0_7_5", "consul__v0_8_0", "consul__v0_8_4", "cetcd__beta":
 		for i := range rhs {
 			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
-				return newGetConsul(conns[0])(ctx, req)
+				conns, eps, err := createConnsConsul(gcfg.DatabaseEndpoints, 1)
+				if err != nil {
+					return err
+				}
+				return withEndpoint(roles.dialed(eps[0]), newGetConsul(conns[0]))(ctx, req)
 			}
 		}
 	default:
-		plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+		return nil, fmt.Errorf("%q is unknown database ID", gcfg.DatabaseID)
 	}
-	return rhs
+	return rhs, nil
 }
 
-func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, inflightReqs chan<- request) {
+func generateReads(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, inflightReqs chan<- request) {
 	defer close(inflightReqs)
 
 	var rateLimiter *rate.Limiter
@@ -492,7 +592,11 @@ func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string,
 
 	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
 		if rateLimiter != nil {
-			rateLimiter.Wait(context.TODO())
+			if rateLimiter.Wait(ctx) != nil {
+				return
+			}
+		} else if ctx.Err() != nil {
+			return
 		}
 
 		switch gcfg.DatabaseID {
@@ -526,7 +630,7 @@ func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string,
 	}
 }
 
-func generateWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx int64, vals values, inflightReqs chan<- request) {
+func generateWrites(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx int64, vals values, inflightReqs chan<- request) {
 	var rateLimiter *rate.Limiter
 	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
 		rateLimiter = rate.NewLimiter(
@@ -551,7 +655,11 @@ func generateWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx in
 		vs := vals.strings[i%int64(vals.sampleSize)]
 
 		if rateLimiter != nil {
-			rateLimiter.Wait(context.TODO())
+			if rateLimiter.Wait(ctx) != nil {
+				return
+			}
+		} else if ctx.Err() != nil {
+			return
 		}
 
 		switch gcfg.DatabaseID {
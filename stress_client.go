@@ -15,6 +15,9 @@
 package dbtester
 
 import (
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
 	"github.com/coreos/etcd/clientv3"
 	"golang.org/x/net/context"
 )
@@ -24,7 +27,89 @@ type request struct {
 	etcdv3Op clientv3.Op
 	zkOp     zkOp
 	consulOp consulOp
+
+	// endpoint is set by the handler, to the endpoint of its connection
+	endpoint dialedEndpoint
 }
 
 // ReqHandler wraps request handler.
 type ReqHandler func(ctx context.Context, req *request) error
+
+// Roles of database endpoints at dial time.
+const (
+	roleLeader   = "LEADER"
+	roleFollower = "FOLLOWER"
+	roleUnknown  = "UNKNOWN"
+)
+
+// endpointRoleTimeout is the timeout to query the role of an endpoint.
+const endpointRoleTimeout = 5 * time.Second
+
+// dialedEndpoint is the database endpoint of a connection,
+// and the role of the endpoint at dial time.
+type dialedEndpoint struct {
+	endpoint string
+	role     string
+}
+
+// endpointRoles maps database endpoints to their roles.
+type endpointRoles map[string]string
+
+// getEndpointRoles queries the role of each database endpoint.
+// Roles of proxies (zetcd, cetcd) and unreachable endpoints are 'roleUnknown'.
+func getEndpointRoles(gcfg dbtesterpb.ConfigClientMachineAgentControl) endpointRoles {
+	var roleFunc func(string) (string, error)
+	switch gcfg.DatabaseID {
+	case "etcd__v2_3":
+		roleFunc = getRoleEtcdv2
+	case "etcd__v3_1", "etcd__v3_2", "etcd__tip":
+		roleFunc = getRoleEtcdv3
+	case "zookeeper__r3_4_9", "zookeeper__r3_5_2_alpha", "zookeeper__r3_5_3_beta":
+		roleFunc = getRoleZk
+	case "consul__v0_7_5", "consul__v0_8_0", "consul__v0_8_4":
+		roleFunc = getRoleConsul
+	}
+
+	roles := make(endpointRoles, len(gcfg.DatabaseEndpoints))
+	for _, ep := range gcfg.DatabaseEndpoints {
+		roles[ep] = roleUnknown
+		if roleFunc == nil {
+			continue
+		}
+		role, err := roleFunc(ep)
+		if err != nil {
+			plog.Warningf("failed to get the role of %q (%v)", ep, err)
+			continue
+		}
+		roles[ep] = role
+	}
+	plog.Infof("endpoint roles %v", roles)
+	return roles
+}
+
+func (rs endpointRoles) dialed(endpoint string) dialedEndpoint {
+	role, ok := rs[endpoint]
+	if !ok {
+		role = roleUnknown
+	}
+	return dialedEndpoint{endpoint: endpoint, role: role}
+}
+
+// withEndpoint tags the requests of the handler with the endpoint.
+func withEndpoint(ep dialedEndpoint, rh ReqHandler) ReqHandler {
+	return func(ctx context.Context, req *request) error {
+		req.endpoint = ep
+		return rh(ctx, req)
+	}
+}
+
+// tagEndpoints tags the requests of each handler with the endpoint
+// of its connection, where 'eps[i]' is the endpoint of 'rhs[i]'.
+func tagEndpoints(rhs []ReqHandler, eps []string, roles endpointRoles) {
+	for i := range rhs {
+		if i >= len(eps) || rhs[i] == nil {
+			continue
+		}
+		rhs[i] = withEndpoint(roles.dialed(eps[i]), rhs[i])
+	}
+}
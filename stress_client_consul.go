@@ -15,6 +15,10 @@
 package dbtester
 
 import (
+	"fmt"
+	"net"
+	"net/http"
+
 	consulapi "github.com/hashicorp/consul/api"
 	"golang.org/x/net/context"
 )
@@ -25,22 +29,25 @@ type consulOp struct {
 	staleRead bool
 }
 
-func mustCreateConnsConsul(endpoints []string, total int64) []*consulapi.KV {
+// createConnsConsul returns the connections and their endpoints.
+func createConnsConsul(endpoints []string, total int64) ([]*consulapi.KV, []string, error) {
 	css := make([]*consulapi.KV, total)
+	eps := make([]string, total)
 	for i := range css {
 		endpoint := endpoints[dialTotal%len(endpoints)]
 		dialTotal++
+		eps[i] = endpoint
 
 		dcfg := consulapi.DefaultConfig()
 		dcfg.Address = endpoint // x.x.x.x:8500
 		cli, err := consulapi.NewClient(dcfg)
 		if err != nil {
-			plog.Fatal(err)
+			return nil, nil, fmt.Errorf("%v (%q)", err, endpoint)
 		}
 
 		css[i] = cli.KV()
 	}
-	return css
+	return css, eps, nil
 }
 
 func newPutConsul(conn *consulapi.KV) ReqHandler {
@@ -74,3 +81,34 @@ func getTotalKeysConsul(endpoints []string) map[string]int64 {
 	}
 	return rs
 }
+
+// getRoleConsul returns the role of the endpoint, by comparing
+// its host with the host of the cluster leader.
+func getRoleConsul(endpoint string) (string, error) {
+	dcfg := consulapi.DefaultConfig()
+	dcfg.Address = endpoint
+	dcfg.HttpClient = &http.Client{Transport: dcfg.Transport, Timeout: endpointRoleTimeout}
+	cli, err := consulapi.NewClient(dcfg)
+	if err != nil {
+		return "", err
+	}
+	leader, err := cli.Status().Leader()
+	if err != nil {
+		return "", err
+	}
+	if leader == "" {
+		return "", fmt.Errorf("no cluster leader")
+	}
+	leaderHost, _, err := net.SplitHostPort(leader)
+	if err != nil {
+		return "", err
+	}
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if host == leaderHost {
+		return roleLeader, nil
+	}
+	return roleFollower, nil
+}
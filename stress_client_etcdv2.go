@@ -15,6 +15,8 @@
 package dbtester
 
 import (
+	"encoding/json"
+	"fmt"
 	"net"
 	"net/http"
 	"strings"
@@ -24,11 +26,14 @@ import (
 	"golang.org/x/net/context"
 )
 
-func mustCreateClientsEtcdv2(endpoints []string, total int64) []clientv2.KeysAPI {
+// createClientsEtcdv2 returns the clients and their endpoints.
+func createClientsEtcdv2(endpoints []string, total int64) ([]clientv2.KeysAPI, []string, error) {
 	cks := make([]clientv2.KeysAPI, total)
+	eps := make([]string, total)
 	for i := range cks {
 		endpoint := endpoints[dialTotal%len(endpoints)]
 		dialTotal++
+		eps[i] = endpoint
 
 		if !strings.HasPrefix(endpoint, "http://") {
 			endpoint = "http://" + endpoint
@@ -49,13 +54,13 @@ func mustCreateClientsEtcdv2(endpoints []string, total int64) []clientv2.KeysAPI
 		}
 		c, err := clientv2.New(cfg)
 		if err != nil {
-			plog.Fatal(err)
+			return nil, nil, fmt.Errorf("%v (%q)", err, endpoint)
 		}
 		kapi := clientv2.NewKeysAPI(c)
 
 		cks[i] = kapi
 	}
-	return cks
+	return cks, eps, nil
 }
 
 type etcdv2Op struct {
@@ -85,3 +90,30 @@ func getTotalKeysEtcdv2(endpoints []string) map[string]int64 {
 	}
 	return rs
 }
+
+// getRoleEtcdv2 returns the role of the endpoint, from its self stats.
+func getRoleEtcdv2(endpoint string) (string, error) {
+	if !strings.HasPrefix(endpoint, "http://") {
+		endpoint = "http://" + endpoint
+	}
+	cli := &http.Client{Timeout: endpointRoleTimeout}
+	resp, err := cli.Get(endpoint + "/v2/stats/self")
+	if err != nil {
+		return "", err
+	}
+	defer gracefulClose(resp)
+
+	var stats struct {
+		State string `json:"state"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return "", err
+	}
+	switch stats.State {
+	case "StateLeader":
+		return roleLeader, nil
+	case "StateFollower":
+		return roleFollower, nil
+	}
+	return roleUnknown, nil
+}
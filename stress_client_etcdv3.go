@@ -18,7 +18,6 @@ import (
 	"bufio"
 	"fmt"
 	"net/http"
-	"os"
 	"strconv"
 	"strings"
 
@@ -33,11 +32,11 @@ func newPutEtcd3(conn clientv3.KV) ReqHandler {
 	}
 }
 
-// dialTotal counts the number of mustCreateConn calls so that endpoint
+// dialTotal counts the number of createConn calls so that endpoint
 // connections can be handed out in round-robin order
 var dialTotal int
 
-func mustCreateConnEtcdv3(endpoints []string) *clientv3.Client {
+func createConnEtcdv3(endpoints []string) (*clientv3.Client, error) {
 	endpoint := endpoints[dialTotal%len(endpoints)]
 	dialTotal++
 	cfg := clientv3.Config{
@@ -45,10 +44,9 @@ func mustCreateConnEtcdv3(endpoints []string) *clientv3.Client {
 	}
 	client, err := clientv3.New(cfg)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "dial error: %v\n", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("dial error %v (%q)", err, endpoint)
 	}
-	return client
+	return client, nil
 }
 
 type etcdv3ClientCfg struct {
@@ -56,17 +54,28 @@ type etcdv3ClientCfg struct {
 	totalClients int64
 }
 
-func mustCreateClientsEtcdv3(endpoints []string, cfg etcdv3ClientCfg) []*clientv3.Client {
+// createClientsEtcdv3 returns the clients and their endpoints.
+// Clients share the connections in round-robin order.
+func createClientsEtcdv3(endpoints []string, cfg etcdv3ClientCfg) ([]*clientv3.Client, []string, error) {
 	conns := make([]*clientv3.Client, cfg.totalConns)
 	for i := range conns {
-		conns[i] = mustCreateConnEtcdv3(endpoints)
+		conn, err := createConnEtcdv3(endpoints)
+		if err != nil {
+			for j := 0; j < i; j++ {
+				conns[j].Close()
+			}
+			return nil, nil, err
+		}
+		conns[i] = conn
 	}
 
 	clients := make([]*clientv3.Client, cfg.totalClients)
+	eps := make([]string, cfg.totalClients)
 	for i := range clients {
 		clients[i] = conns[i%int(cfg.totalConns)]
+		eps[i] = clients[i].Endpoints()[0]
 	}
-	return clients
+	return clients, eps, nil
 }
 
 func newGetEtcd3(conn clientv3.KV) ReqHandler {
@@ -114,3 +123,27 @@ func getTotalKeysEtcdv3(endpoints []string) map[string]int64 {
 	plog.Println("getTotalKeysEtcdv3", rs)
 	return rs
 }
+
+// getRoleEtcdv3 returns the role of the endpoint, by comparing
+// its member ID with the leader ID in its status.
+func getRoleEtcdv3(endpoint string) (string, error) {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{endpoint},
+		DialTimeout: endpointRoleTimeout,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), endpointRoleTimeout)
+	resp, err := cli.Status(ctx, endpoint)
+	cancel()
+	if err != nil {
+		return "", err
+	}
+	if resp.Leader == resp.Header.MemberId {
+		return roleLeader, nil
+	}
+	return roleFollower, nil
+}
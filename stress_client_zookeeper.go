@@ -34,18 +34,24 @@ type zkOp struct {
 	staleRead bool
 }
 
-func mustCreateConnsZk(endpoints []string, total int64) []*zk.Conn {
+// createConnsZk returns the connections and their endpoints.
+func createConnsZk(endpoints []string, total int64) ([]*zk.Conn, []string, error) {
 	zks := make([]*zk.Conn, total)
+	eps := make([]string, total)
 	for i := range zks {
 		endpoint := endpoints[dialTotal%len(endpoints)]
 		dialTotal++
+		eps[i] = endpoint
 		conn, _, err := zk.Connect([]string{endpoint}, time.Second)
 		if err != nil {
-			plog.Fatal(err)
+			for j := 0; j < i; j++ {
+				zks[j].Close()
+			}
+			return nil, nil, fmt.Errorf("%v (%q)", err, endpoint)
 		}
 		zks[i] = conn
 	}
-	return zks
+	return zks, eps, nil
 }
 
 func newPutCreateZK(conn *zk.Conn) ReqHandler {
@@ -103,3 +109,22 @@ func getTotalKeysZk(endpoints []string) map[string]int64 {
 	}
 	return rs
 }
+
+// getRoleZk returns the role of the endpoint, from 'srvr' command.
+// Standalone server is the leader.
+func getRoleZk(endpoint string) (string, error) {
+	stats, ok := zk.FLWSrvr([]string{endpoint}, endpointRoleTimeout)
+	if !ok {
+		if len(stats) == 0 {
+			return "", fmt.Errorf("srvr failed on %q", endpoint)
+		}
+		return "", stats[0].Error
+	}
+	switch stats[0].Mode {
+	case zk.ModeLeader, zk.ModeStandalone:
+		return roleLeader, nil
+	case zk.ModeFollower:
+		return roleFollower, nil
+	}
+	return roleUnknown, nil
+}
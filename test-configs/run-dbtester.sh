@@ -5,12 +5,19 @@ set -e
 # this starts the database on host machine, when 'control' signals
 nohup dbtester agent --network-interface ens4  --disk-device sda  --agent-port :3500 &
 
+# (optional) load generator; run in other client machines, and list its endpoints
+# in 'load_generator_endpoints' to split requests across multiple client machines
+nohup dbtester control load-generator --config config.yaml --load-generator-port :3600 > $HOME/load-generator.log 2>&1 &
+
 # control; specify 'control' configuration file (client number, key number, key-value size),
 # this starts database stressing, and shuts down the database when done
 nohup dbtester control --database-id etcd__tip --config config.yaml > $HOME/control.log 2>&1 &
 nohup dbtester control --database-id zookeeper__r3_5_3_beta --config config.yaml > $HOME/control.log 2>&1 &
 nohup dbtester control --database-id consul__v0_8_4 --config config.yaml > $HOME/control.log 2>&1 &
 
+# or, run all databases in 'all_database_id_list' sequentially, and analyze when done
+nohup dbtester control --all-database-ids --cool-down 1m --analyze --config config.yaml > $HOME/control.log 2>&1 &
+
 # analyze; get all data from remote machines
 # and specify 'analyze' configuration file,
 # this aggregates data, generates all graphs, texts